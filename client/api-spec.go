@@ -0,0 +1,55 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+
+	"github.com/goccy/go-json"
+)
+
+// Get the OpenAPI document describing the daemon's API
+func (c *ApiClient) GetApiSpec() (json.RawMessage, error) {
+	return GetApiSpec(c.context)
+}
+
+// Get the OpenAPI document describing the API of the daemon the requester context is bound to
+func GetApiSpec(rc *RequesterContext) (json.RawMessage, error) {
+	// Make sure the socket exists
+	_, err := os.Stat(rc.SocketPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("the socket at [%s] does not exist - please start the Hyperdrive daemon and try again", rc.SocketPath)
+	}
+
+	// Debug log
+	path := fmt.Sprintf("%s/api-spec", rc.Base)
+	if rc.DebugMode {
+		rc.Log.Printlnf("[DEBUG] Query: GET http://%s", path)
+	}
+
+	// Run the request
+	resp, err := rc.Client.Get("http://" + path)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting API spec: %w", err)
+	}
+	defer resp.Body.Close()
+	bytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading API spec response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server responded to API spec request with code %s: [%s]", resp.Status, string(bytes))
+	}
+	if !json.Valid(bytes) {
+		return nil, fmt.Errorf("server responded to API spec request with invalid JSON")
+	}
+
+	// Pass the document through the response hook so machine-readable CLI output includes it
+	if rc.ResponseHook != nil {
+		rc.ResponseHook(path, json.RawMessage(bytes))
+	}
+	return bytes, nil
+}
@@ -43,6 +43,9 @@ type RequesterContext struct {
 
 	// The base route for the client to send requests to (<http://<base>/<route>/<method>)
 	Base string
+
+	// Called with the route (<base>/<route>/<method>) and data of each successful response, if set
+	ResponseHook func(route string, data any)
 }
 
 // IRequester is an interface for making HTTP requests to a specific subroute on the Hyperdrive Daemon
@@ -59,10 +62,12 @@ type IRequester interface {
 
 // Binder for the Hyperdrive daemon API server
 type ApiClient struct {
-	Service *ServiceRequester
-	Tx      *TxRequester
-	Utils   *UtilsRequester
-	Wallet  *WalletRequester
+	Service   *ServiceRequester
+	Tasks     *TasksRequester
+	Tx        *TxRequester
+	Utils     *UtilsRequester
+	Validator *ValidatorRequester
+	Wallet    *WalletRequester
 
 	context *RequesterContext
 }
@@ -89,8 +94,10 @@ func NewApiClient(baseRoute string, socketPath string, debugMode bool) *ApiClien
 	apiRequester.context.Log = &log
 
 	apiRequester.Service = NewServiceRequester(apiRequester.context)
+	apiRequester.Tasks = NewTasksRequester(apiRequester.context)
 	apiRequester.Tx = NewTxRequester(apiRequester.context)
 	apiRequester.Utils = NewUtilsRequester(apiRequester.context)
+	apiRequester.Validator = NewValidatorRequester(apiRequester.context)
 	apiRequester.Wallet = NewWalletRequester(apiRequester.context)
 
 	return apiRequester
@@ -101,6 +108,11 @@ func (c *ApiClient) SetDebug(debug bool) {
 	c.context.DebugMode = debug
 }
 
+// Set a function to call with the data of each successful response
+func (c *ApiClient) SetResponseHook(hook func(route string, data any)) {
+	c.context.ResponseHook = hook
+}
+
 // Submit a GET request to the API server
 func SendGetRequest[DataType any](r IRequester, method string, requestName string, args map[string]string) (*api.ApiResponse[DataType], error) {
 	if args == nil {
@@ -210,6 +222,9 @@ func HandleResponse[DataType any](context *RequesterContext, resp *http.Response
 	if err != nil {
 		return nil, fmt.Errorf("error deserializing response to %s: %w; original body: [%s]", path, err, string(bytes))
 	}
+	if context.ResponseHook != nil {
+		context.ResponseHook(fmt.Sprintf("%s/%s", context.Base, path), parsedResponse.Data)
+	}
 
 	return &parsedResponse, nil
 }
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+const (
+	// The largest single event the stream reader will accept
+	maxEventSize int = 1024 * 1024
+)
+
+// A live subscription to a daemon's event stream
+type EventSubscription struct {
+	// The events received from the daemon; closed when the stream ends
+	Events <-chan api.Event
+
+	cancel context.CancelFunc
+	err    error
+	lock   *sync.Mutex
+}
+
+// Stop listening for events
+func (s *EventSubscription) Close() {
+	s.cancel()
+}
+
+// The reason the stream ended, once Events has been closed; nil if it was closed by the caller
+func (s *EventSubscription) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.err
+}
+
+// Subscribe to the daemon's event stream. If types is provided, only events of those types will be received.
+func (c *ApiClient) SubscribeToEvents(ctx context.Context, types ...api.EventType) (*EventSubscription, error) {
+	return SubscribeToEvents(ctx, c.context, types)
+}
+
+// Subscribe to the event stream of the daemon the requester context is bound to
+func SubscribeToEvents(ctx context.Context, rc *RequesterContext, types []api.EventType) (*EventSubscription, error) {
+	// Make sure the socket exists
+	_, err := os.Stat(rc.SocketPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("the socket at [%s] does not exist - please start the Hyperdrive daemon and try again", rc.SocketPath)
+	}
+
+	// Create the request
+	ctx, cancel := context.WithCancel(ctx)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/events", rc.Base), nil)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("error creating HTTP request: %w", err)
+	}
+	if len(types) > 0 {
+		typeStrings := make([]string, len(types))
+		for i, eventType := range types {
+			typeStrings[i] = string(eventType)
+		}
+		values := url.Values{}
+		values.Set("types", strings.Join(typeStrings, ","))
+		req.URL.RawQuery = values.Encode()
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	// Debug log
+	if rc.DebugMode {
+		rc.Log.Printlnf("[DEBUG] Subscribe: GET %s", req.URL.String())
+	}
+
+	// Open the stream
+	resp, err := rc.Client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("error subscribing to events: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		cancel()
+		bytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("server responded to events with code %s: [%s]", resp.Status, string(bytes))
+	}
+
+	events := make(chan api.Event)
+	sub := &EventSubscription{
+		Events: events,
+		cancel: cancel,
+		lock:   &sync.Mutex{},
+	}
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+		err := readEventStream(ctx, resp.Body, events)
+		if ctx.Err() != nil {
+			// Closed by the caller
+			return
+		}
+		if err == nil {
+			err = fmt.Errorf("the daemon closed the event stream")
+		}
+		sub.lock.Lock()
+		sub.err = err
+		sub.lock.Unlock()
+	}()
+	return sub, nil
+}
+
+// Parse server-sent events from the stream until it ends
+func readEventStream(ctx context.Context, stream io.Reader, events chan<- api.Event) error {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 4096), maxEventSize)
+	data := strings.Builder{}
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			// A blank line ends the event
+			if data.Len() == 0 {
+				continue
+			}
+			var event api.Event
+			err := json.Unmarshal([]byte(data.String()), &event)
+			data.Reset()
+			if err != nil {
+				return fmt.Errorf("error deserializing event: %w", err)
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return nil
+			}
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteString("\n")
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		default:
+			// The ID and type are also in the data, and lines starting with ':' are keepalives
+		}
+	}
+	return scanner.Err()
+}
@@ -1,6 +1,9 @@
 package client
 
 import (
+	"fmt"
+
+	"github.com/nodeset-org/eth-utils/beacon"
 	"github.com/nodeset-org/hyperdrive/shared/types/api"
 )
 
@@ -34,6 +37,14 @@ func (r *ServiceRequester) GetConfig() (*api.ApiResponse[api.ServiceGetConfigDat
 	return SendGetRequest[api.ServiceGetConfigData](r, "get-config", "GetConfig", nil)
 }
 
+// Gets the proposal and sync committee duties assigned to the provided validators for the current and next epochs
+func (r *ServiceRequester) GetUpcomingDuties(pubkeys []beacon.ValidatorPubkey) (*api.ApiResponse[api.ServiceGetUpcomingDutiesData], error) {
+	args := map[string]string{
+		"pubkeys": MakeBatchArg(pubkeys),
+	}
+	return SendGetRequest[api.ServiceGetUpcomingDutiesData](r, "get-upcoming-duties", "GetUpcomingDuties", args)
+}
+
 // Restarts a Docker container
 func (r *ServiceRequester) RestartContainer(container string) (*api.ApiResponse[api.SuccessData], error) {
 	args := map[string]string{
@@ -42,12 +53,25 @@ func (r *ServiceRequester) RestartContainer(container string) (*api.ApiResponse[
 	return SendGetRequest[api.SuccessData](r, "restart-container", "RestartContainer", args)
 }
 
+// Sends a test alert to all of the configured notification sinks
+func (r *ServiceRequester) SendTestNotification() (*api.ApiResponse[api.ServiceSendTestNotificationData], error) {
+	return SendGetRequest[api.ServiceSendTestNotificationData](r, "send-test-notification", "SendTestNotification", nil)
+}
+
 // Deletes the data folder including the wallet file, password file, and all validator keys.
 // Don't use this unless you have a very good reason to do it (such as switching from Prater to Mainnet).
 func (r *ServiceRequester) TerminateDataFolder() (*api.ApiResponse[api.ServiceTerminateDataFolderData], error) {
 	return SendGetRequest[api.ServiceTerminateDataFolderData](r, "terminate-data-folder", "TerminateDataFolder", nil)
 }
 
+// Gets the most recent entries in the daemon's audit log and verifies the whole log. Use a limit of 0 to get every entry.
+func (r *ServiceRequester) AuditLog(limit uint64) (*api.ApiResponse[api.ServiceAuditLogData], error) {
+	args := map[string]string{
+		"limit": fmt.Sprint(limit),
+	}
+	return SendGetRequest[api.ServiceAuditLogData](r, "audit-log", "AuditLog", args)
+}
+
 // Gets the version of the daemon
 func (r *ServiceRequester) Version() (*api.ApiResponse[api.ServiceVersionData], error) {
 	return SendGetRequest[api.ServiceVersionData](r, "version", "Version", nil)
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+type TasksRequester struct {
+	context *RequesterContext
+}
+
+func NewTasksRequester(context *RequesterContext) *TasksRequester {
+	return &TasksRequester{
+		context: context,
+	}
+}
+
+func (r *TasksRequester) GetName() string {
+	return "Tasks"
+}
+func (r *TasksRequester) GetRoute() string {
+	return "tasks"
+}
+func (r *TasksRequester) GetContext() *RequesterContext {
+	return r.context
+}
+
+// Get the status of each of the daemon's tasks
+func (r *TasksRequester) List() (*api.ApiResponse[api.TaskListData], error) {
+	return SendGetRequest[api.TaskListData](r, "list", "List", nil)
+}
+
+// Run a task immediately instead of waiting for its next scheduled run
+func (r *TasksRequester) Run(name string) (*api.ApiResponse[api.SuccessData], error) {
+	args := map[string]string{
+		"name": name,
+	}
+	return SendGetRequest[api.SuccessData](r, "run", "Run", args)
+}
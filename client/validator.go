@@ -0,0 +1,75 @@
+package client
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/nodeset-org/eth-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+type ValidatorRequester struct {
+	context *RequesterContext
+}
+
+func NewValidatorRequester(context *RequesterContext) *ValidatorRequester {
+	return &ValidatorRequester{
+		context: context,
+	}
+}
+
+func (r *ValidatorRequester) GetName() string {
+	return "Validator"
+}
+func (r *ValidatorRequester) GetRoute() string {
+	return "validator"
+}
+func (r *ValidatorRequester) GetContext() *RequesterContext {
+	return r.context
+}
+
+// Sign, and optionally submit, changes from 0x00 BLS withdrawal credentials to the provided execution address for the given validators.
+// The withdrawal keys are found by searching the mnemonic from startIndex (default 0) for up to searchLimit keys, using validatorPath (defaults to the deposit CLI path).
+func (r *ValidatorRequester) SetWithdrawalAddress(mnemonic string, address common.Address, pubkeys []beacon.ValidatorPubkey, validatorPath *string, startIndex *uint64, searchLimit *uint64, submit bool) (*api.ApiResponse[api.ValidatorSetWithdrawalAddressData], error) {
+	args := map[string]string{
+		"mnemonic": mnemonic,
+		"address":  address.Hex(),
+		"pubkeys":  MakeBatchArg(pubkeys),
+		"submit":   fmt.Sprint(submit),
+	}
+	if validatorPath != nil {
+		args["validator-path"] = *validatorPath
+	}
+	if startIndex != nil {
+		args["start-index"] = fmt.Sprint(*startIndex)
+	}
+	if searchLimit != nil {
+		args["search-limit"] = fmt.Sprint(*searchLimit)
+	}
+	return SendGetRequest[api.ValidatorSetWithdrawalAddressData](r, "set-withdrawal-address", "SetWithdrawalAddress", args)
+}
+
+// Sign changes to the withdrawal credentials of the given validators without using the Beacon Node, so they can be broadcast later.
+// The indices must be in the same order as the pubkeys.
+func (r *ValidatorRequester) SignWithdrawalAddressOffline(mnemonic string, address common.Address, pubkeys []beacon.ValidatorPubkey, indices []uint64, genesisForkVersion []byte, genesisValidatorsRoot common.Hash, validatorPath *string, startIndex *uint64, searchLimit *uint64) (*api.ApiResponse[api.ValidatorSetWithdrawalAddressData], error) {
+	args := map[string]string{
+		"mnemonic":                mnemonic,
+		"address":                 address.Hex(),
+		"pubkeys":                 MakeBatchArg(pubkeys),
+		"submit":                  "false",
+		"indices":                 MakeBatchArg(indices),
+		"genesis-fork-version":    hex.EncodeToString(genesisForkVersion),
+		"genesis-validators-root": genesisValidatorsRoot.Hex(),
+	}
+	if validatorPath != nil {
+		args["validator-path"] = *validatorPath
+	}
+	if startIndex != nil {
+		args["start-index"] = fmt.Sprint(*startIndex)
+	}
+	if searchLimit != nil {
+		args["search-limit"] = fmt.Sprint(*searchLimit)
+	}
+	return SendGetRequest[api.ValidatorSetWithdrawalAddressData](r, "set-withdrawal-address", "SetWithdrawalAddress", args)
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/nodeset-org/hyperdrive/shared/types/api"
 )
 
@@ -34,6 +36,11 @@ func (r *WalletRequester) DeletePassword() (*api.ApiResponse[api.SuccessData], e
 	return SendGetRequest[api.SuccessData](r, "delete-password", "DeletePassword", nil)
 }
 
+// Unload the node wallet and delete its keystore and password from disk
+func (r *WalletRequester) Forget() (*api.ApiResponse[api.SuccessData], error) {
+	return SendGetRequest[api.SuccessData](r, "forget", "Forget", nil)
+}
+
 // Export wallet
 func (r *WalletRequester) Export() (*api.ApiResponse[api.WalletExportData], error) {
 	return SendGetRequest[api.WalletExportData](r, "export", "Export", nil)
@@ -44,6 +51,24 @@ func (r *WalletRequester) ExportEthKey() (*api.ApiResponse[api.WalletExportEthKe
 	return SendGetRequest[api.WalletExportEthKeyData](r, "export-eth-key", "ExportEthKey", nil)
 }
 
+// Export a secondary account derived from the node wallet's seed in encrypted ETH key format
+func (r *WalletRequester) ExportSecondaryEthKey(path string) (*api.ApiResponse[api.WalletExportSecondaryEthKeyData], error) {
+	args := map[string]string{
+		"path": path,
+	}
+	return SendGetRequest[api.WalletExportSecondaryEthKeyData](r, "export-secondary-eth-key", "ExportSecondaryEthKey", args)
+}
+
+// Split the node wallet's seed into SLIP-39 share mnemonics, any threshold of which can recover it
+func (r *WalletRequester) ExportShares(password string, threshold uint64, shares uint64) (*api.ApiResponse[api.WalletExportSharesData], error) {
+	args := map[string]string{
+		"password":  password,
+		"threshold": fmt.Sprint(threshold),
+		"shares":    fmt.Sprint(shares),
+	}
+	return SendGetRequest[api.WalletExportSharesData](r, "export-shares", "ExportShares", args)
+}
+
 // Generate a validator key derived from the node wallet's seed
 func (r *WalletRequester) GenerateValidatorKey(path string) (*api.ApiResponse[api.WalletGenerateValidatorKeyData], error) {
 	args := map[string]string{
@@ -99,6 +124,22 @@ func (r *WalletRequester) Recover(derivationPath *string, mnemonic *string, inde
 	return SendGetRequest[api.WalletRecoverData](r, "recover", "Recover", args)
 }
 
+// Recover wallet from SLIP-39 share mnemonics
+func (r *WalletRequester) RecoverFromShares(derivationPath *string, shares []string, index *uint64, password string, save bool) (*api.ApiResponse[api.WalletRecoverData], error) {
+	args := map[string]string{
+		"shares":        strings.Join(shares, ","),
+		"password":      password,
+		"save-password": fmt.Sprint(save),
+	}
+	if derivationPath != nil {
+		args["derivation-path"] = *derivationPath
+	}
+	if index != nil {
+		args["index"] = fmt.Sprint(*index)
+	}
+	return SendGetRequest[api.WalletRecoverData](r, "recover-from-shares", "RecoverFromShares", args)
+}
+
 // Set the node address back to the wallet address
 func (r *WalletRequester) RestoreAddress() (*api.ApiResponse[api.SuccessData], error) {
 	return SendGetRequest[api.SuccessData](r, "restore-address", "RestoreAddress", nil)
@@ -132,6 +173,18 @@ func (r *WalletRequester) SetPassword(password string, save bool) (*api.ApiRespo
 	return SendGetRequest[api.SuccessData](r, "set-password", "SetPassword", args)
 }
 
+// Re-encrypt the wallet with a new password, optionally switching to a different KDF (pbkdf2 or scrypt; leave it blank to keep the current one)
+func (r *WalletRequester) ChangePassword(oldPassword string, newPassword string, kdf string) (*api.ApiResponse[api.SuccessData], error) {
+	args := map[string]string{
+		"old-password": oldPassword,
+		"new-password": newPassword,
+	}
+	if kdf != "" {
+		args["kdf"] = kdf
+	}
+	return SendGetRequest[api.SuccessData](r, "change-password", "ChangePassword", args)
+}
+
 // Get wallet status
 func (r *WalletRequester) Status() (*api.ApiResponse[api.WalletStatusData], error) {
 	return SendGetRequest[api.WalletStatusData](r, "status", "Status", nil)
@@ -160,6 +213,20 @@ func (r *WalletRequester) TestRecover(derivationPath *string, mnemonic string, i
 	return SendGetRequest[api.WalletRecoverData](r, "test-recover", "TestRecover", args)
 }
 
+// Recover wallet from SLIP-39 share mnemonics in test-mode so none of the artifacts are saved
+func (r *WalletRequester) TestRecoverFromShares(derivationPath *string, shares []string, index *uint64) (*api.ApiResponse[api.WalletRecoverData], error) {
+	args := map[string]string{
+		"shares": strings.Join(shares, ","),
+	}
+	if derivationPath != nil {
+		args["derivation-path"] = *derivationPath
+	}
+	if index != nil {
+		args["index"] = fmt.Sprint(*index)
+	}
+	return SendGetRequest[api.WalletRecoverData](r, "test-recover-from-shares", "TestRecoverFromShares", args)
+}
+
 // Sends a zero-value message with a payload
 func (r *WalletRequester) SendMessage(message []byte, address common.Address) (*api.ApiResponse[api.TxInfoData], error) {
 	args := map[string]string{
@@ -177,6 +244,11 @@ func (r *WalletRequester) SignMessage(message []byte) (*api.ApiResponse[api.Wall
 	return SendGetRequest[api.WalletSignMessageData](r, "sign-message", "SignMessage", args)
 }
 
+// Use the node private key to sign EIP-712 typed data
+func (r *WalletRequester) SignTypedData(typedData apitypes.TypedData) (*api.ApiResponse[api.WalletSignTypedDataData], error) {
+	return SendPostRequest[api.WalletSignTypedDataData](r, "sign-typed-data", "SignTypedData", typedData)
+}
+
 // Use the node private key to sign a transaction
 func (r *WalletRequester) SignTx(message []byte) (*api.ApiResponse[api.WalletSignTxData], error) {
 	args := map[string]string{
@@ -0,0 +1,187 @@
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+const (
+	// The previous hash of the first entry in the log
+	GenesisHash string = "0000000000000000000000000000000000000000000000000000000000000000"
+
+	// The largest single entry the log reader will accept
+	maxEntrySize int = 64 * 1024
+)
+
+// An append-only log of sensitive operations. Each entry includes the hash of the one before it, so editing or removing an entry breaks the chain.
+type AuditLog struct {
+	path      string
+	nextIndex uint64
+	lastHash  string
+	lock      *sync.Mutex
+}
+
+// Open the audit log at the provided path, creating it the first time an entry is recorded
+func NewAuditLog(path string) (*AuditLog, error) {
+	log := &AuditLog{
+		path:     path,
+		lastHash: GenesisHash,
+		lock:     &sync.Mutex{},
+	}
+
+	// Continue the chain from the last entry; damaged entries are left for verification to report
+	entries, _, err := readEntries(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) > 0 {
+		last := entries[len(entries)-1]
+		log.nextIndex = last.Index + 1
+		log.lastHash = last.Hash
+	}
+	return log, nil
+}
+
+// Append an entry for a call to the log
+func (l *AuditLog) Record(caller string, method string, route string, status int, errorMessage string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	entry := api.AuditLogEntry{
+		Index:        l.nextIndex,
+		Time:         time.Now().UTC(),
+		Caller:       caller,
+		Method:       method,
+		Route:        route,
+		Status:       status,
+		Success:      status < 400,
+		Error:        errorMessage,
+		PreviousHash: l.lastHash,
+	}
+	entry.Hash = GetEntryHash(entry)
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error serializing audit log entry: %w", err)
+	}
+
+	// Open for appending only, so existing entries can't be overwritten by mistake
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("error opening audit log [%s]: %w", l.path, err)
+	}
+	defer file.Close()
+	_, err = file.Write(append(bytes, '\n'))
+	if err != nil {
+		return fmt.Errorf("error writing to audit log [%s]: %w", l.path, err)
+	}
+	err = file.Sync()
+	if err != nil {
+		return fmt.Errorf("error syncing audit log [%s]: %w", l.path, err)
+	}
+
+	l.nextIndex++
+	l.lastHash = entry.Hash
+	return nil
+}
+
+// Read every entry in the log. Lines that can't be parsed are skipped, and their line numbers are returned so they can be reported.
+func (l *AuditLog) Read() ([]api.AuditLogEntry, []int, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return readEntries(l.path)
+}
+
+// Check the entries read from the log with VerifyEntries, and that none have been removed from the end since this log last read or wrote it.
+// Removing entries from the end doesn't break the chain, so this is the only way to catch it.
+func (l *AuditLog) Verify(entries []api.AuditLogEntry) error {
+	err := VerifyEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if uint64(len(entries)) < l.nextIndex {
+		return fmt.Errorf("the log has %d entries but %d have been recorded; entries have been removed from the end", len(entries), l.nextIndex)
+	}
+	if len(entries) > 0 && uint64(len(entries)) == l.nextIndex && entries[len(entries)-1].Hash != l.lastHash {
+		return fmt.Errorf("entry %d isn't the last one that was recorded; it has been replaced", len(entries)-1)
+	}
+	return nil
+}
+
+// Check that each entry's hash matches its contents and that the entries form an unbroken chain
+func VerifyEntries(entries []api.AuditLogEntry) error {
+	previousHash := GenesisHash
+	for i, entry := range entries {
+		if entry.Index != uint64(i) {
+			return fmt.Errorf("entry %d has index %d; entries have been removed or reordered", i, entry.Index)
+		}
+		if entry.PreviousHash != previousHash {
+			return fmt.Errorf("entry %d doesn't follow the entry before it; entries have been removed or replaced", i)
+		}
+		if GetEntryHash(entry) != entry.Hash {
+			return fmt.Errorf("entry %d doesn't match its hash; it has been modified", i)
+		}
+		previousHash = entry.Hash
+	}
+	return nil
+}
+
+// Get the hash of an entry, covering every field except the hash itself
+func GetEntryHash(entry api.AuditLogEntry) string {
+	content := strings.Join([]string{
+		fmt.Sprint(entry.Index),
+		entry.Time.UTC().Format(time.RFC3339Nano),
+		entry.Caller,
+		entry.Method,
+		entry.Route,
+		fmt.Sprint(entry.Status),
+		fmt.Sprint(entry.Success),
+		entry.Error,
+		entry.PreviousHash,
+	}, "\n")
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// Read the entries from a log file, along with the line numbers of any that couldn't be parsed
+func readEntries(path string) ([]api.AuditLogEntry, []int, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []api.AuditLogEntry{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening audit log [%s]: %w", path, err)
+	}
+	defer file.Close()
+
+	entries := []api.AuditLogEntry{}
+	badLines := []int{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 4096), maxEntrySize)
+	for line := 1; scanner.Scan(); line++ {
+		var entry api.AuditLogEntry
+		err := json.Unmarshal(scanner.Bytes(), &entry)
+		if err != nil {
+			badLines = append(badLines, line)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error reading audit log [%s]: %w", path, err)
+	}
+	return entries, badLines, nil
+}
@@ -0,0 +1,155 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+// Create a log in a temporary directory with the provided number of entries
+func createTestLog(t *testing.T, count int) *AuditLog {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	log, err := NewAuditLog(path)
+	if err != nil {
+		t.Fatalf("error creating audit log: %s", err.Error())
+	}
+	for i := 0; i < count; i++ {
+		err = log.Record("cli", "GET", "/wallet/sign-tx", 200, "")
+		if err != nil {
+			t.Fatalf("error recording entry %d: %s", i, err.Error())
+		}
+	}
+	return log
+}
+
+// Read the lines of a log file
+func readLines(t *testing.T, path string) []string {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading audit log: %s", err.Error())
+	}
+	return strings.Split(strings.TrimSuffix(string(bytes), "\n"), "\n")
+}
+
+// Replace the contents of a log file
+func writeLines(t *testing.T, path string, lines []string) {
+	err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+	if err != nil {
+		t.Fatalf("error writing audit log: %s", err.Error())
+	}
+}
+
+// Read and verify a log, failing if any lines couldn't be parsed
+func verifyTestLog(t *testing.T, log *AuditLog) error {
+	entries, badLines, err := log.Read()
+	if err != nil {
+		t.Fatalf("error reading audit log: %s", err.Error())
+	}
+	if len(badLines) > 0 {
+		t.Fatalf("audit log has bad lines %v", badLines)
+	}
+	return log.Verify(entries)
+}
+
+func TestAuditLogIntact(t *testing.T) {
+	log := createTestLog(t, 3)
+	err := verifyTestLog(t, log)
+	if err != nil {
+		t.Fatalf("intact log failed verification: %s", err.Error())
+	}
+
+	// Reopening the log continues the chain
+	log, err = NewAuditLog(log.path)
+	if err != nil {
+		t.Fatalf("error reopening audit log: %s", err.Error())
+	}
+	err = log.Record("stakewise", "POST", "/tx/sign-tx", 500, "signing failed")
+	if err != nil {
+		t.Fatalf("error recording entry: %s", err.Error())
+	}
+	entries, _, err := log.Read()
+	if err != nil {
+		t.Fatalf("error reading audit log: %s", err.Error())
+	}
+	if len(entries) != 4 || entries[3].Index != 3 || entries[3].Success || entries[3].Error != "signing failed" {
+		t.Fatalf("unexpected entries after reopening: %+v", entries)
+	}
+	err = log.Verify(entries)
+	if err != nil {
+		t.Fatalf("reopened log failed verification: %s", err.Error())
+	}
+}
+
+func TestAuditLogEditedEntry(t *testing.T) {
+	log := createTestLog(t, 3)
+	lines := readLines(t, log.path)
+
+	// Change the route of the middle entry without updating its hash
+	var entry api.AuditLogEntry
+	err := json.Unmarshal([]byte(lines[1]), &entry)
+	if err != nil {
+		t.Fatalf("error deserializing entry: %s", err.Error())
+	}
+	entry.Route = "/wallet/status"
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		t.Fatalf("error serializing entry: %s", err.Error())
+	}
+	lines[1] = string(bytes)
+	writeLines(t, log.path, lines)
+
+	err = verifyTestLog(t, log)
+	if err == nil || !strings.Contains(err.Error(), "entry 1 doesn't match its hash") {
+		t.Fatalf("expected entry 1 to be reported as modified but got %v", err)
+	}
+}
+
+func TestAuditLogDeletedMiddleEntry(t *testing.T) {
+	log := createTestLog(t, 3)
+	lines := readLines(t, log.path)
+	writeLines(t, log.path, []string{lines[0], lines[2]})
+
+	err := verifyTestLog(t, log)
+	if err == nil || !strings.Contains(err.Error(), "entry 1 has index 2") {
+		t.Fatalf("expected the removed entry to be reported but got %v", err)
+	}
+}
+
+func TestAuditLogTruncatedTail(t *testing.T) {
+	log := createTestLog(t, 3)
+	lines := readLines(t, log.path)
+	writeLines(t, log.path, lines[:2])
+
+	// The remaining chain is intact, so only the log that wrote the entries can tell
+	entries, _, err := log.Read()
+	if err != nil {
+		t.Fatalf("error reading audit log: %s", err.Error())
+	}
+	err = VerifyEntries(entries)
+	if err != nil {
+		t.Fatalf("remaining entries failed verification: %s", err.Error())
+	}
+	err = log.Verify(entries)
+	if err == nil || !strings.Contains(err.Error(), "removed from the end") {
+		t.Fatalf("expected the removed entry to be reported but got %v", err)
+	}
+}
+
+func TestAuditLogPartialLastLine(t *testing.T) {
+	log := createTestLog(t, 3)
+	lines := readLines(t, log.path)
+	lines[2] = lines[2][:len(lines[2])/2]
+	writeLines(t, log.path, lines)
+
+	_, badLines, err := log.Read()
+	if err != nil {
+		t.Fatalf("error reading audit log: %s", err.Error())
+	}
+	if len(badLines) != 1 || badLines[0] != 3 {
+		t.Fatalf("expected line 3 to be reported as bad but got %v", badLines)
+	}
+}
@@ -36,6 +36,7 @@ const (
 	RequestVoluntaryExitPath               = "/eth/v1/beacon/pool/voluntary_exits"
 	RequestAttestationsPath                = "/eth/v1/beacon/blocks/%s/attestations"
 	RequestBeaconBlockPath                 = "/eth/v2/beacon/blocks/%s"
+	RequestBeaconBlockHeaderPath           = "/eth/v1/beacon/headers/%s"
 	RequestValidatorSyncDuties             = "/eth/v1/validator/duties/sync/%s"
 	RequestValidatorProposerDuties         = "/eth/v1/validator/duties/proposer/%s"
 	RequestWithdrawalCredentialsChangePath = "/eth/v1/beacon/pool/bls_to_execution_changes"
@@ -179,6 +180,19 @@ func (c *StandardHttpClient) GetBeaconHead(ctx context.Context) (types.BeaconHea
 
 }
 
+// Get the justified and finalized checkpoints for a state
+func (c *StandardHttpClient) GetFinalityCheckpoints(ctx context.Context, stateId string) (types.FinalityCheckpoints, error) {
+	finalityCheckpoints, err := c.getFinalityCheckpoints(ctx, stateId)
+	if err != nil {
+		return types.FinalityCheckpoints{}, err
+	}
+	return types.FinalityCheckpoints{
+		PreviousJustified: getCheckpoint(finalityCheckpoints.Data.PreviousJustified),
+		CurrentJustified:  getCheckpoint(finalityCheckpoints.Data.CurrentJustified),
+		Finalized:         getCheckpoint(finalityCheckpoints.Data.Finalized),
+	}, nil
+}
+
 // Get a validator's status
 func (c *StandardHttpClient) GetValidatorStatus(ctx context.Context, pubkey beacon.ValidatorPubkey, opts *types.ValidatorStatusOptions) (types.ValidatorStatus, error) {
 
@@ -520,6 +534,22 @@ func (c *StandardHttpClient) GetBeaconBlock(ctx context.Context, blockId string)
 	return beaconBlock, true, nil
 }
 
+// Get the header of a Beacon chain block
+func (c *StandardHttpClient) GetBeaconBlockHeader(ctx context.Context, blockId string) (types.BeaconBlockHeader, bool, error) {
+	header, exists, err := c.getBeaconBlockHeader(ctx, blockId)
+	if err != nil {
+		return types.BeaconBlockHeader{}, false, err
+	}
+	if !exists {
+		return types.BeaconBlockHeader{}, false, nil
+	}
+	return types.BeaconBlockHeader{
+		Slot:      uint64(header.Data.Header.Message.Slot),
+		Root:      common.BytesToHash(header.Data.Root),
+		Canonical: header.Data.Canonical,
+	}, true, nil
+}
+
 // Perform a withdrawal credentials change on a validator
 func (c *StandardHttpClient) ChangeWithdrawalCredentials(ctx context.Context, validatorIndex string, fromBlsPubkey beacon.ValidatorPubkey, toExecutionAddress common.Address, signature beacon.ValidatorSignature) error {
 	return c.postWithdrawalCredentialsChange(ctx, BLSToExecutionChangeRequest{
@@ -765,6 +795,25 @@ func (c *StandardHttpClient) getBeaconBlock(ctx context.Context, blockId string)
 	return beaconBlock, true, nil
 }
 
+// Get the target beacon block header
+func (c *StandardHttpClient) getBeaconBlockHeader(ctx context.Context, blockId string) (BeaconBlockHeaderResponse, bool, error) {
+	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestBeaconBlockHeaderPath, blockId))
+	if err != nil {
+		return BeaconBlockHeaderResponse{}, false, fmt.Errorf("Could not get beacon block header data: %w", err)
+	}
+	if status == http.StatusNotFound {
+		return BeaconBlockHeaderResponse{}, false, nil
+	}
+	if status != http.StatusOK {
+		return BeaconBlockHeaderResponse{}, false, fmt.Errorf("Could not get beacon block header data: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var header BeaconBlockHeaderResponse
+	if err := json.Unmarshal(responseBody, &header); err != nil {
+		return BeaconBlockHeaderResponse{}, false, fmt.Errorf("Could not decode beacon block header data: %w", err)
+	}
+	return header, true, nil
+}
+
 // Send withdrawal credentials change request
 func (c *StandardHttpClient) postWithdrawalCredentialsChange(ctx context.Context, request BLSToExecutionChangeRequest) error {
 	requestArray := []BLSToExecutionChangeRequest{request} // This route must be wrapped in an array
@@ -856,3 +905,11 @@ func (c *StandardHttpClient) postRequest(ctx context.Context, requestPath string
 func epochAt(config types.Eth2Config, time uint64) uint64 {
 	return config.GenesisEpoch + (time-config.GenesisTime)/config.SecondsPerEpoch
 }
+
+// Convert a checkpoint response into a checkpoint
+func getCheckpoint(checkpoint Checkpoint) types.Checkpoint {
+	return types.Checkpoint{
+		Epoch: uint64(checkpoint.Epoch),
+		Root:  common.BytesToHash(checkpoint.Root),
+	}
+}
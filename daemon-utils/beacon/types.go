@@ -58,15 +58,24 @@ type GenesisResponse struct {
 }
 type FinalityCheckpointsResponse struct {
 	Data struct {
-		PreviousJustified struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"previous_justified"`
-		CurrentJustified struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"current_justified"`
-		Finalized struct {
-			Epoch uinteger `json:"epoch"`
-		} `json:"finalized"`
+		PreviousJustified Checkpoint `json:"previous_justified"`
+		CurrentJustified  Checkpoint `json:"current_justified"`
+		Finalized         Checkpoint `json:"finalized"`
+	} `json:"data"`
+}
+type Checkpoint struct {
+	Epoch uinteger  `json:"epoch"`
+	Root  byteArray `json:"root"`
+}
+type BeaconBlockHeaderResponse struct {
+	Data struct {
+		Root      byteArray `json:"root"`
+		Canonical bool      `json:"canonical"`
+		Header    struct {
+			Message struct {
+				Slot uinteger `json:"slot"`
+			} `json:"message"`
+		} `json:"header"`
 	} `json:"data"`
 }
 type ForkResponse struct {
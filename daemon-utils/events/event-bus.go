@@ -0,0 +1,88 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+)
+
+const (
+	logColor color.Attribute = color.FgYellow
+
+	// How many events a subscriber can fall behind by before new ones are dropped for it
+	subscriberBufferSize int = 64
+)
+
+// Publishes events from a daemon to any subscribers, such as clients listening on the event stream
+type EventBus struct {
+	source      string
+	nextId      uint64
+	subscribers map[uint64]chan api.Event
+	nextSubId   uint64
+	logger      log.ColorLogger
+	lock        *sync.Mutex
+}
+
+// Create a new event bus; source is the name of the daemon that owns it
+func NewEventBus(source string) *EventBus {
+	return &EventBus{
+		source:      source,
+		nextId:      1,
+		subscribers: map[uint64]chan api.Event{},
+		logger:      log.NewColorLogger(logColor),
+		lock:        &sync.Mutex{},
+	}
+}
+
+// Publish an event to all of the current subscribers. This never blocks; subscribers that have fallen too far behind will miss it.
+func (b *EventBus) Publish(eventType api.EventType, data any) {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		b.logger.Printlnf("WARNING: error serializing %s event: %s", eventType, err.Error())
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	event := api.Event{
+		Id:     b.nextId,
+		Type:   eventType,
+		Source: b.source,
+		Time:   time.Now(),
+		Data:   bytes,
+	}
+	b.nextId++
+	for _, subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe to the events published from now on. Call the returned function to unsubscribe and close the channel.
+func (b *EventBus) Subscribe() (<-chan api.Event, func()) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	id := b.nextSubId
+	b.nextSubId++
+	subscriber := make(chan api.Event, subscriberBufferSize)
+	b.subscribers[id] = subscriber
+
+	once := &sync.Once{}
+	unsubscribe := func() {
+		once.Do(func() {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+			delete(b.subscribers, id)
+			close(subscriber)
+		})
+	}
+	return subscriber, unsubscribe
+}
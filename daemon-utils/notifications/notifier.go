@@ -0,0 +1,135 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nodeset-org/hyperdrive/shared/config"
+)
+
+const (
+	// How long to wait for all of the sinks to accept an alert
+	sendTimeout time.Duration = 30 * time.Second
+)
+
+// Delivers alerts to all of the configured sinks, suppressing repeats of alerts that are still active
+type Notifier struct {
+	source         string
+	sinks          []ISink
+	repeatInterval time.Duration
+
+	// Map of alert ID to the time it was last sent
+	active map[string]time.Time
+	lock   *sync.Mutex
+}
+
+// Creates a new notifier from the Hyperdrive config. The source is the name of the daemon raising the alerts.
+func NewNotifier(cfg *config.HyperdriveConfig, source string) *Notifier {
+	notifCfg := cfg.Notifications
+	n := &Notifier{
+		source:         source,
+		sinks:          []ISink{},
+		repeatInterval: time.Duration(notifCfg.RepeatInterval.Value) * time.Minute,
+		active:         map[string]time.Time{},
+		lock:           &sync.Mutex{},
+	}
+	if !notifCfg.EnableNotifications.Value {
+		return n
+	}
+
+	// Create the sinks
+	if notifCfg.WebhookUrl.Value != "" {
+		n.sinks = append(n.sinks, NewWebhookSink(notifCfg.WebhookUrl.Value, notifCfg.WebhookFormat.Value))
+	}
+	if notifCfg.SmtpServer.Value != "" {
+		recipients := []string{}
+		for _, recipient := range strings.Split(notifCfg.SmtpTo.Value, ",") {
+			recipient = strings.TrimSpace(recipient)
+			if recipient != "" {
+				recipients = append(recipients, recipient)
+			}
+		}
+		if len(recipients) > 0 {
+			n.sinks = append(n.sinks, NewSmtpSink(notifCfg.SmtpServer.Value, notifCfg.SmtpUsername.Value, notifCfg.SmtpPassword.Value, notifCfg.SmtpFrom.Value, recipients))
+		}
+	}
+	return n
+}
+
+// True if there is at least one sink to send alerts to
+func (n *Notifier) IsEnabled() bool {
+	return len(n.sinks) > 0
+}
+
+// Raise an alert for a problem. If an alert with the same ID is already active, it won't be sent again until the repeat interval has passed.
+func (n *Notifier) Raise(id string, level AlertLevel, title string, message string) error {
+	n.lock.Lock()
+	lastSent, isActive := n.active[id]
+	if isActive && time.Since(lastSent) < n.repeatInterval {
+		n.lock.Unlock()
+		return nil
+	}
+	n.active[id] = time.Now()
+	n.lock.Unlock()
+
+	return n.Send(Alert{
+		ID:      id,
+		Level:   level,
+		Title:   title,
+		Message: message,
+	})
+}
+
+// Resolve an active alert, sending a notification that the problem has been fixed. Does nothing if the alert isn't active.
+func (n *Notifier) Resolve(id string, title string, message string) error {
+	n.lock.Lock()
+	_, isActive := n.active[id]
+	delete(n.active, id)
+	n.lock.Unlock()
+	if !isActive {
+		return nil
+	}
+
+	return n.Send(Alert{
+		ID:       id,
+		Level:    AlertLevel_Info,
+		Title:    title,
+		Message:  message,
+		Resolved: true,
+	})
+}
+
+// Send an alert to every sink, regardless of whether or not it's already active
+func (n *Notifier) Send(alert Alert) error {
+	if !n.IsEnabled() {
+		return nil
+	}
+	alert.Source = n.source
+	if alert.Time.IsZero() {
+		alert.Time = time.Now()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	errs := []error{}
+	for _, sink := range n.sinks {
+		err := sink.Send(ctx, alert)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error sending alert [%s] to %s: %w", alert.ID, sink.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Get the headline for an alert, including its severity
+func getAlertHeadline(alert Alert) string {
+	if alert.Resolved {
+		return fmt.Sprintf("[RESOLVED] %s", alert.Title)
+	}
+	return fmt.Sprintf("[%s] %s", strings.ToUpper(string(alert.Level)), alert.Title)
+}
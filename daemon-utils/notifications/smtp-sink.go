@@ -0,0 +1,139 @@
+package notifications
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+const (
+	// The longest an email can take to send, including connecting to the server
+	smtpTimeout time.Duration = 15 * time.Second
+)
+
+// Sends alerts by email through an SMTP server
+type SmtpSink struct {
+	server   string
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+// Creates a new SMTP sink
+func NewSmtpSink(server string, username string, password string, from string, to []string) *SmtpSink {
+	return &SmtpSink{
+		server:   server,
+		username: username,
+		password: password,
+		from:     from,
+		to:       to,
+	}
+}
+
+// The name of the sink
+func (s *SmtpSink) GetName() string {
+	return "email"
+}
+
+// Email the alert to the recipients. The connection is bound to the context, so a hung server can't block the caller past its deadline.
+func (s *SmtpSink) Send(ctx context.Context, alert Alert) error {
+	host, _, err := net.SplitHostPort(s.server)
+	if err != nil {
+		return fmt.Errorf("invalid SMTP server address [%s]: %w", s.server, err)
+	}
+
+	// Connect to the server
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", s.server)
+	if err != nil {
+		return fmt.Errorf("error connecting to SMTP server: %w", err)
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		return fmt.Errorf("error setting SMTP connection deadline: %w", err)
+	}
+
+	// Close the connection early if the context is cancelled before the deadline
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer stop()
+
+	err = s.sendMessage(conn, host, s.createMessage(alert))
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("error sending email: %w (%w)", err, ctx.Err())
+		}
+		return fmt.Errorf("error sending email: %w", err)
+	}
+	return nil
+}
+
+// Run the SMTP conversation for a message over an open connection, using TLS and authenticating if the server supports it
+func (s *SmtpSink) sendMessage(conn net.Conn, host string, message []byte) error {
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		err = client.Auth(smtp.PlainAuth("", s.username, s.password, host))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(s.from)
+	if err != nil {
+		return err
+	}
+	for _, recipient := range s.to {
+		err = client.Rcpt(recipient)
+		if err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(message)
+	if err != nil {
+		return err
+	}
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// Build the email for an alert
+func (s *SmtpSink) createMessage(alert Alert) []byte {
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("From: %s\r\n", s.from))
+	builder.WriteString(fmt.Sprintf("To: %s\r\n", strings.Join(s.to, ", ")))
+	builder.WriteString(fmt.Sprintf("Subject: [Hyperdrive] %s\r\n", getAlertHeadline(alert)))
+	builder.WriteString("MIME-Version: 1.0\r\n")
+	builder.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	builder.WriteString("\r\n")
+	builder.WriteString(alert.Message)
+	builder.WriteString(fmt.Sprintf("\r\n\r\nSource: %s\r\nTime: %s\r\n", alert.Source, alert.Time.UTC().Format("2006-01-02 15:04:05 MST")))
+	return []byte(builder.String())
+}
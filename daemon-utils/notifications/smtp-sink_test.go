@@ -0,0 +1,140 @@
+package notifications
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// A minimal SMTP server that accepts one message and records it
+type fakeSmtpServer struct {
+	listener   net.Listener
+	recipients []string
+	message    string
+	done       chan struct{}
+}
+
+// Start a fake SMTP server on a random local port
+func newFakeSmtpServer(t *testing.T) *fakeSmtpServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error starting fake SMTP server: %s", err.Error())
+	}
+	s := &fakeSmtpServer{
+		listener: listener,
+		done:     make(chan struct{}),
+	}
+	go s.serve()
+	return s
+}
+
+// Handle a single SMTP session
+func (s *fakeSmtpServer) serve() {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	write := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+	write("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			write("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM"):
+			write("250 OK")
+		case strings.HasPrefix(command, "RCPT TO"):
+			s.recipients = append(s.recipients, strings.TrimSpace(line[len("RCPT TO:"):]))
+			write("250 OK")
+		case command == "DATA":
+			write("354 Go ahead")
+			builder := strings.Builder{}
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				builder.WriteString(dataLine)
+			}
+			s.message = builder.String()
+			write("250 OK")
+		case command == "QUIT":
+			write("221 Bye")
+			return
+		default:
+			write("502 Not implemented")
+		}
+	}
+}
+
+func TestSmtpSend(t *testing.T) {
+	server := newFakeSmtpServer(t)
+	defer server.listener.Close()
+
+	sink := NewSmtpSink(server.listener.Addr().String(), "", "", "node@example.com", []string{"alice@example.com", "bob@example.com"})
+	err := sink.Send(context.Background(), getTestAlert())
+	if err != nil {
+		t.Fatalf("error sending email: %s", err.Error())
+	}
+	<-server.done
+
+	if len(server.recipients) != 2 || server.recipients[0] != "<alice@example.com>" || server.recipients[1] != "<bob@example.com>" {
+		t.Errorf("unexpected recipients %v", server.recipients)
+	}
+	for _, expected := range []string{
+		"From: node@example.com\r\n",
+		"To: alice@example.com, bob@example.com\r\n",
+		"Subject: [Hyperdrive] [WARNING] Low disk space\r\n",
+		"\r\n\r\nOnly 10 GB of disk space is left.\r\n",
+		"Source: hyperdrive\r\nTime: 2024-01-02 03:04:05 UTC\r\n",
+	} {
+		if !strings.Contains(server.message, expected) {
+			t.Errorf("email is missing [%q]:\n%s", expected, server.message)
+		}
+	}
+}
+
+func TestSmtpSendHungServer(t *testing.T) {
+	// Accept connections but never respond
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error starting fake SMTP server: %s", err.Error())
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Read(make([]byte, 1))
+	}()
+
+	sink := NewSmtpSink(listener.Addr().String(), "", "", "node@example.com", []string{"alice@example.com"})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = sink.Send(ctx, getTestAlert())
+	if err == nil {
+		t.Fatal("sending to a hung server succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("sending to a hung server took %s instead of respecting the context", elapsed)
+	}
+}
@@ -0,0 +1,48 @@
+package notifications
+
+import (
+	"context"
+	"time"
+)
+
+// The severity of an alert
+type AlertLevel string
+
+const (
+	AlertLevel_Info     AlertLevel = "info"
+	AlertLevel_Warning  AlertLevel = "warning"
+	AlertLevel_Critical AlertLevel = "critical"
+)
+
+// A notification about a problem (or the resolution of a problem) with the node
+type Alert struct {
+	// Unique identifier for the condition that raised the alert, used for deduplication
+	ID string `json:"id"`
+
+	// The severity of the alert
+	Level AlertLevel `json:"level"`
+
+	// The name of the daemon that raised the alert
+	Source string `json:"source"`
+
+	// A short summary of the alert
+	Title string `json:"title"`
+
+	// The details of the alert
+	Message string `json:"message"`
+
+	// True if this alert signals that a previous problem has been resolved
+	Resolved bool `json:"resolved"`
+
+	// The time the alert was raised
+	Time time.Time `json:"time"`
+}
+
+// A destination that alerts can be delivered to
+type ISink interface {
+	// The name of the sink, for logging
+	GetName() string
+
+	// Deliver an alert to the sink
+	Send(ctx context.Context, alert Alert) error
+}
@@ -0,0 +1,122 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+)
+
+const (
+	// The largest response body that will be read back from a webhook for error reporting
+	maxWebhookResponseSize int64 = 1024
+)
+
+// Discord webhook payload
+type discordMessage struct {
+	Username string         `json:"username"`
+	Embeds   []discordEmbed `json:"embeds"`
+}
+type discordEmbed struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Color       int    `json:"color"`
+	Timestamp   string `json:"timestamp"`
+}
+
+// Slack incoming webhook payload
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// Sends alerts to an HTTP webhook
+type WebhookSink struct {
+	url    string
+	format config.WebhookFormat
+	client *http.Client
+}
+
+// Creates a new webhook sink
+func NewWebhookSink(url string, format config.WebhookFormat) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		format: format,
+		client: &http.Client{
+			Timeout: config.ClientTimeout,
+		},
+	}
+}
+
+// The name of the sink
+func (s *WebhookSink) GetName() string {
+	return fmt.Sprintf("%s webhook", s.format)
+}
+
+// Post the alert to the webhook
+func (s *WebhookSink) Send(ctx context.Context, alert Alert) error {
+	body, err := s.createPayload(alert)
+	if err != nil {
+		return fmt.Errorf("error creating webhook payload: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating webhook request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json; charset=UTF-8")
+
+	resp, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("error posting to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseSize))
+		return fmt.Errorf("webhook responded with code %s: [%s]", resp.Status, string(msg))
+	}
+	return nil
+}
+
+// Serialize the alert into the format expected by the webhook
+func (s *WebhookSink) createPayload(alert Alert) ([]byte, error) {
+	switch s.format {
+	case config.WebhookFormat_Discord:
+		return json.Marshal(discordMessage{
+			Username: "Hyperdrive",
+			Embeds: []discordEmbed{
+				{
+					Title:       getAlertHeadline(alert),
+					Description: alert.Message,
+					Color:       getDiscordColor(alert),
+					Timestamp:   alert.Time.UTC().Format("2006-01-02T15:04:05Z"),
+				},
+			},
+		})
+	case config.WebhookFormat_Slack:
+		return json.Marshal(slackMessage{
+			Text: fmt.Sprintf("*%s*\n%s", getAlertHeadline(alert), alert.Message),
+		})
+	default:
+		return json.Marshal(alert)
+	}
+}
+
+// Get the embed color for an alert in Discord
+func getDiscordColor(alert Alert) int {
+	if alert.Resolved {
+		return 0x2ecc71
+	}
+	switch alert.Level {
+	case AlertLevel_Critical:
+		return 0xe74c3c
+	case AlertLevel_Warning:
+		return 0xf1c40f
+	default:
+		return 0x3498db
+	}
+}
@@ -0,0 +1,129 @@
+package notifications
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+)
+
+// Create an alert for testing
+func getTestAlert() Alert {
+	return Alert{
+		ID:      "test-alert",
+		Level:   AlertLevel_Warning,
+		Source:  "hyperdrive",
+		Title:   "Low disk space",
+		Message: "Only 10 GB of disk space is left.",
+		Time:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestDiscordPayload(t *testing.T) {
+	sink := NewWebhookSink("", config.WebhookFormat_Discord)
+	body, err := sink.createPayload(getTestAlert())
+	if err != nil {
+		t.Fatalf("error creating payload: %s", err.Error())
+	}
+
+	var payload discordMessage
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		t.Fatalf("error deserializing payload: %s", err.Error())
+	}
+	if len(payload.Embeds) != 1 {
+		t.Fatalf("expected 1 embed but got %d", len(payload.Embeds))
+	}
+	embed := payload.Embeds[0]
+	if embed.Title != "[WARNING] Low disk space" {
+		t.Errorf("unexpected title [%s]", embed.Title)
+	}
+	if embed.Description != "Only 10 GB of disk space is left." {
+		t.Errorf("unexpected description [%s]", embed.Description)
+	}
+	if embed.Color != 0xf1c40f {
+		t.Errorf("unexpected color %x", embed.Color)
+	}
+	if embed.Timestamp != "2024-01-02T03:04:05Z" {
+		t.Errorf("unexpected timestamp [%s]", embed.Timestamp)
+	}
+}
+
+func TestSlackPayload(t *testing.T) {
+	sink := NewWebhookSink("", config.WebhookFormat_Slack)
+	alert := getTestAlert()
+	alert.Resolved = true
+	body, err := sink.createPayload(alert)
+	if err != nil {
+		t.Fatalf("error creating payload: %s", err.Error())
+	}
+
+	var payload slackMessage
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		t.Fatalf("error deserializing payload: %s", err.Error())
+	}
+	expected := "*[RESOLVED] Low disk space*\nOnly 10 GB of disk space is left."
+	if payload.Text != expected {
+		t.Errorf("expected text [%s] but got [%s]", expected, payload.Text)
+	}
+}
+
+func TestJsonPayload(t *testing.T) {
+	sink := NewWebhookSink("", config.WebhookFormat_Json)
+	alert := getTestAlert()
+	body, err := sink.createPayload(alert)
+	if err != nil {
+		t.Fatalf("error creating payload: %s", err.Error())
+	}
+
+	var payload Alert
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		t.Fatalf("error deserializing payload: %s", err.Error())
+	}
+	if payload != alert {
+		t.Errorf("expected alert %+v but got %+v", alert, payload)
+	}
+}
+
+func TestWebhookSend(t *testing.T) {
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected a POST but got %s", r.Method)
+		}
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, config.WebhookFormat_Slack)
+	err := sink.Send(context.Background(), getTestAlert())
+	if err != nil {
+		t.Fatalf("error sending alert: %s", err.Error())
+	}
+	if !strings.Contains(string(received), "Low disk space") {
+		t.Errorf("webhook received unexpected payload [%s]", string(received))
+	}
+}
+
+func TestWebhookSendRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("bad payload"))
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, config.WebhookFormat_Discord)
+	err := sink.Send(context.Background(), getTestAlert())
+	if err == nil || !strings.Contains(err.Error(), "bad payload") {
+		t.Fatalf("expected an error with the webhook's response but got %v", err)
+	}
+}
@@ -0,0 +1,355 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+)
+
+const (
+	// How long to wait before retrying a task whose requirements weren't met
+	requirementCooldown time.Duration = 10 * time.Second
+
+	errorColor color.Attribute = color.FgRed
+)
+
+// Services a task can require to be ready before it runs
+type Requirement int
+
+const (
+	Requirement_EthClientSynced Requirement = 1 << iota
+	Requirement_BeaconClientSynced
+	Requirement_WalletReady
+)
+
+// Checks if the services a task requires are ready
+type IRequirementChecker interface {
+	// Wait for the Execution client to sync
+	WaitEthClientSynced(ctx context.Context, verbose bool) error
+
+	// Wait for the Beacon client to sync
+	WaitBeaconClientSynced(ctx context.Context, verbose bool) error
+
+	// Check if the node wallet is ready to use
+	RequireWalletReady() error
+}
+
+// A task that can be run by the scheduler
+type TaskInfo struct {
+	// The unique name of the task, used to trigger it
+	Name string
+
+	// A human-readable description of what the task does
+	Description string
+
+	// How often to run the task
+	Interval time.Duration
+
+	// A random delay of up to this long added to each interval, to keep tasks from running in lockstep
+	Jitter time.Duration
+
+	// How long to wait for the task to finish before giving up on it; 0 means no timeout
+	Timeout time.Duration
+
+	// The services the task needs to be ready before it runs
+	Requirements Requirement
+
+	// The function that runs the task
+	Run func(ctx context.Context) error
+}
+
+// The state of a registered task
+type task struct {
+	info         TaskInfo
+	isRunning    bool
+	lastRunTime  time.Time
+	lastDuration time.Duration
+	lastResult   api.TaskResult
+	lastError    string
+	nextRunTime  time.Time
+}
+
+// Runs registered tasks on their own intervals
+type Scheduler struct {
+	checker  IRequirementChecker
+	events   *events.EventBus
+	tasks    []*task
+	taskMap  map[string]*task
+	trigger  chan struct{}
+	errorLog log.ColorLogger
+	lock     *sync.Mutex
+}
+
+// Create a new scheduler that uses the provided checker to verify task requirements, publishing the result of each run to the event bus
+func NewScheduler(checker IRequirementChecker, bus *events.EventBus) *Scheduler {
+	return &Scheduler{
+		checker:  checker,
+		events:   bus,
+		tasks:    []*task{},
+		taskMap:  map[string]*task{},
+		trigger:  make(chan struct{}, 1),
+		errorLog: log.NewColorLogger(errorColor),
+		lock:     &sync.Mutex{},
+	}
+}
+
+// Register a task with the scheduler; it will run for the first time once the scheduler starts
+func (s *Scheduler) Register(info TaskInfo) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, exists := s.taskMap[info.Name]; exists {
+		return fmt.Errorf("a task named [%s] is already registered", info.Name)
+	}
+	if info.Interval <= 0 {
+		return fmt.Errorf("task [%s] must have a positive interval", info.Name)
+	}
+	t := &task{
+		info: info,
+	}
+	s.tasks = append(s.tasks, t)
+	s.taskMap[info.Name] = t
+	return nil
+}
+
+// Run the scheduler until the context is cancelled, calling wg.Done() when it stops
+func (s *Scheduler) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			s.runDueTasks(ctx)
+
+			// Wait until the next task is due or one is triggered
+			timer := time.NewTimer(time.Until(s.getNextRunTime()))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-s.trigger:
+				timer.Stop()
+			case <-timer.C:
+			}
+		}
+	}()
+}
+
+// Schedule a task to run immediately
+func (s *Scheduler) Trigger(name string) error {
+	s.lock.Lock()
+	t, exists := s.taskMap[name]
+	if !exists {
+		s.lock.Unlock()
+		return fmt.Errorf("there is no task named [%s]", name)
+	}
+	t.nextRunTime = time.Now()
+	s.lock.Unlock()
+
+	// Wake the scheduler up; if it's busy, it will pick the task up once its current pass is done
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Get the status of each registered task, sorted by name
+func (s *Scheduler) GetStatus() []api.TaskStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	statuses := make([]api.TaskStatus, len(s.tasks))
+	for i, t := range s.tasks {
+		statuses[i] = t.getStatus()
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// Run each task that's due, in the order they were registered
+func (s *Scheduler) runDueTasks(ctx context.Context) {
+	// Requirements are only checked once per pass since they're shared between tasks
+	requirementResults := map[Requirement]error{}
+	for _, t := range s.getDueTasks() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Check the requirements
+		err := s.checkRequirements(ctx, t.info.Requirements, requirementResults)
+		if err != nil {
+			s.lock.Lock()
+			t.lastResult = api.TaskResult_Skipped
+			t.lastError = err.Error()
+			t.nextRunTime = time.Now().Add(requirementCooldown)
+			status := t.getStatus()
+			s.lock.Unlock()
+			s.events.Publish(api.EventType_TaskRun, status)
+			continue
+		}
+
+		s.runTask(ctx, t)
+	}
+}
+
+// Get the tasks that are due to run
+func (s *Scheduler) getDueTasks() []*task {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	due := []*task{}
+	for _, t := range s.tasks {
+		if !t.isRunning && !t.nextRunTime.After(now) {
+			due = append(due, t)
+		}
+	}
+	return due
+}
+
+// Get the time the next task is due to run
+func (s *Scheduler) getNextRunTime() time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var next time.Time
+	for _, t := range s.tasks {
+		if t.isRunning {
+			continue
+		}
+		if next.IsZero() || t.nextRunTime.Before(next) {
+			next = t.nextRunTime
+		}
+	}
+	if next.IsZero() {
+		next = time.Now().Add(requirementCooldown)
+	}
+	return next
+}
+
+// Check if all of the provided requirements are met, caching the results
+func (s *Scheduler) checkRequirements(ctx context.Context, requirements Requirement, results map[Requirement]error) error {
+	checks := []struct {
+		requirement Requirement
+		check       func() error
+	}{
+		{Requirement_EthClientSynced, func() error { return s.checker.WaitEthClientSynced(ctx, false) }},
+		{Requirement_BeaconClientSynced, func() error { return s.checker.WaitBeaconClientSynced(ctx, false) }},
+		{Requirement_WalletReady, s.checker.RequireWalletReady},
+	}
+	for _, check := range checks {
+		if requirements&check.requirement == 0 {
+			continue
+		}
+		err, exists := results[check.requirement]
+		if !exists {
+			err = check.check()
+			results[check.requirement] = err
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run a task's function, turning a panic into an error so it can't take down the daemon
+func (s *Scheduler) runRecovered(ctx context.Context, t *task) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.errorLog.Printlnf("Task [%s] panicked: %v\n%s", t.info.Name, r, debug.Stack())
+			err = fmt.Errorf("task panicked: %v", r)
+		}
+	}()
+	return t.info.Run(ctx)
+}
+
+// Run a task, waiting for it to finish or time out
+func (s *Scheduler) runTask(ctx context.Context, t *task) {
+	s.lock.Lock()
+	t.isRunning = true
+	t.lastRunTime = time.Now()
+	s.lock.Unlock()
+
+	// The context is only cancelled once the result is in, so a task that returns normally can't be mistaken for one that timed out
+	taskCtx := ctx
+	cancel := func() {}
+	if t.info.Timeout > 0 {
+		taskCtx, cancel = context.WithTimeout(ctx, t.info.Timeout)
+	}
+	defer cancel()
+
+	// Run it in the background so a stuck task can't block the others; it won't be run again until it returns
+	done := make(chan error, 1)
+	go func() {
+		err := s.runRecovered(taskCtx, t)
+
+		s.lock.Lock()
+		t.isRunning = false
+		s.lock.Unlock()
+		done <- err
+	}()
+
+	var err error
+	var result api.TaskResult
+	select {
+	case err = <-done:
+		result = api.TaskResult_Succeeded
+		if err != nil {
+			result = api.TaskResult_Failed
+		}
+	case <-taskCtx.Done():
+		if errors.Is(taskCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			err = fmt.Errorf("task didn't finish within %s", t.info.Timeout)
+			result = api.TaskResult_TimedOut
+		} else {
+			err = taskCtx.Err()
+			result = api.TaskResult_Failed
+		}
+	}
+	if err != nil {
+		s.errorLog.Println(err)
+	}
+
+	s.lock.Lock()
+	t.lastDuration = time.Since(t.lastRunTime)
+	t.lastResult = result
+	t.lastError = ""
+	if err != nil {
+		t.lastError = err.Error()
+	}
+	t.nextRunTime = t.lastRunTime.Add(t.info.Interval)
+	if t.info.Jitter > 0 {
+		t.nextRunTime = t.nextRunTime.Add(time.Duration(rand.Int63n(int64(t.info.Jitter))))
+	}
+	status := t.getStatus()
+	s.lock.Unlock()
+	s.events.Publish(api.EventType_TaskRun, status)
+}
+
+// Get the task's status; the scheduler's lock must be held by the caller
+func (t *task) getStatus() api.TaskStatus {
+	return api.TaskStatus{
+		Name:            t.info.Name,
+		Description:     t.info.Description,
+		Interval:        t.info.Interval,
+		IsRunning:       t.isRunning,
+		LastRunTime:     t.lastRunTime,
+		LastRunDuration: t.lastDuration,
+		LastResult:      t.lastResult,
+		LastError:       t.lastError,
+		NextRunTime:     t.nextRunTime,
+	}
+}
@@ -0,0 +1,163 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+// A requirement checker with fixed results
+type fakeChecker struct {
+	walletErr error
+}
+
+func (c *fakeChecker) WaitEthClientSynced(ctx context.Context, verbose bool) error {
+	return nil
+}
+
+func (c *fakeChecker) WaitBeaconClientSynced(ctx context.Context, verbose bool) error {
+	return nil
+}
+
+func (c *fakeChecker) RequireWalletReady() error {
+	return c.walletErr
+}
+
+// Register a task and return its state
+func registerTask(t *testing.T, s *Scheduler, info TaskInfo) *task {
+	if info.Interval == 0 {
+		info.Interval = time.Hour
+	}
+	err := s.Register(info)
+	if err != nil {
+		t.Fatalf("error registering task: %s", err.Error())
+	}
+	return s.taskMap[info.Name]
+}
+
+func TestRunTaskSucceeds(t *testing.T) {
+	s := NewScheduler(&fakeChecker{}, events.NewEventBus("test"))
+	task := registerTask(t, s, TaskInfo{
+		Name:    "success",
+		Timeout: 2 * time.Minute,
+		Run: func(ctx context.Context) error {
+			return nil
+		},
+	})
+
+	// A task that returns in time must never be recorded as timed out
+	for i := 0; i < 200; i++ {
+		s.runTask(context.Background(), task)
+		if task.lastResult != api.TaskResult_Succeeded {
+			t.Fatalf("run %d: expected result %s but got %s (%s)", i, api.TaskResult_Succeeded, task.lastResult, task.lastError)
+		}
+		if task.isRunning {
+			t.Fatalf("run %d: task is still marked as running", i)
+		}
+	}
+}
+
+func TestRunTaskFails(t *testing.T) {
+	s := NewScheduler(&fakeChecker{}, events.NewEventBus("test"))
+	task := registerTask(t, s, TaskInfo{
+		Name:    "failure",
+		Timeout: 2 * time.Minute,
+		Run: func(ctx context.Context) error {
+			return errors.New("task error")
+		},
+	})
+
+	s.runTask(context.Background(), task)
+	if task.lastResult != api.TaskResult_Failed {
+		t.Fatalf("expected result %s but got %s", api.TaskResult_Failed, task.lastResult)
+	}
+	if task.lastError != "task error" {
+		t.Fatalf("expected error [task error] but got [%s]", task.lastError)
+	}
+}
+
+func TestRunTaskPanics(t *testing.T) {
+	s := NewScheduler(&fakeChecker{}, events.NewEventBus("test"))
+	task := registerTask(t, s, TaskInfo{
+		Name:    "panic",
+		Timeout: 2 * time.Minute,
+		Run: func(ctx context.Context) error {
+			panic("task panic")
+		},
+	})
+
+	s.runTask(context.Background(), task)
+	if task.lastResult != api.TaskResult_Failed {
+		t.Fatalf("expected result %s but got %s", api.TaskResult_Failed, task.lastResult)
+	}
+	if task.lastError != "task panicked: task panic" {
+		t.Fatalf("expected error [task panicked: task panic] but got [%s]", task.lastError)
+	}
+	if task.isRunning {
+		t.Fatal("task is still marked as running")
+	}
+}
+
+func TestRunTaskTimesOut(t *testing.T) {
+	s := NewScheduler(&fakeChecker{}, events.NewEventBus("test"))
+	release := make(chan struct{})
+	defer close(release)
+	task := registerTask(t, s, TaskInfo{
+		Name:    "timeout",
+		Timeout: 10 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			<-release
+			return nil
+		},
+	})
+
+	s.runTask(context.Background(), task)
+	if task.lastResult != api.TaskResult_TimedOut {
+		t.Fatalf("expected result %s but got %s", api.TaskResult_TimedOut, task.lastResult)
+	}
+}
+
+func TestRunTaskCancelled(t *testing.T) {
+	s := NewScheduler(&fakeChecker{}, events.NewEventBus("test"))
+	ctx, cancel := context.WithCancel(context.Background())
+	task := registerTask(t, s, TaskInfo{
+		Name:    "cancelled",
+		Timeout: 2 * time.Minute,
+		Run: func(ctx context.Context) error {
+			cancel()
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			return ctx.Err()
+		},
+	})
+
+	s.runTask(ctx, task)
+	if task.lastResult != api.TaskResult_Failed {
+		t.Fatalf("expected result %s but got %s", api.TaskResult_Failed, task.lastResult)
+	}
+}
+
+func TestRunDueTasksSkipsUnmetRequirements(t *testing.T) {
+	s := NewScheduler(&fakeChecker{walletErr: errors.New("wallet not ready")}, events.NewEventBus("test"))
+	ran := false
+	task := registerTask(t, s, TaskInfo{
+		Name:         "requires-wallet",
+		Requirements: Requirement_WalletReady,
+		Run: func(ctx context.Context) error {
+			ran = true
+			return nil
+		},
+	})
+
+	s.runDueTasks(context.Background())
+	if ran {
+		t.Fatal("task ran even though its requirements weren't met")
+	}
+	if task.lastResult != api.TaskResult_Skipped {
+		t.Fatalf("expected result %s but got %s", api.TaskResult_Skipped, task.lastResult)
+	}
+}
@@ -0,0 +1,290 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// The route clients can get the OpenAPI document for the daemon's API from
+	ApiSpecRoute string = "api-spec"
+)
+
+// Information about a query argument used by a route
+type ArgInfo struct {
+	// The name of the argument
+	Name string
+
+	// True if the route fails without it
+	Required bool
+
+	// True if the argument is a comma-separated list of values
+	IsBatch bool
+
+	// The type the argument is parsed into (or the type of each element, if it's a batch)
+	Type reflect.Type
+}
+
+// Information about a route, used to generate the API spec
+type RouteInfo struct {
+	// The HTTP method the route accepts
+	Method string
+
+	// The query arguments the route accepts
+	Args []ArgInfo
+
+	// The type of the request body, if the route takes one
+	BodyType reflect.Type
+
+	// The type of the response data
+	DataType reflect.Type
+
+	// The content type of the response if it isn't a JSON ApiResponse wrapper around the data
+	RawContentType string
+}
+
+var (
+	// The info for each route registered by the route registration functions
+	routeInfos    = map[*mux.Route]*RouteInfo{}
+	routeInfoLock = &sync.Mutex{}
+)
+
+// Record the info for a route so it's included in the API spec
+func SetRouteInfo(route *mux.Route, info *RouteInfo) {
+	routeInfoLock.Lock()
+	defer routeInfoLock.Unlock()
+	routeInfos[route] = info
+}
+
+// Describe a required query arg, parsed with ValidateArg or GetStringFromVars
+func Arg[ArgType any](name string) ArgInfo {
+	return ArgInfo{
+		Name:     name,
+		Required: true,
+		Type:     reflect.TypeOf((*ArgType)(nil)).Elem(),
+	}
+}
+
+// Describe an optional query arg, parsed with ValidateOptionalArg or GetOptionalStringFromVars
+func OptionalArg[ArgType any](name string) ArgInfo {
+	return ArgInfo{
+		Name: name,
+		Type: reflect.TypeOf((*ArgType)(nil)).Elem(),
+	}
+}
+
+// Describe a required query arg holding a comma-separated list of values, parsed with ValidateArgBatch
+func BatchArg[ArgType any](name string) ArgInfo {
+	return ArgInfo{
+		Name:     name,
+		Required: true,
+		IsBatch:  true,
+		Type:     reflect.TypeOf((*ArgType)(nil)).Elem(),
+	}
+}
+
+// Get the info for a GET route that takes the provided query args
+func NewGetRouteInfo[DataType any](args []ArgInfo) *RouteInfo {
+	return &RouteInfo{
+		Method:   http.MethodGet,
+		Args:     args,
+		DataType: reflect.TypeOf((*DataType)(nil)).Elem(),
+	}
+}
+
+// Get the info for a POST route
+func NewPostRouteInfo[BodyType any, DataType any]() *RouteInfo {
+	return &RouteInfo{
+		Method:   http.MethodPost,
+		BodyType: reflect.TypeOf((*BodyType)(nil)).Elem(),
+		DataType: reflect.TypeOf((*DataType)(nil)).Elem(),
+	}
+}
+
+// Serve an OpenAPI document describing every route registered with this manager on the /api-spec route
+func (m *ApiManager) RegisterApiSpec(title string, version string) {
+	var document []byte
+	var err error
+	once := &sync.Once{}
+	m.hostRouter.HandleFunc("/"+ApiSpecRoute, func(w http.ResponseWriter, r *http.Request) {
+		m.log.Printlnf("[%s] => %s", r.Method, r.URL.Path)
+		if r.Method != http.MethodGet {
+			HandleInvalidMethod(&m.log, w)
+			return
+		}
+
+		// Routes can't change once the server is running, so the document only needs to be built once
+		once.Do(func() {
+			var spec *OpenApiDocument
+			spec, err = m.GetApiSpec(title, version)
+			if err == nil {
+				document, err = json.Marshal(spec)
+			}
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			m.log.Printlnf("[%d INTERNAL_SERVER_ERROR] <= %s", http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		w.Write(document)
+		m.log.Printlnf("[%d OK]", http.StatusOK)
+	})
+}
+
+// Build an OpenAPI document describing every route registered with this manager
+func (m *ApiManager) GetApiSpec(title string, version string) (*OpenApiDocument, error) {
+	builder := newSchemaBuilder()
+	doc := &OpenApiDocument{
+		OpenApi: OpenApiVersion,
+		Info: OpenApiInfo{
+			Title:       title,
+			Description: "Every route except the event stream returns its data inside an ApiResponse object. Errors are returned as plain text.",
+			Version:     version,
+		},
+		Servers: []OpenApiServer{
+			{
+				Url:         "http://" + m.route,
+				Description: fmt.Sprintf("Served over the daemon's Unix socket at %s", m.socketPath),
+			},
+		},
+		Paths: map[string]*OpenApiPathItem{},
+	}
+
+	routeInfoLock.Lock()
+	defer routeInfoLock.Unlock()
+	err := m.hostRouter.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		info, exists := routeInfos[route]
+		if !exists {
+			return nil
+		}
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return fmt.Errorf("error getting path of route: %w", err)
+		}
+
+		item, exists := doc.Paths[path]
+		if !exists {
+			item = &OpenApiPathItem{}
+			doc.Paths[path] = item
+		}
+		operation := getOperation(builder, path, info)
+		switch info.Method {
+		case http.MethodGet:
+			item.Get = operation
+		case http.MethodPost:
+			item.Post = operation
+		default:
+			return fmt.Errorf("route %s uses unsupported method %s", path, info.Method)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking routes: %w", err)
+	}
+
+	doc.Components.Schemas = builder.components
+	return doc, nil
+}
+
+// Describe a single route
+func getOperation(builder *schemaBuilder, path string, info *RouteInfo) *OpenApiOperation {
+	trimmedPath := strings.Trim(path, "/")
+	operation := &OpenApiOperation{
+		OperationId: strings.ReplaceAll(trimmedPath, "/", "-"),
+		Parameters:  []*OpenApiParameter{},
+		Responses: map[string]*OpenApiResponse{
+			"400": {
+				Description: "The request was invalid",
+				Content:     getTextContent(),
+			},
+			"500": {
+				Description: "The daemon failed to process the request",
+				Content:     getTextContent(),
+			},
+		},
+	}
+	if index := strings.Index(trimmedPath, "/"); index > 0 {
+		operation.Tags = []string{trimmedPath[:index]}
+	}
+
+	// Query args
+	for _, arg := range info.Args {
+		param := &OpenApiParameter{
+			Name:     arg.Name,
+			In:       "query",
+			Required: arg.Required,
+			Schema:   builder.getArgSchema(arg.Type),
+		}
+		if arg.IsBatch {
+			explode := false
+			param.Style = "form"
+			param.Explode = &explode
+			param.Schema = &OpenApiSchema{
+				Type:  "array",
+				Items: param.Schema,
+			}
+		}
+		operation.Parameters = append(operation.Parameters, param)
+	}
+	sort.SliceStable(operation.Parameters, func(i, j int) bool {
+		return operation.Parameters[i].Required && !operation.Parameters[j].Required
+	})
+
+	// Request body
+	if info.BodyType != nil {
+		operation.RequestBody = &OpenApiRequestBody{
+			Required: true,
+			Content: map[string]*OpenApiMediaType{
+				"application/json": {
+					Schema: builder.getSchema(info.BodyType),
+				},
+			},
+		}
+	}
+
+	// Response
+	if info.RawContentType != "" {
+		operation.Responses["200"] = &OpenApiResponse{
+			Description: "Success",
+			Content: map[string]*OpenApiMediaType{
+				info.RawContentType: {
+					Schema: builder.getSchema(info.DataType),
+				},
+			},
+		}
+	} else {
+		operation.Responses["200"] = &OpenApiResponse{
+			Description: "Success",
+			Content: map[string]*OpenApiMediaType{
+				"application/json": {
+					Schema: &OpenApiSchema{
+						Type: "object",
+						Properties: map[string]*OpenApiSchema{
+							"data": builder.getSchema(info.DataType),
+						},
+						Required: []string{"data"},
+					},
+				},
+			},
+		}
+	}
+	return operation
+}
+
+// Get the content of a plain text response
+func getTextContent() map[string]*OpenApiMediaType {
+	return map[string]*OpenApiMediaType{
+		"text/plain": {
+			Schema: &OpenApiSchema{Type: "string"},
+		},
+	}
+}
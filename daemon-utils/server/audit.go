@@ -0,0 +1,68 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/nodeset-org/hyperdrive/daemon-utils/audit"
+)
+
+const (
+	// The most of an error response that will be saved in the audit log
+	maxAuditErrorLength int = 512
+)
+
+// Record each call to the provided routes in the audit log. Caller is the name of whoever is on the other end of this manager's socket.
+func (m *ApiManager) EnableAuditLog(auditLog *audit.AuditLog, caller string, routes []string) {
+	auditedRoutes := map[string]bool{}
+	for _, route := range routes {
+		auditedRoutes["/"+strings.TrimPrefix(route, "/")] = true
+	}
+
+	m.hostRouter.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !auditedRoutes[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Run the route, keeping track of the response status
+			recorder := &auditResponseRecorder{
+				ResponseWriter: w,
+				status:         http.StatusOK,
+			}
+			next.ServeHTTP(recorder, r)
+
+			// Record it; the arguments are never saved since they can include secrets like passwords and mnemonics
+			err := auditLog.Record(caller, r.Method, r.URL.Path, recorder.status, strings.TrimSpace(recorder.errorMessage.String()))
+			if err != nil {
+				m.log.Printlnf("WARNING: error recording call to %s in the audit log: %s", r.URL.Path, err.Error())
+			}
+		})
+	})
+}
+
+// Records the status of a response, and the start of its body if it's an error
+type auditResponseRecorder struct {
+	http.ResponseWriter
+	status       int
+	errorMessage strings.Builder
+}
+
+func (r *auditResponseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *auditResponseRecorder) Write(bytes []byte) (int, error) {
+	if r.status >= http.StatusBadRequest {
+		remaining := maxAuditErrorLength - r.errorMessage.Len()
+		if remaining > 0 {
+			if len(bytes) < remaining {
+				remaining = len(bytes)
+			}
+			r.errorMessage.Write(bytes[:remaining])
+		}
+	}
+	return r.ResponseWriter.Write(bytes)
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/audit"
+)
+
+const testDaemonRoute string = "test-daemon"
+
+// A handler with routes that succeed, fail, or return a long error
+type testAuditHandler struct{}
+
+func (h *testAuditHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/wallet/sign-tx", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	})
+	router.HandleFunc("/wallet/export", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("wallet is not loaded\n"))
+	})
+	router.HandleFunc("/wallet/masquerade", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(strings.Repeat("x", 2*maxAuditErrorLength)))
+	})
+	router.HandleFunc("/wallet/status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	})
+}
+
+// Call a route on the manager's router
+func callTestRoute(t *testing.T, mgr *ApiManager, method string, route string) int {
+	request := httptest.NewRequest(method, "http://"+testDaemonRoute+"/"+route, nil)
+	recorder := httptest.NewRecorder()
+	mgr.router.ServeHTTP(recorder, request)
+	return recorder.Code
+}
+
+func TestAuditLogMiddleware(t *testing.T) {
+	dir := t.TempDir()
+	mgr, err := NewApiServer(filepath.Join(dir, "test.sock"), []IHandler{&testAuditHandler{}}, testDaemonRoute)
+	if err != nil {
+		t.Fatalf("error creating API server: %s", err.Error())
+	}
+	auditLog, err := audit.NewAuditLog(filepath.Join(dir, "audit.log"))
+	if err != nil {
+		t.Fatalf("error creating audit log: %s", err.Error())
+	}
+	mgr.EnableAuditLog(auditLog, "stakewise", []string{"wallet/sign-tx", "wallet/export", "/wallet/masquerade"})
+
+	// Audited calls still reach the route
+	if code := callTestRoute(t, mgr, http.MethodPost, "wallet/sign-tx"); code != http.StatusOK {
+		t.Fatalf("expected sign-tx to return %d but got %d", http.StatusOK, code)
+	}
+	if code := callTestRoute(t, mgr, http.MethodGet, "wallet/export"); code != http.StatusInternalServerError {
+		t.Fatalf("expected export to return %d but got %d", http.StatusInternalServerError, code)
+	}
+	callTestRoute(t, mgr, http.MethodGet, "wallet/masquerade")
+	callTestRoute(t, mgr, http.MethodGet, "wallet/status")
+
+	entries, badLines, err := auditLog.Read()
+	if err != nil {
+		t.Fatalf("error reading audit log: %s", err.Error())
+	}
+	if len(badLines) > 0 {
+		t.Fatalf("audit log has bad lines %v", badLines)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries but got %d: %+v", len(entries), entries)
+	}
+	err = auditLog.Verify(entries)
+	if err != nil {
+		t.Fatalf("audit log failed verification: %s", err.Error())
+	}
+
+	// A successful call
+	entry := entries[0]
+	if entry.Caller != "stakewise" || entry.Method != http.MethodPost || entry.Route != "/wallet/sign-tx" || entry.Status != http.StatusOK || !entry.Success || entry.Error != "" {
+		t.Fatalf("unexpected entry for sign-tx: %+v", entry)
+	}
+
+	// A failed call records the error from the response
+	entry = entries[1]
+	if entry.Route != "/wallet/export" || entry.Status != http.StatusInternalServerError || entry.Success || entry.Error != "wallet is not loaded" {
+		t.Fatalf("unexpected entry for export: %+v", entry)
+	}
+
+	// Long errors are cut off
+	entry = entries[2]
+	if entry.Route != "/wallet/masquerade" || entry.Status != http.StatusBadRequest || len(entry.Error) != maxAuditErrorLength {
+		t.Fatalf("unexpected entry for masquerade: route %s, status %d, error length %d", entry.Route, entry.Status, len(entry.Error))
+	}
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+const (
+	// The route clients connect to for the server-sent event stream
+	EventStreamRoute string = "events"
+
+	// How often to send a comment to idle streams so the connection isn't considered dead
+	eventStreamKeepaliveInterval time.Duration = 15 * time.Second
+)
+
+// Serve the events published on the bus as a server-sent event stream on the /events route.
+// Clients can provide a comma-separated list of event types in the "types" query parameter to only receive those events.
+func (m *ApiManager) RegisterEventStream(bus *events.EventBus) {
+	// Close open streams when the server shuts down, otherwise Shutdown() would wait on them forever
+	streamCtx, cancel := context.WithCancel(context.Background())
+	m.server.RegisterOnShutdown(cancel)
+
+	route := m.hostRouter.HandleFunc("/"+EventStreamRoute, func(w http.ResponseWriter, r *http.Request) {
+		m.handleEventStream(streamCtx, bus, w, r)
+	})
+
+	// Record the route for the API spec
+	SetRouteInfo(route, &RouteInfo{
+		Method: http.MethodGet,
+		Args: []ArgInfo{
+			{
+				Name:    "types",
+				IsBatch: true,
+				Type:    reflect.TypeOf(api.EventType("")),
+			},
+		},
+		DataType:       reflect.TypeOf(api.Event{}),
+		RawContentType: "text/event-stream",
+	})
+}
+
+// Stream events to a client until it disconnects or the server shuts down
+func (m *ApiManager) handleEventStream(streamCtx context.Context, bus *events.EventBus, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		HandleInvalidMethod(&m.log, w)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("streaming is not supported by this connection"))
+		m.log.Printlnf("[%d INTERNAL_SERVER_ERROR] <= streaming is not supported by this connection", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the event type filter
+	filter := map[api.EventType]bool{}
+	typesString := r.URL.Query().Get("types")
+	if typesString != "" {
+		for _, eventType := range strings.Split(typesString, ",") {
+			filter[api.EventType(strings.TrimSpace(eventType))] = true
+		}
+	}
+
+	// Subscribe before sending the headers so no events are missed once the client sees the stream is open
+	subscription, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepalive := time.NewTicker(eventStreamKeepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-streamCtx.Done():
+			return
+		case <-keepalive.C:
+			_, err := fmt.Fprint(w, ": keepalive\n\n")
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		case event, ok := <-subscription:
+			if !ok {
+				return
+			}
+			if len(filter) > 0 && !filter[event.Type] {
+				continue
+			}
+			bytes, err := json.Marshal(event)
+			if err != nil {
+				m.log.Printlnf("error serializing event %d: %s", event.Id, err.Error())
+				continue
+			}
+			_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, bytes)
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package server
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+const (
+	// The version of the OpenAPI specification the generated documents follow
+	OpenApiVersion string = "3.0.3"
+)
+
+var (
+	// Matches the package paths in the names of generic types
+	packagePathRegex = regexp.MustCompile(`[\w.\-]+/`)
+
+	// Matches characters that aren't allowed in component names
+	invalidComponentCharRegex = regexp.MustCompile(`[^a-zA-Z0-9.\-_]`)
+
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+	byteSliceType     = reflect.TypeOf([]byte{})
+)
+
+// An OpenAPI 3 document describing a daemon's API
+type OpenApiDocument struct {
+	OpenApi    string                      `json:"openapi"`
+	Info       OpenApiInfo                 `json:"info"`
+	Servers    []OpenApiServer             `json:"servers"`
+	Paths      map[string]*OpenApiPathItem `json:"paths"`
+	Components OpenApiComponents           `json:"components"`
+}
+
+type OpenApiInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type OpenApiServer struct {
+	Url         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+type OpenApiPathItem struct {
+	Get  *OpenApiOperation `json:"get,omitempty"`
+	Post *OpenApiOperation `json:"post,omitempty"`
+}
+
+type OpenApiOperation struct {
+	OperationId string                      `json:"operationId"`
+	Summary     string                      `json:"summary,omitempty"`
+	Tags        []string                    `json:"tags,omitempty"`
+	Parameters  []*OpenApiParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenApiRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*OpenApiResponse `json:"responses"`
+}
+
+type OpenApiParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Style    string         `json:"style,omitempty"`
+	Explode  *bool          `json:"explode,omitempty"`
+	Schema   *OpenApiSchema `json:"schema"`
+}
+
+type OpenApiRequestBody struct {
+	Required bool                         `json:"required"`
+	Content  map[string]*OpenApiMediaType `json:"content"`
+}
+
+type OpenApiResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*OpenApiMediaType `json:"content,omitempty"`
+}
+
+type OpenApiMediaType struct {
+	Schema *OpenApiSchema `json:"schema"`
+}
+
+type OpenApiComponents struct {
+	Schemas map[string]*OpenApiSchema `json:"schemas"`
+}
+
+type OpenApiSchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Nullable             bool                      `json:"nullable,omitempty"`
+	Items                *OpenApiSchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenApiSchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties *OpenApiSchema            `json:"additionalProperties,omitempty"`
+}
+
+// Builds JSON schemas for Go types, collecting named structs as reusable components
+type schemaBuilder struct {
+	components map[string]*OpenApiSchema
+	names      map[reflect.Type]string
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{
+		components: map[string]*OpenApiSchema{},
+		names:      map[reflect.Type]string{},
+	}
+}
+
+// Get the schema for a type as it's serialized to JSON
+func (b *schemaBuilder) getSchema(t reflect.Type) *OpenApiSchema {
+	if t == nil {
+		return &OpenApiSchema{}
+	}
+
+	// Pointers serialize as their element, or null
+	if t.Kind() == reflect.Pointer {
+		schema := b.getSchema(t.Elem())
+		if schema.Ref != "" {
+			return schema
+		}
+		schema.Nullable = true
+		return schema
+	}
+
+	// Types with custom serialization
+	if t == timeType {
+		return &OpenApiSchema{Type: "string", Format: "date-time"}
+	}
+	if implementsMarshaler(t, jsonMarshalerType) {
+		return getMarshalerSchema(t)
+	}
+	if implementsMarshaler(t, textMarshalerType) {
+		return &OpenApiSchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &OpenApiSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &OpenApiSchema{Type: "integer", Format: "int32"}
+	case reflect.Int64:
+		return &OpenApiSchema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &OpenApiSchema{Type: "integer", Format: "uint64"}
+	case reflect.Float32:
+		return &OpenApiSchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenApiSchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenApiSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &OpenApiSchema{Type: "string", Format: "byte"}
+		}
+		return &OpenApiSchema{Type: "array", Items: b.getSchema(t.Elem())}
+	case reflect.Map:
+		return &OpenApiSchema{Type: "object", AdditionalProperties: b.getSchema(t.Elem())}
+	case reflect.Struct:
+		return b.getStructRef(t)
+	}
+
+	// Interfaces and anything else can hold any value
+	return &OpenApiSchema{}
+}
+
+// Get a reference to the component schema for a struct, building it if it hasn't been built yet
+func (b *schemaBuilder) getStructRef(t reflect.Type) *OpenApiSchema {
+	if t.Name() == "" {
+		return b.getStructSchema(t)
+	}
+	name, exists := b.names[t]
+	if !exists {
+		// Types from different packages can share a name, so number any duplicates
+		baseName := getComponentName(t)
+		name = baseName
+		for i := 2; b.components[name] != nil; i++ {
+			name = fmt.Sprintf("%s_%d", baseName, i)
+		}
+		b.names[t] = name
+
+		// Register a placeholder first so recursive types don't loop forever
+		schema := &OpenApiSchema{}
+		b.components[name] = schema
+		*schema = *b.getStructSchema(t)
+	}
+	return &OpenApiSchema{Ref: "#/components/schemas/" + name}
+}
+
+// Build the object schema for a struct's serialized fields
+func (b *schemaBuilder) getStructSchema(t reflect.Type) *OpenApiSchema {
+	schema := &OpenApiSchema{
+		Type:       "object",
+		Properties: map[string]*OpenApiSchema{},
+	}
+	b.addStructFields(t, schema)
+	return schema
+}
+
+// Add the serialized fields of a struct to an object schema, flattening embedded structs the way the JSON serializer does
+func (b *schemaBuilder) addStructFields(t reflect.Type, schema *OpenApiSchema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		// Flatten untagged embedded structs
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				b.addStructFields(fieldType, schema)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = b.getSchema(field.Type)
+		if !strings.Contains(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// Get the schema for a query argument, which is parsed from a string rather than deserialized from JSON
+func (b *schemaBuilder) getArgSchema(t reflect.Type) *OpenApiSchema {
+	if t == byteSliceType {
+		return &OpenApiSchema{Type: "string", Description: "Hex-encoded bytes"}
+	}
+	return b.getSchema(t)
+}
+
+// Check if a type or a pointer to it implements a serialization interface
+func implementsMarshaler(t reflect.Type, marshaler reflect.Type) bool {
+	return t.Implements(marshaler) || reflect.PointerTo(t).Implements(marshaler)
+}
+
+// Work out the schema for a type with custom JSON serialization by serializing its zero value
+func getMarshalerSchema(t reflect.Type) *OpenApiSchema {
+	bytes, err := json.Marshal(reflect.New(t).Interface())
+	if err != nil || len(bytes) == 0 {
+		return &OpenApiSchema{}
+	}
+	switch bytes[0] {
+	case '"':
+		return &OpenApiSchema{Type: "string"}
+	case '{':
+		return &OpenApiSchema{Type: "object"}
+	case '[':
+		return &OpenApiSchema{Type: "array", Items: &OpenApiSchema{}}
+	case 't', 'f':
+		return &OpenApiSchema{Type: "boolean"}
+	case 'n':
+		return &OpenApiSchema{Nullable: true}
+	}
+	if strings.ContainsAny(string(bytes), ".eE") {
+		return &OpenApiSchema{Type: "number"}
+	}
+	return &OpenApiSchema{Type: "integer"}
+}
+
+// Get the name of a struct's component schema, qualified by its package so types with the same name don't collide
+func getComponentName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if index := strings.LastIndex(pkg, "/"); index >= 0 {
+		pkg = pkg[index+1:]
+	}
+	name := packagePathRegex.ReplaceAllString(t.Name(), "")
+	if pkg != "" {
+		name = pkg + "." + name
+	}
+	return invalidComponentCharRegex.ReplaceAllString(name, "_")
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Only allow calls to the provided routes on this manager's socket; everything else gets a 403 Forbidden response.
+// Caller is the name of whoever is on the other end of the socket, used in the error message and log.
+func (m *ApiManager) RestrictRoutes(caller string, allowedRoutes []string) {
+	allowed := map[string]bool{}
+	for _, route := range allowedRoutes {
+		allowed["/"+strings.Trim(route, "/")] = true
+	}
+
+	m.hostRouter.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if allowed[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			route := strings.TrimPrefix(r.URL.Path, "/")
+			message := fmt.Sprintf("%s is not allowed to call %s; it's only permitted to use these routes: [%s]", caller, route, strings.Join(allowedRoutes, ", "))
+			if len(allowedRoutes) == 0 {
+				message = fmt.Sprintf("%s is not allowed to call %s; it isn't permitted to use any routes", caller, route)
+			}
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(message))
+			m.log.Printlnf("[%d FORBIDDEN] <= %s", http.StatusForbidden, message)
+		})
+	})
+}
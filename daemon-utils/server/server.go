@@ -28,9 +28,11 @@ type ApiManager struct {
 	log        log.ColorLogger
 	handlers   []IHandler
 	socketPath string
+	route      string
 	socket     net.Listener
 	server     http.Server
 	router     *mux.Router
+	hostRouter *mux.Router
 }
 
 func NewApiServer(socketPath string, handlers []IHandler, route string) (*ApiManager, error) {
@@ -42,6 +44,7 @@ func NewApiServer(socketPath string, handlers []IHandler, route string) (*ApiMan
 		log:        log.NewColorLogger(ApiLogColor),
 		handlers:   handlers,
 		socketPath: socketPath,
+		route:      route,
 		router:     router,
 		server: http.Server{
 			Handler: router,
@@ -49,9 +52,9 @@ func NewApiServer(socketPath string, handlers []IHandler, route string) (*ApiMan
 	}
 
 	// Register each route
-	hyperdriveRouter := router.Host(route).Subrouter()
+	mgr.hostRouter = router.Host(route).Subrouter()
 	for _, handler := range mgr.handlers {
-		handler.RegisterRoutes(hyperdriveRouter)
+		handler.RegisterRoutes(mgr.hostRouter)
 	}
 
 	// Create the socket directory
@@ -131,6 +131,7 @@ func HandleResponse(log *log.ColorLogger, w http.ResponseWriter, response any, e
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte(err.Error()))
 		log.Printlnf("[%d INTERNAL_SERVER_ERROR] <= %s", http.StatusInternalServerError, err.Error())
+		return
 	}
 
 	// Write the serialized response
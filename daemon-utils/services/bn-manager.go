@@ -11,6 +11,7 @@ import (
 	"github.com/fatih/color"
 	beaconutils "github.com/nodeset-org/eth-utils/beacon"
 	"github.com/nodeset-org/hyperdrive/daemon-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/types"
 	"github.com/nodeset-org/hyperdrive/shared/types/api"
@@ -22,6 +23,7 @@ type BeaconClientManager struct {
 	primaryBc       types.IBeaconClient
 	fallbackBc      types.IBeaconClient
 	logger          log.ColorLogger
+	events          *events.EventBus
 	primaryReady    bool
 	fallbackReady   bool
 	ignoreSyncCheck bool
@@ -37,11 +39,11 @@ type bcFunction1 func(types.IBeaconClient) (interface{}, error)
 type bcFunction2 func(types.IBeaconClient) (interface{}, interface{}, error)
 
 // Creates a new BeaconClientManager instance based on the Hyperdrive config
-func NewBeaconClientManager(cfg *config.HyperdriveConfig) (*BeaconClientManager, error) {
+func NewBeaconClientManager(cfg *config.HyperdriveConfig, bus *events.EventBus) (*BeaconClientManager, error) {
 	// Primary BN
 	var primaryProvider string
 	if cfg.IsLocalMode() {
-		primaryProvider = fmt.Sprintf("http://%s:%d", config.ContainerID_BeaconNode, cfg.LocalBeaconConfig.HttpPort.Value)
+		primaryProvider = cfg.GetBnHttpEndpoint()
 	} else if cfg.ClientMode.Value == config.ClientMode_External {
 		primaryProvider = cfg.ExternalBeaconConfig.HttpUrl.Value
 	} else {
@@ -65,6 +67,7 @@ func NewBeaconClientManager(cfg *config.HyperdriveConfig) (*BeaconClientManager,
 		primaryBc:     primaryBc,
 		fallbackBc:    fallbackBc,
 		logger:        log.NewColorLogger(color.FgHiBlue),
+		events:        bus,
 		primaryReady:  true,
 		fallbackReady: fallbackBc != nil,
 	}, nil
@@ -137,6 +140,17 @@ func (m *BeaconClientManager) GetBeaconBlock(ctx context.Context, blockId string
 	return result1.(types.BeaconBlock), result2.(bool), nil
 }
 
+// Get the header of a Beacon chain block
+func (m *BeaconClientManager) GetBeaconBlockHeader(ctx context.Context, blockId string) (types.BeaconBlockHeader, bool, error) {
+	result1, result2, err := m.runFunction2(func(client types.IBeaconClient) (interface{}, interface{}, error) {
+		return client.GetBeaconBlockHeader(ctx, blockId)
+	})
+	if err != nil {
+		return types.BeaconBlockHeader{}, false, err
+	}
+	return result1.(types.BeaconBlockHeader), result2.(bool), nil
+}
+
 // Get the Beacon chain's head information
 func (m *BeaconClientManager) GetBeaconHead(ctx context.Context) (types.BeaconHead, error) {
 	result, err := m.runFunction1(func(client types.IBeaconClient) (interface{}, error) {
@@ -148,6 +162,17 @@ func (m *BeaconClientManager) GetBeaconHead(ctx context.Context) (types.BeaconHe
 	return result.(types.BeaconHead), nil
 }
 
+// Get the justified and finalized checkpoints for a state
+func (m *BeaconClientManager) GetFinalityCheckpoints(ctx context.Context, stateId string) (types.FinalityCheckpoints, error) {
+	result, err := m.runFunction1(func(client types.IBeaconClient) (interface{}, error) {
+		return client.GetFinalityCheckpoints(ctx, stateId)
+	})
+	if err != nil {
+		return types.FinalityCheckpoints{}, err
+	}
+	return result.(types.FinalityCheckpoints), nil
+}
+
 // Get a validator's status by its index
 func (m *BeaconClientManager) GetValidatorStatusByIndex(ctx context.Context, index string, opts *types.ValidatorStatusOptions) (types.ValidatorStatus, error) {
 	result, err := m.runFunction1(func(client types.IBeaconClient) (interface{}, error) {
@@ -340,6 +365,7 @@ func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
 				m.primaryReady = false
+				m.publishFailover(true, err)
 				return m.runFunction0(function)
 			}
 			// If it's a different error, just return it
@@ -357,6 +383,7 @@ func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
 				m.fallbackReady = false
+				m.publishFailover(false, err)
 				return fmt.Errorf("all Beacon clients failed")
 			}
 
@@ -382,6 +409,7 @@ func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, e
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
 				m.primaryReady = false
+				m.publishFailover(true, err)
 				return m.runFunction1(function)
 			}
 			// If it's a different error, just return it
@@ -399,6 +427,7 @@ func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, e
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
 				m.fallbackReady = false
+				m.publishFailover(false, err)
 				return nil, fmt.Errorf("all Beacon clients failed")
 			}
 			// If it's a different error, just return it
@@ -424,6 +453,7 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Primary Beacon client request failed (%s), using fallback...", err.Error())
 				m.primaryReady = false
+				m.publishFailover(true, err)
 				return m.runFunction2(function)
 			}
 			// If it's a different error, just return it
@@ -441,6 +471,7 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("WARNING: Fallback Beacon client request failed (%s)", err.Error())
 				m.fallbackReady = false
+				m.publishFailover(false, err)
 				return nil, nil, fmt.Errorf("all Beacon clients failed")
 			}
 			// If it's a different error, just return it
@@ -454,6 +485,16 @@ func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, i
 
 }
 
+// Publish an event when a client is marked as unavailable after a failed request
+func (m *BeaconClientManager) publishFailover(isPrimary bool, err error) {
+	m.events.Publish(api.EventType_ClientFailover, api.ClientFailoverEvent{
+		Client:        "beacon",
+		IsPrimary:     isPrimary,
+		UsingFallback: isPrimary && m.fallbackReady,
+		Error:         err.Error(),
+	})
+}
+
 // Returns true if the error was a connection failure and a backup client is available
 func (m *BeaconClientManager) isDisconnected(err error) bool {
 	var sysErr syscall.Errno
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/fatih/color"
 	"github.com/nodeset-org/eth-utils/eth"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/types/api"
 	"github.com/nodeset-org/hyperdrive/shared/utils"
@@ -29,6 +30,7 @@ type ExecutionClientManager struct {
 	primaryEc       *ethclient.Client
 	fallbackEc      *ethclient.Client
 	logger          log.ColorLogger
+	events          *events.EventBus
 	primaryReady    bool
 	fallbackReady   bool
 	expectedChainID uint
@@ -38,7 +40,7 @@ type ExecutionClientManager struct {
 type ecFunction func(*ethclient.Client) (interface{}, error)
 
 // Creates a new ExecutionClientManager instance based on the Hyperdrive config
-func NewExecutionClientManager(cfg *config.HyperdriveConfig) (*ExecutionClientManager, error) {
+func NewExecutionClientManager(cfg *config.HyperdriveConfig, bus *events.EventBus) (*ExecutionClientManager, error) {
 	primaryEcUrl := cfg.GetEcHttpEndpoint()
 	primaryEc, err := ethclient.Dial(primaryEcUrl)
 	if err != nil {
@@ -66,6 +68,7 @@ func NewExecutionClientManager(cfg *config.HyperdriveConfig) (*ExecutionClientMa
 		primaryEc:       primaryEc,
 		fallbackEc:      fallbackEc,
 		logger:          log.NewColorLogger(color.FgYellow),
+		events:          bus,
 		primaryReady:    true,
 		fallbackReady:   fallbackEc != nil,
 		expectedChainID: chainID,
@@ -458,6 +461,7 @@ func (m *ExecutionClientManager) runFunction(ctx context.Context, function ecFun
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("Primary Execution client request failed (%s), using fallback...", err.Error())
 				m.primaryReady = false
+				m.publishFailover(true, err)
 				return m.runFunction(ctx, function)
 			}
 
@@ -477,6 +481,7 @@ func (m *ExecutionClientManager) runFunction(ctx context.Context, function ecFun
 				// If it's disconnected, log it and try the fallback
 				m.logger.Printlnf("Fallback Execution client request failed (%s)", err.Error())
 				m.fallbackReady = false
+				m.publishFailover(false, err)
 				return nil, fmt.Errorf("all Execution clients failed")
 			}
 
@@ -491,6 +496,16 @@ func (m *ExecutionClientManager) runFunction(ctx context.Context, function ecFun
 	return nil, fmt.Errorf("no Execution clients were ready")
 }
 
+// Publish an event when a client is marked as unavailable after a failed request
+func (m *ExecutionClientManager) publishFailover(isPrimary bool, err error) {
+	m.events.Publish(api.EventType_ClientFailover, api.ClientFailoverEvent{
+		Client:        "execution",
+		IsPrimary:     isPrimary,
+		UsingFallback: isPrimary && m.fallbackReady,
+		Error:         err.Error(),
+	})
+}
+
 // Returns true if the error was a connection failure and a backup client is available
 func (m *ExecutionClientManager) isDisconnected(err error) bool {
 	var sysErr syscall.Errno
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/nodeset-org/eth-utils/eth"
+	"github.com/nodeset-org/hyperdrive/shared/types"
 )
 
 const (
@@ -26,11 +27,37 @@ var (
 )
 
 func (sp *ServiceProvider[_]) RequireNodeAddress() error {
-	return fmt.Errorf("NYI")
+	status, err := sp.getWalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.Address.HasAddress {
+		return errors.New("The node currently does not have an address set. Please run 'hyperdrive wallet init' and try again.")
+	}
+	return nil
 }
 
 func (sp *ServiceProvider[_]) RequireWalletReady() error {
-	return fmt.Errorf("NYI")
+	status, err := sp.getWalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.Address.HasAddress {
+		return errors.New("The node currently does not have an address set. Please run 'hyperdrive wallet init' and try again.")
+	}
+	if !status.Wallet.IsLoaded {
+		if status.Wallet.IsOnDisk {
+			if !status.Password.IsPasswordSaved {
+				return errors.New("The node has a node wallet on disk but does not have the password for it loaded. Please run `hyperdrive wallet set-password` to load it.")
+			}
+			return errors.New("The node has a node wallet and a password on disk but there was an error loading it - perhaps the password is incorrect? Please check the daemon logs for more information.")
+		}
+		return errors.New("The node currently does not have a node wallet keystore. Please run 'hyperdrive wallet init' and try again.")
+	}
+	if status.Wallet.WalletAddress != status.Address.NodeAddress {
+		return errors.New("The node's wallet keystore does not match the node address. This node is currently in read-only mode.")
+	}
+	return nil
 }
 
 func (sp *ServiceProvider[_]) RequireEthClientSynced(ctx context.Context) error {
@@ -69,6 +96,15 @@ func (sp *ServiceProvider[_]) WaitBeaconClientSynced(ctx context.Context, verbos
 
 // Check if the primary and fallback Execution clients are synced
 // TODO: Move this into ec-manager and stop exposing the primary and fallback directly...
+// Get the status of the node wallet from the Hyperdrive daemon
+func (sp *ServiceProvider[_]) getWalletStatus() (types.WalletStatus, error) {
+	response, err := sp.hdClient.Wallet.Status()
+	if err != nil {
+		return types.WalletStatus{}, fmt.Errorf("error getting wallet status from Hyperdrive: %w", err)
+	}
+	return response.Data.WalletStatus, nil
+}
+
 func (sp *ServiceProvider[_]) checkExecutionClientStatus(ctx context.Context) (bool, eth.IExecutionClient, error) {
 	// Check the EC status
 	ecMgr := sp.ecManager
@@ -10,6 +10,9 @@ import (
 	"github.com/fatih/color"
 	"github.com/nodeset-org/eth-utils/eth"
 	"github.com/nodeset-org/hyperdrive/client"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/scheduler"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/utils"
 	"github.com/nodeset-org/hyperdrive/shared/utils/log"
@@ -32,6 +35,9 @@ type ServiceProvider[ConfigType config.IModuleConfig] struct {
 	queryMgr     *eth.QueryManager
 	resources    *utils.Resources
 	signer       *ModuleSigner
+	notifier     *notifications.Notifier
+	scheduler    *scheduler.Scheduler
+	events       *events.EventBus
 
 	// TODO: find a better place for this than the common service provider
 	apiLogger *log.ColorLogger
@@ -78,17 +84,29 @@ func NewServiceProvider[ConfigType config.IModuleConfig](moduleDir string, facto
 	// Logger
 	apiLogger := log.NewColorLogger(apiLogColor)
 
+	// Settings saved by older versions are only warned about, so nodes with settings those versions accepted keep running after upgrading
+	err = config.JoinValidationErrors(hdCfg.Validate([]config.IModuleConfig{moduleCfg}))
+	if err != nil {
+		if !hdCfg.IsFromOlderVersion() {
+			return nil, fmt.Errorf("config for module [%s] is invalid: %w", moduleName, err)
+		}
+		apiLogger.Printlnf("WARNING: config for module [%s] is invalid, please correct it with `hyperdrive service config`: %s", moduleName, err.Error())
+	}
+
 	// Resources
 	resources := utils.NewResources(hdCfg.Network.Value)
 
+	// Event bus
+	bus := events.NewEventBus(moduleName)
+
 	// EC Manager
-	ecManager, err := NewExecutionClientManager(hdCfg)
+	ecManager, err := NewExecutionClientManager(hdCfg, bus)
 	if err != nil {
 		return nil, fmt.Errorf("error creating executon client manager: %w", err)
 	}
 
 	// Beacon manager
-	bcManager, err := NewBeaconClientManager(hdCfg)
+	bcManager, err := NewBeaconClientManager(hdCfg, bus)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Beacon client manager: %w", err)
 	}
@@ -115,6 +133,9 @@ func NewServiceProvider[ConfigType config.IModuleConfig](moduleDir string, facto
 	// Signer
 	signer := NewModuleSigner(hdClient)
 
+	// Notifier
+	notifier := notifications.NewNotifier(hdCfg, moduleName)
+
 	// Create the provider
 	provider := &ServiceProvider[ConfigType]{
 		moduleDir:    moduleDir,
@@ -130,7 +151,12 @@ func NewServiceProvider[ConfigType config.IModuleConfig](moduleDir string, facto
 		queryMgr:     queryMgr,
 		apiLogger:    &apiLogger,
 		signer:       signer,
+		notifier:     notifier,
+		events:       bus,
 	}
+
+	// Task scheduler
+	provider.scheduler = scheduler.NewScheduler(provider, bus)
 	return provider, nil
 }
 
@@ -186,6 +212,18 @@ func (p *ServiceProvider[_]) GetSigner() *ModuleSigner {
 	return p.signer
 }
 
+func (p *ServiceProvider[_]) GetNotifier() *notifications.Notifier {
+	return p.notifier
+}
+
+func (p *ServiceProvider[_]) GetEventBus() *events.EventBus {
+	return p.events
+}
+
+func (p *ServiceProvider[_]) GetTaskScheduler() *scheduler.Scheduler {
+	return p.scheduler
+}
+
 func (p *ServiceProvider[_]) GetApiLogger() *log.ColorLogger {
 	return p.apiLogger
 }
@@ -77,6 +77,11 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 	}, nil
 }
 
+// Verify the signature of a deposit against the deposit domain for the provided genesis fork version
+func VerifyDepositSignature(genesisForkVersion []byte, depositAmount uint64, pubkey beacon.ValidatorPubkey, withdrawalCredentials common.Hash, signature beacon.ValidatorSignature) error {
+	return validateDepositInfo(genesisForkVersion, depositAmount, pubkey, withdrawalCredentials, signature)
+}
+
 func validateDepositInfo(genesisForkVersion []byte, depositAmount uint64, pubkey beacon.ValidatorPubkey, withdrawalCredentials common.Hash, signature beacon.ValidatorSignature) error {
 
 	// Get the deposit domain based on the eth2 config
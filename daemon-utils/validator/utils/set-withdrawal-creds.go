@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"strconv"
 	"strings"
@@ -21,7 +22,14 @@ func GetWithdrawalKey(mnemonic string, validatorKeyPath string) (*eth2types.BLSP
 	return withdrawalKey, nil
 }
 
-// Get a voluntary exit message signature for a given validator key and index
+// Get the 0x00 BLS withdrawal credentials that correspond to a withdrawal public key
+func GetBlsWithdrawalCredentials(withdrawalPubkey []byte) common.Hash {
+	creds := common.Hash(sha256.Sum256(withdrawalPubkey))
+	creds[0] = 0x00 // BLS_WITHDRAWAL_PREFIX
+	return creds
+}
+
+// Get a withdrawal credentials change message signature for a given withdrawal key and validator index
 func GetSignedWithdrawalCredsChangeMessage(withdrawalKey *eth2types.BLSPrivateKey, validatorIndex string, newWithdrawalAddress common.Address, signatureDomain []byte) (beacon.ValidatorSignature, error) {
 	// Get the withdrawal pubkey
 	withdrawalPubkey := withdrawalKey.PublicKey().Marshal()
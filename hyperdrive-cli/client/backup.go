@@ -0,0 +1,411 @@
+package client
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+	"github.com/nodeset-org/hyperdrive/shared"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// The current version of the backup file format
+	backupFormatVersion int = 1
+
+	// Paths inside of the backup archive
+	backupManifestPath string = "manifest.json"
+	backupConfigDir    string = "config"
+	backupDataDir      string = "data"
+
+	// Permissions for restored files
+	backupDirMode  fs.FileMode = 0700
+	backupFileMode fs.FileMode = 0600
+)
+
+// Metadata about a node backup
+type BackupManifest struct {
+	FormatVersion     int             `json:"formatVersion"`
+	HyperdriveVersion string          `json:"hyperdriveVersion"`
+	Network           config.Network  `json:"network"`
+	NodeAddress       *common.Address `json:"nodeAddress,omitempty"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	Modules           []string        `json:"modules"`
+	Files             []string        `json:"files"`
+}
+
+// A decrypted node backup, ready to be restored
+type NodeBackup struct {
+	Manifest BackupManifest
+	Config   *GlobalConfig
+
+	// Map of file paths relative to the user data directory to their contents
+	dataFiles map[string]backupEntry
+}
+
+// The encrypted backup file saved to disk
+type backupFile struct {
+	FormatVersion int                     `json:"formatVersion"`
+	Crypto        gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+// A single file in the backup archive
+type backupEntry struct {
+	mode fs.FileMode
+	data []byte
+}
+
+// Create an encrypted backup of the config, node wallet, and module data (including the validator keys and slashing protection databases)
+func (c *HyperdriveClient) CreateBackup(cfg *GlobalConfig, password string) ([]byte, *BackupManifest, error) {
+	entries := map[string]backupEntry{}
+
+	// Add the config
+	configPath, err := homedir.Expand(filepath.Join(c.Context.ConfigPath, SettingsFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error expanding settings file path: %w", err)
+	}
+	err = addBackupFile(entries, configPath, path.Join(backupConfigDir, SettingsFile))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Add the node wallet files
+	dataPath, err := homedir.Expand(cfg.Hyperdrive.UserDataPath.Value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error expanding user data path: %w", err)
+	}
+	for _, filename := range []string{config.UserAddressFilename, config.UserWalletDataFilename, config.UserPasswordFilename} {
+		filePath := filepath.Join(dataPath, filename)
+		_, err := os.Stat(filePath)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		err = addBackupFile(entries, filePath, path.Join(backupDataDir, filename))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Add the module data directories
+	modulesPath := filepath.Join(dataPath, config.ModulesName)
+	err = filepath.WalkDir(modulesPath, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && filePath == modulesPath {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			// Skip directories, sockets, and symlinks
+			return nil
+		}
+		relPath, err := filepath.Rel(dataPath, filePath)
+		if err != nil {
+			return fmt.Errorf("error getting relative path for [%s]: %w", filePath, err)
+		}
+		return addBackupFile(entries, filePath, path.Join(backupDataDir, filepath.ToSlash(relPath)))
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading module data: %w", err)
+	}
+
+	// Create the manifest
+	manifest := &BackupManifest{
+		FormatVersion:     backupFormatVersion,
+		HyperdriveVersion: shared.HyperdriveVersion,
+		Network:           cfg.Hyperdrive.Network.Value,
+		CreatedAt:         time.Now().UTC(),
+		Modules:           cfg.GetEnabledModuleConfigNames(),
+		Files:             []string{},
+	}
+	if entry, exists := entries[path.Join(backupDataDir, config.UserAddressFilename)]; exists {
+		address := common.HexToAddress(strings.TrimSpace(string(entry.data)))
+		manifest.NodeAddress = &address
+	}
+	for name := range entries {
+		manifest.Files = append(manifest.Files, name)
+	}
+	sort.Strings(manifest.Files)
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error serializing backup manifest: %w", err)
+	}
+	entries[backupManifestPath] = backupEntry{mode: backupFileMode, data: manifestBytes}
+
+	// Archive and encrypt everything
+	archive, err := createBackupArchive(entries)
+	if err != nil {
+		return nil, nil, err
+	}
+	cryptoJson, err := gethkeystore.EncryptDataV3(archive, []byte(password), gethkeystore.StandardScryptN, gethkeystore.StandardScryptP)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error encrypting backup: %w", err)
+	}
+	backupBytes, err := json.Marshal(backupFile{
+		FormatVersion: backupFormatVersion,
+		Crypto:        cryptoJson,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error serializing backup: %w", err)
+	}
+	return backupBytes, manifest, nil
+}
+
+// Decrypt a backup and make sure its contents are consistent with each other
+func (c *HyperdriveClient) ReadBackup(backupBytes []byte, password string) (*NodeBackup, error) {
+	// Decrypt the archive
+	var file backupFile
+	err := json.Unmarshal(backupBytes, &file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing backup file: %w", err)
+	}
+	if file.FormatVersion != backupFormatVersion {
+		return nil, fmt.Errorf("backup file has format version %d but this version of Hyperdrive only supports version %d", file.FormatVersion, backupFormatVersion)
+	}
+	archive, err := gethkeystore.DecryptDataV3(file.Crypto, password)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting backup (is the password correct?): %w", err)
+	}
+	entries, err := readBackupArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the manifest
+	manifestEntry, exists := entries[backupManifestPath]
+	if !exists {
+		return nil, fmt.Errorf("backup is missing its manifest")
+	}
+	backup := &NodeBackup{
+		dataFiles: map[string]backupEntry{},
+	}
+	err = json.Unmarshal(manifestEntry.data, &backup.Manifest)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing backup manifest: %w", err)
+	}
+	for _, name := range backup.Manifest.Files {
+		if _, exists := entries[name]; !exists {
+			return nil, fmt.Errorf("backup is missing [%s], which is listed in its manifest", name)
+		}
+	}
+
+	// Read the config
+	configEntry, exists := entries[path.Join(backupConfigDir, SettingsFile)]
+	if !exists {
+		return nil, fmt.Errorf("backup is missing the Hyperdrive config")
+	}
+	var settings map[string]any
+	err = yaml.Unmarshal(configEntry.data, &settings)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing backed up config: %w", err)
+	}
+	hdCfg := config.NewHyperdriveConfig(c.Context.ConfigPath)
+	err = hdCfg.Deserialize(settings)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing backed up config: %w", err)
+	}
+	hdCfg.HyperdriveUserDirectory = c.Context.ConfigPath // The config directory may be different on this machine
+	backup.Config = NewGlobalConfig(hdCfg)
+	err = backup.Config.DeserializeModules()
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing backed up module configs: %w", err)
+	}
+	if backup.Config.Hyperdrive.Network.Value != backup.Manifest.Network {
+		return nil, fmt.Errorf("backed up config is for network [%s] but the backup manifest is for network [%s]", backup.Config.Hyperdrive.Network.Value, backup.Manifest.Network)
+	}
+
+	// Collect the data files
+	dataPrefix := backupDataDir + "/"
+	for name, entry := range entries {
+		if !strings.HasPrefix(name, dataPrefix) {
+			continue
+		}
+		backup.dataFiles[strings.TrimPrefix(name, dataPrefix)] = entry
+	}
+
+	// Make sure the node address matches the manifest
+	addressEntry, hasAddress := backup.dataFiles[config.UserAddressFilename]
+	switch {
+	case hasAddress && backup.Manifest.NodeAddress == nil:
+		return nil, fmt.Errorf("backup has a node address file but the manifest doesn't have a node address")
+	case !hasAddress && backup.Manifest.NodeAddress != nil:
+		return nil, fmt.Errorf("backup manifest has node address %s but the backup is missing its node address file", backup.Manifest.NodeAddress.Hex())
+	case hasAddress:
+		address := common.HexToAddress(strings.TrimSpace(string(addressEntry.data)))
+		if address != *backup.Manifest.NodeAddress {
+			return nil, fmt.Errorf("backup has node address %s but its manifest has node address %s", address.Hex(), backup.Manifest.NodeAddress.Hex())
+		}
+	}
+
+	// Make sure the wallet keystore can be parsed
+	if walletEntry, exists := backup.dataFiles[config.UserWalletDataFilename]; exists {
+		var walletData types.WalletData
+		err = json.Unmarshal(walletEntry.data, &walletData)
+		if err != nil {
+			return nil, fmt.Errorf("backed up node wallet is corrupt: %w", err)
+		}
+	}
+
+	return backup, nil
+}
+
+// Get the node address currently stored on this machine, or nil if there isn't one
+func (c *HyperdriveClient) GetStoredNodeAddress(cfg *GlobalConfig) (*common.Address, error) {
+	dataPath, err := homedir.Expand(cfg.Hyperdrive.UserDataPath.Value)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding user data path: %w", err)
+	}
+	bytes, err := os.ReadFile(filepath.Join(dataPath, config.UserAddressFilename))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading node address file: %w", err)
+	}
+	address := common.HexToAddress(strings.TrimSpace(string(bytes)))
+	return &address, nil
+}
+
+// Write the contents of a backup to disk, replacing the current config and data files
+func (c *HyperdriveClient) RestoreBackup(backup *NodeBackup) error {
+	// Restore the data files
+	dataPath, err := homedir.Expand(backup.Config.Hyperdrive.UserDataPath.Value)
+	if err != nil {
+		return fmt.Errorf("error expanding user data path: %w", err)
+	}
+	for name, entry := range backup.dataFiles {
+		filePath := filepath.Join(dataPath, filepath.FromSlash(name))
+		err := os.MkdirAll(filepath.Dir(filePath), backupDirMode)
+		if err != nil {
+			return fmt.Errorf("error creating directory for [%s]: %w", filePath, err)
+		}
+		err = os.WriteFile(filePath, entry.data, entry.mode)
+		if err != nil {
+			return fmt.Errorf("error restoring [%s]: %w", filePath, err)
+		}
+	}
+
+	// Restore the config last so a failure above doesn't leave a config pointing to missing data
+	err = c.SaveConfig(backup.Config)
+	if err != nil {
+		return fmt.Errorf("error saving restored config: %w", err)
+	}
+	c.cfg = backup.Config
+	c.isNewCfg = false
+	return nil
+}
+
+// Read a file from disk and add it to the backup entries
+func addBackupFile(entries map[string]backupEntry, filePath string, name string) error {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("error reading [%s]: %w", filePath, err)
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("error reading [%s]: %w", filePath, err)
+	}
+	entries[name] = backupEntry{
+		mode: info.Mode().Perm(),
+		data: data,
+	}
+	return nil
+}
+
+// Serialize the backup entries into a gzipped tarball
+func createBackupArchive(entries map[string]backupEntry) ([]byte, error) {
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buffer := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, name := range names {
+		entry := entries[name]
+		err := tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     int64(entry.mode),
+			Size:     int64(len(entry.data)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error adding [%s] to backup: %w", name, err)
+		}
+		_, err = tarWriter.Write(entry.data)
+		if err != nil {
+			return nil, fmt.Errorf("error adding [%s] to backup: %w", name, err)
+		}
+	}
+	err := tarWriter.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error finalizing backup archive: %w", err)
+	}
+	err = gzipWriter.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error compressing backup archive: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// Deserialize a gzipped tarball into backup entries
+func readBackupArchive(archive []byte) (map[string]backupEntry, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing backup archive: %w", err)
+	}
+	defer gzipReader.Close()
+
+	entries := map[string]backupEntry{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading backup archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// Don't allow paths that could escape the restore directories
+		name := path.Clean(header.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("backup archive contains an invalid path [%s]", header.Name)
+		}
+
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("error reading [%s] from backup archive: %w", name, err)
+		}
+		mode := fs.FileMode(header.Mode).Perm()
+		if mode == 0 {
+			mode = backupFileMode
+		}
+		entries[name] = backupEntry{
+			mode: mode,
+			data: data,
+		}
+	}
+	return entries, nil
+}
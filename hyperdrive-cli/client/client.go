@@ -50,6 +50,9 @@ func NewHyperdriveClientFromCtx(c *cli.Context) *HyperdriveClient {
 		Api:     client.NewApiClient(config.HyperdriveDaemonRoute, socketPath, snCtx.DebugEnabled),
 		Context: snCtx,
 	}
+	if snCtx.ResponseHook != nil {
+		client.Api.SetResponseHook(snCtx.ResponseHook)
+	}
 	return client
 }
 
@@ -62,6 +65,9 @@ func NewStakewiseClientFromCtx(c *cli.Context) *StakewiseClient {
 		Api:     swclient.NewApiClient(swconfig.ModuleName, socketPath, snCtx.DebugEnabled),
 		Context: snCtx,
 	}
+	if snCtx.ResponseHook != nil {
+		client.Api.SetResponseHook(snCtx.ResponseHook)
+	}
 	return client
 }
 
@@ -106,6 +112,8 @@ func (c *HyperdriveClient) WithReady() (*HyperdriveClient, error) {
 
 // Check the status of the Execution and Beacon Node(s) and provision the API with them
 func (c *HyperdriveClient) checkClientStatus() (bool, error) {
+	out := c.Context.Output
+
 	// Check if the primary clients are up, synced, and able to respond to requests - if not, forces the use of the fallbacks for this command
 	response, err := c.Api.Service.ClientStatus()
 	if err != nil {
@@ -131,17 +139,17 @@ func (c *HyperdriveClient) checkClientStatus() (bool, error) {
 	if ecMgrStatus.FallbackEnabled && bcMgrStatus.FallbackEnabled {
 		// Fallback EC and CC are good
 		if ecMgrStatus.FallbackClientStatus.IsSynced && bcMgrStatus.FallbackClientStatus.IsSynced {
-			fmt.Printf("%sNOTE: primary clients are not ready, using fallback clients...\n\tPrimary EC status: %s\n\tPrimary CC status: %s%s\n\n", terminal.ColorYellow, primaryEcStatus, primaryBcStatus, terminal.ColorReset)
+			fmt.Fprintf(out, "%sNOTE: primary clients are not ready, using fallback clients...\n\tPrimary EC status: %s\n\tPrimary CC status: %s%s\n\n", terminal.ColorYellow, primaryEcStatus, primaryBcStatus, terminal.ColorReset)
 			//c.SetClientStatusFlags(true, true)
 			return true, nil
 		}
 
 		// Both pairs aren't ready
-		fmt.Printf("Error: neither primary nor fallback client pairs are ready.\n\tPrimary EC status: %s\n\tFallback EC status: %s\n\tPrimary CC status: %s\n\tFallback CC status: %s\n", primaryEcStatus, fallbackEcStatus, primaryBcStatus, fallbackBcStatus)
+		fmt.Fprintf(out, "Error: neither primary nor fallback client pairs are ready.\n\tPrimary EC status: %s\n\tFallback EC status: %s\n\tPrimary CC status: %s\n\tFallback CC status: %s\n", primaryEcStatus, fallbackEcStatus, primaryBcStatus, fallbackBcStatus)
 		return false, nil
 	}
 
 	// Primary isn't ready and fallback isn't enabled
-	fmt.Printf("Error: primary client pair isn't ready and fallback clients aren't enabled.\n\tPrimary EC status: %s\n\tPrimary CC status: %s\n", primaryEcStatus, primaryBcStatus)
+	fmt.Fprintf(out, "Error: primary client pair isn't ready and fallback clients aren't enabled.\n\tPrimary EC status: %s\n\tPrimary CC status: %s\n", primaryEcStatus, primaryBcStatus)
 	return false, nil
 }
@@ -132,7 +132,7 @@ func (c *HyperdriveClient) checkIfCommandExists(command string) (bool, error) {
 func (c *HyperdriveClient) printOutput(cmdText string) error {
 	// Initialize command
 	cmd := c.newCommand(cmdText)
-	cmd.SetStdout(os.Stdout)
+	cmd.SetStdout(c.Context.Output)
 	cmd.SetStderr(os.Stderr)
 
 	// Start the command
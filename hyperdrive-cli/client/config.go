@@ -58,6 +58,15 @@ func (c *HyperdriveClient) LoadBackupConfig() (*GlobalConfig, error) {
 	return LoadConfigFromFile(expandedPath)
 }
 
+// Save a copy of the config as the backup config
+func (c *HyperdriveClient) SaveBackupConfig(cfg *GlobalConfig) error {
+	settingsFileDirectoryPath, err := homedir.Expand(c.Context.ConfigPath)
+	if err != nil {
+		return err
+	}
+	return SaveConfig(cfg, settingsFileDirectoryPath, BackupSettingsFile)
+}
+
 // Save the config
 func (c *HyperdriveClient) SaveConfig(cfg *GlobalConfig) error {
 	settingsFileDirectoryPath, err := homedir.Expand(c.Context.ConfigPath)
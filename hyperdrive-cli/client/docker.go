@@ -8,6 +8,7 @@ import (
 
 	dt "github.com/docker/docker/api/types"
 	dtc "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 )
 
@@ -171,6 +172,57 @@ func (c *HyperdriveClient) GetVolumeSize(volumeName string) (int64, error) {
 	return 0, fmt.Errorf("couldn't find a volume named [%s]", volumeName)
 }
 
+// Get the host ports published by the running containers of the given Docker Compose project, mapped to the names of the containers that publish them
+func (c *HyperdriveClient) GetPublishedPorts(projectName string) (map[uint16]string, error) {
+	d, err := c.GetDocker()
+	if err != nil {
+		return nil, err
+	}
+	cl, err := d.ContainerList(context.Background(), dt.ContainerListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", "com.docker.compose.project="+projectName)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting container list: %w", err)
+	}
+
+	ports := map[uint16]string{}
+	for _, container := range cl {
+		for _, port := range container.Ports {
+			if port.PublicPort != 0 {
+				ports[port.PublicPort] = strings.TrimPrefix(container.Names[0], "/")
+			}
+		}
+	}
+	return ports, nil
+}
+
+// Get the ID of the image used by each container of the given Docker Compose project, keyed by the name of the compose service the container belongs to
+func (c *HyperdriveClient) GetServiceImages(projectName string) (map[string]string, error) {
+	d, err := c.GetDocker()
+	if err != nil {
+		return nil, err
+	}
+	cl, err := d.ContainerList(context.Background(), dt.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", "com.docker.compose.project="+projectName)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting container list: %w", err)
+	}
+
+	images := map[string]string{}
+	for _, container := range cl {
+		service := container.Labels["com.docker.compose.service"]
+		if service == "" {
+			continue
+		}
+
+		// Use the image ID instead of the tag, since pulling can move the tag to a different image
+		images[service] = container.ImageID
+	}
+	return images, nil
+}
+
 // Inspect a Docker container
 func inspectContainer(c *HyperdriveClient, container string) (dt.ContainerJSON, error) {
 	d, err := c.GetDocker()
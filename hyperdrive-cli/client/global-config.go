@@ -57,6 +57,11 @@ func (c *GlobalConfig) DeserializeModules() error {
 	return nil
 }
 
+// Applies a partial settings document, laid out like the settings file, over the config and all of its modules
+func (c *GlobalConfig) ApplyPartial(settings map[string]any) error {
+	return c.Hyperdrive.ApplyPartial(settings, c.GetAllModuleConfigs())
+}
+
 // Creates a copy of the configuration
 func (c *GlobalConfig) CreateCopy() *GlobalConfig {
 	// Hyperdrive
@@ -100,43 +105,8 @@ func (c *GlobalConfig) UpdateDefaults() {
 }
 
 // Checks to see if the current configuration is valid; if not, returns a list of errors
-func (c *GlobalConfig) Validate() []string {
-	errors := []string{}
-
-	// Check for illegal blank strings
-	/* TODO - this needs to be smarter and ignore irrelevant settings
-	for _, param := range config.GetParameters() {
-		if param.Type == ParameterType_String && !param.CanBeBlank && param.Value == "" {
-			errors = append(errors, fmt.Sprintf("[%s] cannot be blank.", param.Name))
-		}
-	}
-
-	for name, subconfig := range config.GetSubconfigs() {
-		for _, param := range subconfig.GetParameters() {
-			if param.Type == ParameterType_String && !param.CanBeBlank && param.Value == "" {
-				errors = append(errors, fmt.Sprintf("[%s - %s] cannot be blank.", name, param.Name))
-			}
-		}
-	}
-	*/
-
-	// Ensure the selected port numbers are unique. Keeps track of all the errors
-	portMap := make(map[uint16]bool)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.LocalBeaconConfig.HttpPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.LocalBeaconConfig.P2pPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.LocalExecutionConfig.HttpPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.LocalExecutionConfig.WebsocketPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.LocalExecutionConfig.EnginePort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.LocalExecutionConfig.P2pPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.Metrics.EcMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.Metrics.BnMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.Metrics.Prometheus.Port, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.Metrics.ExporterMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.Metrics.Grafana.Port, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.Metrics.DaemonMetricsPort, errors)
-	_, errors = addAndCheckForDuplicate(portMap, c.Hyperdrive.LocalBeaconConfig.Lighthouse.P2pQuicPort, errors)
-
-	return errors
+func (c *GlobalConfig) Validate() []*config.ValidationError {
+	return c.Hyperdrive.Validate(c.GetAllModuleConfigs())
 }
 
 // Get all of the settings that have changed between an old config and this config, and get all of the containers that are affected by those changes - also returns whether or not the selected network was changed
@@ -183,14 +153,3 @@ func getChanges(
 	}
 	return sectionList
 }
-
-// Check a port setting to see if it's already used elsewhere
-func addAndCheckForDuplicate(portMap map[uint16]bool, param config.Parameter[uint16], errors []string) (map[uint16]bool, []string) {
-	port := param.Value
-	if portMap[port] {
-		return portMap, append(errors, fmt.Sprintf("Port %d for %s is already in use", port, param.GetCommon().Name))
-	} else {
-		portMap[port] = true
-	}
-	return portMap, errors
-}
@@ -0,0 +1,565 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alessio/shellescape"
+	"github.com/mitchellh/go-homedir"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client/template"
+	"github.com/nodeset-org/hyperdrive/shared/utils/sys"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	nativeDir                string        = "native"
+	nativeUnitTemplate       string        = "unit.tmpl"
+	nativeEnvSuffix          string        = ".env"
+	nativeDefaultStopTimeout time.Duration = 10 * time.Second
+)
+
+// The parts of a rendered Docker Compose file that are used to build systemd units
+type nativeComposeFile struct {
+	Services map[string]nativeComposeService `yaml:"services"`
+}
+
+// The parts of a Docker Compose service that are used to build its systemd unit
+type nativeComposeService struct {
+	Entrypoint      composeCommand `yaml:"entrypoint"`
+	Command         composeCommand `yaml:"command"`
+	Environment     []string       `yaml:"environment"`
+	Volumes         []string       `yaml:"volumes"`
+	StopGracePeriod string         `yaml:"stop_grace_period"`
+	NativeExec      string         `yaml:"x-native-exec"`
+}
+
+// A Docker Compose entrypoint or command, which can be either a single string or a list of arguments
+type composeCommand []string
+
+// Deserialize a command from either of its Docker Compose forms
+func (c *composeCommand) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*c = strings.Fields(node.Value)
+		return nil
+	}
+	var args []string
+	err := node.Decode(&args)
+	if err != nil {
+		return err
+	}
+	*c = args
+	return nil
+}
+
+// A systemd unit for one of the Hyperdrive service's containers
+type nativeUnit struct {
+	Name              string
+	Service           string
+	Description       string
+	EnvironmentFile   string
+	ExecStart         string
+	StopTimeout       int
+	BindPaths         []string
+	BindReadOnlyPaths []string
+
+	// The host folders that have to exist before the unit can start
+	sources []string
+
+	// True if the unit or its environment changed since it was last installed
+	changed bool
+}
+
+// Check if the Hyperdrive service is configured to run natively with systemd
+func (c *HyperdriveClient) isNativeMode() (bool, error) {
+	cfg, isNew, err := c.LoadConfig()
+	if err != nil {
+		return false, fmt.Errorf("error loading user settings: %w", err)
+	}
+	return !isNew && cfg.Hyperdrive.IsNativeMode(), nil
+}
+
+// Render the service's templates, convert them to systemd units, and install them
+func (c *HyperdriveClient) deployNativeUnits() ([]*nativeUnit, error) {
+	// Get the expanded config path
+	expandedConfigPath, err := homedir.Expand(c.Context.ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load config
+	cfg, isNew, err := c.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	err = checkServiceConfig(cfg, isNew)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deploy the templates
+	composeFiles, err := c.deployTemplates(cfg, expandedConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("error deploying templates: %w", err)
+	}
+
+	// Make the native folder; it holds the environment files, which can include secrets
+	nativeFolder := filepath.Join(expandedConfigPath, nativeDir)
+	err = os.MkdirAll(nativeFolder, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("error creating native folder [%s]: %w", nativeFolder, err)
+	}
+
+	// Convert each service into a unit
+	runtimeFolder := filepath.Join(expandedConfigPath, runtimeDir)
+	units := []*nativeUnit{}
+	for _, composeFile := range composeFiles {
+		// Overrides are Docker-specific, so only the rendered templates are used
+		if !strings.HasPrefix(composeFile, runtimeFolder) {
+			continue
+		}
+
+		contents, err := os.ReadFile(composeFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading [%s]: %w", composeFile, err)
+		}
+		var definition nativeComposeFile
+		err = yaml.Unmarshal(contents, &definition)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing [%s]: %w", composeFile, err)
+		}
+
+		serviceNames := make([]string, 0, len(definition.Services))
+		for name := range definition.Services {
+			serviceNames = append(serviceNames, name)
+		}
+		sort.Strings(serviceNames)
+		for _, name := range serviceNames {
+			unit, err := createNativeUnit(cfg, nativeFolder, name, definition.Services[name])
+			if err != nil {
+				return nil, err
+			}
+			units = append(units, unit)
+		}
+	}
+
+	// Install them
+	err = c.installNativeUnits(cfg, nativeFolder, units)
+	if err != nil {
+		return nil, err
+	}
+	return units, nil
+}
+
+// Create the unit and environment file for a service in the native folder
+func createNativeUnit(cfg *GlobalConfig, nativeFolder string, name string, service nativeComposeService) (*nativeUnit, error) {
+	hd := cfg.Hyperdrive
+	unit := &nativeUnit{
+		Name:            hd.GetNativeUnitName(name),
+		Service:         name,
+		Description:     fmt.Sprintf("Hyperdrive %s (%s)", name, hd.ProjectName.Value),
+		EnvironmentFile: filepath.Join(nativeFolder, hd.GetDockerArtifactName(name)+nativeEnvSuffix),
+		StopTimeout:     int(nativeDefaultStopTimeout.Seconds()),
+	}
+
+	// Get the command to run
+	executable := service.NativeExec
+	args := []string(service.Command)
+	if executable == "" {
+		if len(service.Entrypoint) == 0 {
+			return nil, fmt.Errorf("service [%s] doesn't have an entrypoint or an x-native-exec binary, so it can't be run natively", name)
+		}
+		executable = service.Entrypoint[0]
+		args = append(append([]string{}, service.Entrypoint[1:]...), args...)
+	}
+	if !filepath.IsAbs(executable) {
+		path, err := exec.LookPath(executable)
+		if err != nil {
+			return nil, fmt.Errorf("error finding [%s] for service [%s]: %w", executable, name, err)
+		}
+		executable = path
+	}
+	execArgs := []string{quoteUnitArg(executable)}
+	for _, arg := range args {
+		execArgs = append(execArgs, quoteUnitArg(arg))
+	}
+	unit.ExecStart = strings.Join(execArgs, " ")
+
+	// Get the stop timeout
+	if service.StopGracePeriod != "" {
+		timeout, err := time.ParseDuration(service.StopGracePeriod)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing stop grace period [%s] for service [%s]: %w", service.StopGracePeriod, name, err)
+		}
+		unit.StopTimeout = int(timeout.Seconds())
+	}
+
+	// Map the volumes to bind mounts, using the chain data folder in place of named volumes
+	for _, volume := range service.Volumes {
+		parts := strings.Split(volume, ":")
+		if len(parts) < 2 {
+			continue
+		}
+		source := parts[0]
+		target := parts[1]
+		if !filepath.IsAbs(source) {
+			source = hd.GetNativeVolumePath(source)
+		}
+		if source == target {
+			// The unit already sees the host's filesystem
+			continue
+		}
+		unit.sources = append(unit.sources, source)
+		binding := source + ":" + target
+		if len(parts) > 2 && parts[2] == "ro" {
+			unit.BindReadOnlyPaths = append(unit.BindReadOnlyPaths, binding)
+		} else {
+			unit.BindPaths = append(unit.BindPaths, binding)
+		}
+	}
+
+	// Write the environment file
+	env := &strings.Builder{}
+	env.WriteString("# Autogenerated - DO NOT MODIFY THIS FILE DIRECTLY\n")
+	for _, entry := range service.Environment {
+		key, value, hasValue := strings.Cut(entry, "=")
+		if !hasValue {
+			continue
+		}
+		fmt.Fprintf(env, "%s=%s\n", key, quoteEnvValue(value))
+	}
+	oldEnv, _ := os.ReadFile(unit.EnvironmentFile)
+	newEnv := []byte(env.String())
+	err := os.WriteFile(unit.EnvironmentFile, newEnv, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("error writing environment file [%s]: %w", unit.EnvironmentFile, err)
+	}
+
+	// Write the unit
+	unitPath := filepath.Join(nativeFolder, unit.Name)
+	tmpl := template.Template{
+		Src: filepath.Join(templatesDir, nativeDir, nativeUnitTemplate),
+		Dst: unitPath,
+	}
+	err = tmpl.Write(unit)
+	if err != nil {
+		return nil, fmt.Errorf("error writing unit for service [%s]: %w", name, err)
+	}
+	newUnit, err := os.ReadFile(unitPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading unit [%s]: %w", unitPath, err)
+	}
+	installedUnit, _ := os.ReadFile(filepath.Join(hd.Native.UnitDirectory.Value, unit.Name))
+	unit.changed = !bytes.Equal(oldEnv, newEnv) || !bytes.Equal(installedUnit, newUnit)
+	return unit, nil
+}
+
+// Copy the units into the system's unit folder, removing any for services that are no longer deployed
+func (c *HyperdriveClient) installNativeUnits(cfg *GlobalConfig, nativeFolder string, units []*nativeUnit) error {
+	out := c.Context.Output
+	rootCmd, err := c.getNativeEscalationCommand()
+	if err != nil {
+		return err
+	}
+	unitDir := cfg.Hyperdrive.Native.UnitDirectory.Value
+
+	// Remove stale units
+	deployed := map[string]bool{}
+	for _, unit := range units {
+		deployed[unit.Name] = true
+	}
+	installed, err := sys.GetUnitFiles(unitDir, cfg.Hyperdrive.ProjectName.Value+"_")
+	if err != nil {
+		return err
+	}
+	staleNames := []string{}
+	stalePaths := []string{}
+	for _, path := range installed {
+		name := filepath.Base(path)
+		if !deployed[name] {
+			staleNames = append(staleNames, shellescape.Quote(name))
+			stalePaths = append(stalePaths, shellescape.Quote(path))
+		}
+	}
+	if len(staleNames) > 0 {
+		fmt.Fprintf(out, "Removing units for services that are no longer used: %s\n", strings.Join(staleNames, ", "))
+		err = c.printOutput(fmt.Sprintf("%s systemctl disable --now %s", rootCmd, strings.Join(staleNames, " ")))
+		if err != nil {
+			return fmt.Errorf("error stopping stale units: %w", err)
+		}
+		err = c.printOutput(fmt.Sprintf("%s rm -f %s", rootCmd, strings.Join(stalePaths, " ")))
+		if err != nil {
+			return fmt.Errorf("error removing stale units: %w", err)
+		}
+	}
+
+	// Create the bind mount sources, since systemd won't make them the way Docker does
+	folders := []string{shellescape.Quote(unitDir)}
+	unitPaths := []string{}
+	for _, unit := range units {
+		for _, source := range unit.sources {
+			folders = append(folders, shellescape.Quote(source))
+		}
+		unitPaths = append(unitPaths, shellescape.Quote(filepath.Join(nativeFolder, unit.Name)))
+	}
+	err = c.printOutput(fmt.Sprintf("%s mkdir -p %s", rootCmd, strings.Join(folders, " ")))
+	if err != nil {
+		return fmt.Errorf("error creating unit folders: %w", err)
+	}
+
+	// Install the units
+	if len(unitPaths) > 0 {
+		err = c.printOutput(fmt.Sprintf("%s cp %s %s", rootCmd, strings.Join(unitPaths, " "), shellescape.Quote(unitDir)))
+		if err != nil {
+			return fmt.Errorf("error installing units: %w", err)
+		}
+	}
+	err = c.printOutput(fmt.Sprintf("%s systemctl daemon-reload", rootCmd))
+	if err != nil {
+		return fmt.Errorf("error reloading systemd: %w", err)
+	}
+	return nil
+}
+
+// Install the units and start them, restarting any that changed
+func (c *HyperdriveClient) startNativeService() error {
+	units, err := c.deployNativeUnits()
+	if err != nil {
+		return err
+	}
+	rootCmd, err := c.getNativeEscalationCommand()
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	changedNames := []string{}
+	for _, unit := range units {
+		name := shellescape.Quote(unit.Name)
+		names = append(names, name)
+		if unit.changed {
+			changedNames = append(changedNames, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	err = c.printOutput(fmt.Sprintf("%s systemctl enable --quiet %s", rootCmd, strings.Join(names, " ")))
+	if err != nil {
+		return fmt.Errorf("error enabling units: %w", err)
+	}
+	if len(changedNames) > 0 {
+		err = c.printOutput(fmt.Sprintf("%s systemctl restart %s", rootCmd, strings.Join(changedNames, " ")))
+		if err != nil {
+			return fmt.Errorf("error restarting units: %w", err)
+		}
+	}
+	err = c.printOutput(fmt.Sprintf("%s systemctl start %s", rootCmd, strings.Join(names, " ")))
+	if err != nil {
+		return fmt.Errorf("error starting units: %w", err)
+	}
+	return nil
+}
+
+// Install the units and restart only the ones for the given services
+func (c *HyperdriveClient) startNativeServices(serviceNames []string) error {
+	_, err := c.deployNativeUnits()
+	if err != nil {
+		return err
+	}
+	rootCmd, err := c.getNativeEscalationCommand()
+	if err != nil {
+		return err
+	}
+	cfg, _, err := c.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(serviceNames))
+	for i, service := range serviceNames {
+		names[i] = shellescape.Quote(cfg.Hyperdrive.GetNativeUnitName(service))
+	}
+	err = c.printOutput(fmt.Sprintf("%s systemctl restart %s", rootCmd, strings.Join(names, " ")))
+	if err != nil {
+		return fmt.Errorf("error restarting units: %w", err)
+	}
+	return nil
+}
+
+// Run systemctl on all of the installed units
+func (c *HyperdriveClient) runNativeUnitCommand(args string) error {
+	rootCmd, err := c.getNativeEscalationCommand()
+	if err != nil {
+		return err
+	}
+	names, _, err := c.getInstalledNativeUnits()
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return c.printOutput(fmt.Sprintf("%s systemctl %s %s", rootCmd, args, strings.Join(names, " ")))
+}
+
+// Stop, disable, and remove all of the installed units
+func (c *HyperdriveClient) removeNativeUnits() error {
+	rootCmd, err := c.getNativeEscalationCommand()
+	if err != nil {
+		return err
+	}
+	names, paths, err := c.getInstalledNativeUnits()
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	err = c.printOutput(fmt.Sprintf("%s systemctl disable --now %s", rootCmd, strings.Join(names, " ")))
+	if err != nil {
+		return fmt.Errorf("error stopping units: %w", err)
+	}
+	err = c.printOutput(fmt.Sprintf("%s rm -f %s", rootCmd, strings.Join(paths, " ")))
+	if err != nil {
+		return fmt.Errorf("error removing units: %w", err)
+	}
+	err = c.printOutput(fmt.Sprintf("%s systemctl daemon-reload", rootCmd))
+	if err != nil {
+		return fmt.Errorf("error reloading systemd: %w", err)
+	}
+	return nil
+}
+
+// Remove the units and the chain data that replaces the Docker volumes
+func (c *HyperdriveClient) stopNativeService() error {
+	err := c.removeNativeUnits()
+	if err != nil {
+		return err
+	}
+	return c.removeNativeChainData()
+}
+
+// Delete the folders that hold the Execution Client and Beacon Node chain data
+func (c *HyperdriveClient) removeNativeChainData() error {
+	out := c.Context.Output
+	rootCmd, err := c.getNativeEscalationCommand()
+	if err != nil {
+		return err
+	}
+	cfg, _, err := c.LoadConfig()
+	if err != nil {
+		return err
+	}
+	hd := cfg.Hyperdrive
+	folders := []string{
+		shellescape.Quote(hd.GetNativeVolumePath(hd.ExecutionClientDataVolume())),
+		shellescape.Quote(hd.GetNativeVolumePath(hd.BeaconNodeDataVolume())),
+	}
+	fmt.Fprintln(out, "Deleting chain data...")
+	_, err = c.readOutput(fmt.Sprintf("%s rm -rf %s", rootCmd, strings.Join(folders, " ")))
+	if err != nil {
+		return fmt.Errorf("error deleting chain data: %w", err)
+	}
+	return nil
+}
+
+// Print the state of all of the service's units
+func (c *HyperdriveClient) printNativeServiceStatus() error {
+	cfg, _, err := c.LoadConfig()
+	if err != nil {
+		return err
+	}
+	pattern := shellescape.Quote(cfg.Hyperdrive.GetNativeUnitName("*"))
+	return c.printOutput(fmt.Sprintf("systemctl list-units --all --no-pager %s", pattern))
+}
+
+// Follow the journal of the given services, or of all of them if none are provided
+func (c *HyperdriveClient) printNativeServiceLogs(tail string, serviceNames ...string) error {
+	rootCmd, err := c.getNativeEscalationCommand()
+	if err != nil {
+		return err
+	}
+	cfg, _, err := c.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if len(serviceNames) == 0 {
+		serviceNames = []string{"*"}
+	}
+	unitFlags := make([]string, len(serviceNames))
+	for i, service := range serviceNames {
+		unitFlags[i] = "-u " + shellescape.Quote(cfg.Hyperdrive.GetNativeUnitName(service))
+	}
+	return c.printOutput(fmt.Sprintf("%s journalctl -f -n %s %s", rootCmd, shellescape.Quote(tail), strings.Join(unitFlags, " ")))
+}
+
+// Print the units that the service would install
+func (c *HyperdriveClient) printNativeServiceUnits() error {
+	out := c.Context.Output
+	units, err := c.deployNativeUnits()
+	if err != nil {
+		return err
+	}
+	expandedConfigPath, err := homedir.Expand(c.Context.ConfigPath)
+	if err != nil {
+		return err
+	}
+	for _, unit := range units {
+		contents, err := os.ReadFile(filepath.Join(expandedConfigPath, nativeDir, unit.Name))
+		if err != nil {
+			return fmt.Errorf("error reading unit [%s]: %w", unit.Name, err)
+		}
+		fmt.Fprintf(out, "# %s\n%s\n", unit.Name, string(contents))
+	}
+	return nil
+}
+
+// Get the names and paths of the service's units that are installed on the system, quoted for the shell
+func (c *HyperdriveClient) getInstalledNativeUnits() ([]string, []string, error) {
+	cfg, _, err := c.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	paths, err := sys.GetUnitFiles(cfg.Hyperdrive.Native.UnitDirectory.Value, cfg.Hyperdrive.ProjectName.Value+"_")
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, len(paths))
+	quotedPaths := make([]string, len(paths))
+	for i, path := range paths {
+		names[i] = shellescape.Quote(filepath.Base(path))
+		quotedPaths[i] = shellescape.Quote(path)
+	}
+	return names, quotedPaths, nil
+}
+
+// Get the command to manage systemd with, which is empty if the CLI is already running as root
+func (c *HyperdriveClient) getNativeEscalationCommand() (string, error) {
+	if os.Geteuid() == 0 {
+		return "", nil
+	}
+	rootCmd, err := c.getEscalationCommand()
+	if err != nil {
+		return "", fmt.Errorf("could not get privilege escalation command: %w", err)
+	}
+	return rootCmd, nil
+}
+
+// Quote an argument for a unit's ExecStart line
+func quoteUnitArg(arg string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `%`, `%%`, `$`, `$$`)
+	return `"` + replacer.Replace(arg) + `"`
+}
+
+// Quote a value for a unit's environment file
+func quoteEnvValue(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, `$`, `\$`, "`", "\\`")
+	return `"` + replacer.Replace(value) + `"`
+}
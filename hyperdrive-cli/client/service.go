@@ -27,12 +27,14 @@ const (
 	templatesDir       string = "/usr/share/hyperdrive/templates"
 	overrideSourceDir  string = "/usr/share/hyperdrive/override"
 	overrideDir        string = "override"
-	runtimeDir         string = "runtime"
+	runtimeDir         string = config.RuntimeDirectory
 	extraScrapeJobsDir string = "extra-scrape-jobs"
 )
 
 // Install Hyperdrive
 func (c *HyperdriveClient) InstallService(verbose bool, noDeps bool, version string, path string, useLocalInstaller bool) error {
+	out := c.Context.Output
+
 	// Get installation script flags
 	flags := []string{
 		"-v", shellescape.Quote(version),
@@ -111,7 +113,7 @@ func (c *HyperdriveClient) InstallService(verbose bool, noDeps bool, version str
 	go (func() {
 		scanner := bufio.NewScanner(cmdOut)
 		for scanner.Scan() {
-			fmt.Println(scanner.Text())
+			fmt.Fprintln(out, scanner.Text())
 		}
 	})()
 
@@ -138,6 +140,13 @@ func (c *HyperdriveClient) InstallService(verbose bool, noDeps bool, version str
 
 // Start the Hyperdrive service
 func (c *HyperdriveClient) StartService(composeFiles []string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return c.startNativeService()
+	}
 	cmd, err := c.compose(composeFiles, "up -d --remove-orphans --quiet-pull")
 	if err != nil {
 		return err
@@ -145,8 +154,51 @@ func (c *HyperdriveClient) StartService(composeFiles []string) error {
 	return c.printOutput(cmd)
 }
 
+// Pull the images for all of the Hyperdrive service's containers without restarting any of them
+func (c *HyperdriveClient) PullServiceImages(composeFiles []string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return errors.New("Hyperdrive doesn't use container images in Native Mode")
+	}
+	cmd, err := c.compose(composeFiles, "pull --quiet")
+	if err != nil {
+		return err
+	}
+	return c.printOutput(cmd)
+}
+
+// Create or recreate only the given services of the Hyperdrive service, leaving the services they depend on alone
+func (c *HyperdriveClient) StartServices(composeFiles []string, serviceNames []string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return c.startNativeServices(serviceNames)
+	}
+	quotedNames := make([]string, len(serviceNames))
+	for i, name := range serviceNames {
+		quotedNames[i] = shellescape.Quote(name)
+	}
+	cmd, err := c.compose(composeFiles, fmt.Sprintf("up -d --no-deps --quiet-pull %s", strings.Join(quotedNames, " ")))
+	if err != nil {
+		return err
+	}
+	return c.printOutput(cmd)
+}
+
 // Pause the Hyperdrive service
 func (c *HyperdriveClient) PauseService(composeFiles []string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return c.runNativeUnitCommand("stop")
+	}
 	cmd, err := c.compose(composeFiles, "stop")
 	if err != nil {
 		return err
@@ -156,6 +208,13 @@ func (c *HyperdriveClient) PauseService(composeFiles []string) error {
 
 // Stop the Hyperdrive service
 func (c *HyperdriveClient) StopService(composeFiles []string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return c.stopNativeService()
+	}
 	cmd, err := c.compose(composeFiles, "down -v")
 	if err != nil {
 		return err
@@ -165,20 +224,34 @@ func (c *HyperdriveClient) StopService(composeFiles []string) error {
 
 // Stop Hyperdrive and remove the config folder
 func (c *HyperdriveClient) TerminateService(composeFiles []string, configPath string) error {
+	out := c.Context.Output
+
 	// Get the command to run with root privileges
 	rootCmd, err := c.getEscalationCommand()
 	if err != nil {
 		return fmt.Errorf("could not get privilege escalation command: %w", err)
 	}
 
-	// Terminate the Docker containers
-	cmd, err := c.compose(composeFiles, "down -v")
+	native, err := c.isNativeMode()
 	if err != nil {
-		return fmt.Errorf("error creating Docker artifact removal command: %w", err)
+		return err
 	}
-	err = c.printOutput(cmd)
-	if err != nil {
-		return fmt.Errorf("error removing Docker artifacts: %w", err)
+	if native {
+		// Remove the units and the chain data that replaces the Docker volumes
+		err = c.stopNativeService()
+		if err != nil {
+			return err
+		}
+	} else {
+		// Terminate the Docker containers
+		cmd, err := c.compose(composeFiles, "down -v")
+		if err != nil {
+			return fmt.Errorf("error creating Docker artifact removal command: %w", err)
+		}
+		err = c.printOutput(cmd)
+		if err != nil {
+			return fmt.Errorf("error removing Docker artifacts: %w", err)
+		}
 	}
 
 	// Delete the Hyperdrive directory
@@ -186,20 +259,27 @@ func (c *HyperdriveClient) TerminateService(composeFiles []string, configPath st
 	if err != nil {
 		return fmt.Errorf("error loading Hyperdrive directory: %w", err)
 	}
-	fmt.Printf("Deleting Hyperdrive directory (%s)...\n", path)
-	cmd = fmt.Sprintf("%s rm -rf %s", rootCmd, path)
+	fmt.Fprintf(out, "Deleting Hyperdrive directory (%s)...\n", path)
+	cmd := fmt.Sprintf("%s rm -rf %s", rootCmd, path)
 	_, err = c.readOutput(cmd)
 	if err != nil {
 		return fmt.Errorf("error deleting Hyperdrive directory: %w", err)
 	}
 
-	fmt.Println("Termination complete.")
+	fmt.Fprintln(out, "Termination complete.")
 
 	return nil
 }
 
 // Print the Hyperdrive service status
 func (c *HyperdriveClient) PrintServiceStatus(composeFiles []string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return c.printNativeServiceStatus()
+	}
 	cmd, err := c.compose(composeFiles, "ps")
 	if err != nil {
 		return err
@@ -209,6 +289,13 @@ func (c *HyperdriveClient) PrintServiceStatus(composeFiles []string) error {
 
 // Print the Hyperdrive service logs
 func (c *HyperdriveClient) PrintServiceLogs(composeFiles []string, tail string, serviceNames ...string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return c.printNativeServiceLogs(tail, serviceNames...)
+	}
 	sanitizedStrings := make([]string, len(serviceNames))
 	for i, serviceName := range serviceNames {
 		sanitizedStrings[i] = shellescape.Quote(serviceName)
@@ -222,6 +309,14 @@ func (c *HyperdriveClient) PrintServiceLogs(composeFiles []string, tail string,
 
 // Print the Hyperdrive service stats
 func (c *HyperdriveClient) PrintServiceStats(composeFiles []string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return errors.New("container stats aren't available in Native Mode; use your system's process monitor instead")
+	}
+
 	// Get service container IDs
 	cmd, err := c.compose(composeFiles, "ps -q")
 	if err != nil {
@@ -239,6 +334,13 @@ func (c *HyperdriveClient) PrintServiceStats(composeFiles []string) error {
 
 // Print the Hyperdrive service compose config
 func (c *HyperdriveClient) PrintServiceCompose(composeFiles []string) error {
+	native, err := c.isNativeMode()
+	if err != nil {
+		return err
+	}
+	if native {
+		return c.printNativeServiceUnits()
+	}
 	cmd, err := c.compose(composeFiles, "config")
 	if err != nil {
 		return err
@@ -265,8 +367,19 @@ func (c *HyperdriveClient) GetServiceVersion() (string, error) {
 	return version.String(), nil
 }
 
+// Get the version of the Docker Compose plugin
+func (c *HyperdriveClient) GetDockerComposeVersion() (string, error) {
+	output, err := c.readOutput("docker compose version --short")
+	if err != nil {
+		return "", fmt.Errorf("error running docker compose: %w", err)
+	}
+	return strings.TrimPrefix(strings.TrimSpace(string(output)), "v"), nil
+}
+
 // Deletes the data directory, including the node wallet and all validator keys, and restarts the Docker containers
 func (c *HyperdriveClient) PurgeData(composeFiles []string) error {
+	out := c.Context.Output
+
 	// Get the command to run with root privileges
 	rootCmd, err := c.getEscalationCommand()
 	if err != nil {
@@ -279,8 +392,14 @@ func (c *HyperdriveClient) PurgeData(composeFiles []string) error {
 		return fmt.Errorf("error loading user settings: %w", err)
 	}
 
+	// Have the daemon unload the wallet first so anything watching its events knows it's gone
+	_, err = c.Api.Wallet.Forget()
+	if err != nil {
+		fmt.Fprintf(out, "WARNING: couldn't unload the node wallet from the daemon (%s); it will be deleted from disk anyway.\n", err.Error())
+	}
+
 	// Shut down the containers
-	fmt.Println("Stopping containers...")
+	fmt.Fprintln(out, "Stopping containers...")
 	err = c.PauseService(composeFiles)
 	if err != nil {
 		return fmt.Errorf("error stopping Docker containers: %w", err)
@@ -291,7 +410,7 @@ func (c *HyperdriveClient) PurgeData(composeFiles []string) error {
 	if err != nil {
 		return fmt.Errorf("error loading data path: %w", err)
 	}
-	fmt.Println("Deleting data...")
+	fmt.Fprintln(out, "Deleting data...")
 	cmd := fmt.Sprintf("%s rm -f %s", rootCmd, dataPath)
 	_, err = c.readOutput(cmd)
 	if err != nil {
@@ -299,13 +418,13 @@ func (c *HyperdriveClient) PurgeData(composeFiles []string) error {
 	}
 
 	// Start the containers
-	fmt.Println("Starting containers...")
+	fmt.Fprintln(out, "Starting containers...")
 	err = c.StartService(composeFiles)
 	if err != nil {
 		return fmt.Errorf("error starting Docker containers: %w", err)
 	}
 
-	fmt.Println("Purge complete.")
+	fmt.Fprintln(out, "Purge complete.")
 	return nil
 }
 
@@ -380,18 +499,9 @@ func (c *HyperdriveClient) compose(composeFiles []string, args string) (string,
 		return "", err
 	}
 
-	if isNew {
-		return "", fmt.Errorf("settings file not found. Please run `hyperdrive service config` to set up Hyperdrive before starting it")
-	}
-
-	// Check config
-	if cfg.Hyperdrive.ClientMode.Value == config.ClientMode_Unknown {
-		return "", fmt.Errorf("you haven't selected local or external mode for your clients yet.\nPlease run 'hyperdrive service config' before running this command")
-	} else if cfg.Hyperdrive.IsLocalMode() && cfg.Hyperdrive.LocalExecutionConfig.ExecutionClient.Value == config.ExecutionClient_Unknown {
-		return "", errors.New("no Execution Client selected. Please run 'hyperdrive service config' before running this command")
-	}
-	if cfg.Hyperdrive.IsLocalMode() && cfg.Hyperdrive.LocalBeaconConfig.BeaconNode.Value == config.BeaconNode_Unknown {
-		return "", errors.New("no Beacon Node selected. Please run 'hyperdrive service config' before running this command")
+	err = checkServiceConfig(cfg, isNew)
+	if err != nil {
+		return "", err
 	}
 
 	// Deploy the templates and run environment variable substitution on them
@@ -413,6 +523,24 @@ func (c *HyperdriveClient) compose(composeFiles []string, args string) (string,
 	return fmt.Sprintf("COMPOSE_PROJECT_NAME=%s docker compose --project-directory %s %s %s", cfg.Hyperdrive.ProjectName.Value, shellescape.Quote(expandedConfigPath), strings.Join(composeFileFlags, " "), args), nil
 }
 
+// Make sure the config is complete enough to start the service with
+func checkServiceConfig(cfg *GlobalConfig, isNew bool) error {
+	if isNew {
+		return fmt.Errorf("settings file not found. Please run `hyperdrive service config` to set up Hyperdrive before starting it")
+	}
+
+	// Check config
+	if cfg.Hyperdrive.ClientMode.Value == config.ClientMode_Unknown {
+		return fmt.Errorf("you haven't selected local or external mode for your clients yet.\nPlease run 'hyperdrive service config' before running this command")
+	} else if cfg.Hyperdrive.IsLocalMode() && cfg.Hyperdrive.LocalExecutionConfig.ExecutionClient.Value == config.ExecutionClient_Unknown {
+		return errors.New("no Execution Client selected. Please run 'hyperdrive service config' before running this command")
+	}
+	if cfg.Hyperdrive.IsLocalMode() && cfg.Hyperdrive.LocalBeaconConfig.BeaconNode.Value == config.BeaconNode_Unknown {
+		return errors.New("no Beacon Node selected. Please run 'hyperdrive service config' before running this command")
+	}
+	return nil
+}
+
 // Deploys all of the appropriate docker compose template files and provisions them based on the provided configuration
 func (c *HyperdriveClient) deployTemplates(cfg *GlobalConfig, hyperdriveDir string) ([]string, error) {
 	// Prep the override folder
@@ -457,8 +585,8 @@ func (c *HyperdriveClient) deployTemplates(cfg *GlobalConfig, hyperdriveDir stri
 		toDeploy = append(toDeploy, config.ContainerID_BeaconNode)
 	}
 
-	// Check the metrics containers
-	if cfg.Hyperdrive.Metrics.EnableMetrics.Value {
+	// Check the metrics containers; the metrics stack is only provided in Docker mode
+	if cfg.Hyperdrive.Metrics.EnableMetrics.Value && !cfg.Hyperdrive.IsNativeMode() {
 		toDeploy = append(toDeploy,
 			config.ContainerID_Grafana,
 			config.ContainerID_Exporter,
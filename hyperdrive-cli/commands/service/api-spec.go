@@ -0,0 +1,80 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	apiSpecDaemonFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "daemon",
+		Aliases: []string{"d"},
+		Usage:   fmt.Sprintf("The daemon to get the API spec for. Options: %s, %s", config.HyperdriveDaemonRoute, swconfig.ModuleName),
+		Value:   config.HyperdriveDaemonRoute,
+	}
+	apiSpecFileFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "file",
+		Aliases: []string{"f"},
+		Usage:   "Save the API spec to this file instead of printing it",
+	}
+)
+
+// Print the OpenAPI document for one of the daemons' APIs
+func getApiSpec(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Get the spec
+	var spec json.RawMessage
+	var err error
+	daemon := c.String(apiSpecDaemonFlag.Name)
+	switch daemon {
+	case config.HyperdriveDaemonRoute:
+		spec, err = hd.Api.GetApiSpec()
+	case swconfig.ModuleName:
+		cfg, _, loadErr := hd.LoadConfig()
+		if loadErr != nil {
+			return fmt.Errorf("error loading Hyperdrive config: %w", loadErr)
+		}
+		if !cfg.Stakewise.Enabled.Value {
+			return fmt.Errorf("the Stakewise module is not enabled")
+		}
+		sw := client.NewStakewiseClientFromCtx(c)
+		spec, err = sw.Api.GetApiSpec()
+	default:
+		return fmt.Errorf("unknown daemon [%s]; options are %s and %s", daemon, config.HyperdriveDaemonRoute, swconfig.ModuleName)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Format it
+	buffer := &bytes.Buffer{}
+	err = json.Indent(buffer, spec, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error formatting API spec: %w", err)
+	}
+
+	// Save or print it
+	path := c.String(apiSpecFileFlag.Name)
+	if path == "" {
+		fmt.Fprintln(out, buffer.String())
+		return nil
+	}
+	err = os.WriteFile(path, buffer.Bytes(), 0644)
+	if err != nil {
+		return fmt.Errorf("error saving API spec to [%s]: %w", path, err)
+	}
+	fmt.Fprintf(out, "Saved the %s API spec to %s.\n", daemon, path)
+	return nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	auditLogLimitFlag *cli.Uint64Flag = &cli.Uint64Flag{
+		Name:    "limit",
+		Aliases: []string{"l"},
+		Usage:   "The number of most recent entries to show; use 0 to show every entry",
+		Value:   50,
+	}
+)
+
+// Print the daemon's audit log and whether it's intact
+func viewAuditLog(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Get the log
+	response, err := hd.Api.Service.AuditLog(c.Uint64(auditLogLimitFlag.Name))
+	if err != nil {
+		return err
+	}
+	data := response.Data
+
+	// Print the entries
+	if data.TotalEntries == 0 {
+		fmt.Fprintln(out, "The audit log is empty.")
+	} else {
+		if len(data.Entries) < data.TotalEntries {
+			fmt.Fprintf(out, "Showing the last %d of %d entries:\n\n", len(data.Entries), data.TotalEntries)
+		}
+		for _, entry := range data.Entries {
+			outcome := fmt.Sprintf("%s%d OK%s", terminal.ColorGreen, entry.Status, terminal.ColorReset)
+			if !entry.Success {
+				outcome = fmt.Sprintf("%s%d %s%s", terminal.ColorRed, entry.Status, entry.Error, terminal.ColorReset)
+			}
+			fmt.Fprintf(out, "%6d  %s  %-10s  %-30s  %s\n", entry.Index, entry.Time.Local().Format(time.DateTime), entry.Caller, entry.Route, outcome)
+		}
+	}
+	fmt.Fprintln(out)
+
+	// Print the verification result
+	if data.Verified {
+		fmt.Fprintf(out, "%sThe audit log is intact: every entry matches its hash and is chained to the one before it.%s\n", terminal.ColorGreen, terminal.ColorReset)
+		return nil
+	}
+	fmt.Fprintf(out, "%sThe audit log failed verification: %s%s\n", terminal.ColorRed, data.VerificationError, terminal.ColorReset)
+	return fmt.Errorf("the audit log has been tampered with or damaged")
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	backupFileExtension string      = ".hdbackup"
+	backupFilePerms     os.FileMode = 0600
+)
+
+var (
+	backupOutputFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "output",
+		Aliases: []string{"o"},
+		Usage:   "The path to save the backup file to. Defaults to a timestamped file in the current directory.",
+	}
+	backupPasswordFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "backup-password",
+		Aliases: []string{"b"},
+		Usage:   "The password used to encrypt or decrypt the backup",
+	}
+)
+
+// Create an encrypted backup of the node
+func backupNode(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Get the config
+	cfg, isNew, err := hd.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading configuration: %w", err)
+	}
+	if isNew {
+		return fmt.Errorf("No configuration detected. There's nothing to back up yet.")
+	}
+
+	// Get the output path
+	outputPath := c.String(backupOutputFlag.Name)
+	if outputPath == "" {
+		outputPath = fmt.Sprintf("hyperdrive-%s-%s%s", cfg.Hyperdrive.Network.Value, time.Now().Format("20060102-150405"), backupFileExtension)
+	}
+	outputPath, err = filepath.Abs(outputPath)
+	if err != nil {
+		return fmt.Errorf("error getting absolute path of [%s]: %w", outputPath, err)
+	}
+	_, err = os.Stat(outputPath)
+	if err == nil && !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(fmt.Sprintf("%s already exists. Would you like to overwrite it?", outputPath))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
+	}
+
+	// Warn about running VCs since their slashing protection databases may change while being copied
+	runningVcs, err := getRunningValidatorClients(hd, cfg)
+	if err != nil {
+		fmt.Fprintf(out, "%sWARNING: couldn't check if your Validator Clients are running: %s%s\n\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+	} else if len(runningVcs) > 0 {
+		fmt.Fprintf(out, "%sNOTE: your Validator Clients are still running, so their slashing protection databases may be updated while they're being backed up.\nFor the most reliable backup, stop Hyperdrive with `hyperdrive service stop` first.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
+		if !c.Bool(utils.YesFlag.Name) {
+			confirmed, err := utils.Confirm("Would you like to continue anyway?")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				fmt.Fprintln(out, "Cancelled.")
+				return nil
+			}
+		}
+	}
+
+	// Get the password
+	password := c.String(backupPasswordFlag.Name)
+	if password == "" {
+		password, err = promptNewBackupPassword(out)
+		if err != nil {
+			return err
+		}
+	}
+	if len(password) < input.MinPasswordLength {
+		return fmt.Errorf("backup password must be at least %d characters long", input.MinPasswordLength)
+	}
+
+	// Create the backup
+	fmt.Fprintln(out, "Creating backup...")
+	backup, manifest, err := hd.CreateBackup(cfg, password)
+	if err != nil {
+		return fmt.Errorf("error creating backup: %w", err)
+	}
+	err = os.WriteFile(outputPath, backup, backupFilePerms)
+	if err != nil {
+		return fmt.Errorf("error saving backup to [%s]: %w", outputPath, err)
+	}
+
+	fmt.Fprintf(out, "%sBackup of %d files saved to %s.%s\n", terminal.ColorGreen, len(manifest.Files), outputPath, terminal.ColorReset)
+	fmt.Fprintln(out, "This file contains your node wallet and validator keys. Store it somewhere safe and don't forget its password - it can't be recovered without it.")
+	return nil
+}
+
+// Prompt for a new password to encrypt a backup with
+func promptNewBackupPassword(out io.Writer) (string, error) {
+	for {
+		password, err := utils.PromptPassword(
+			"Please enter a password to encrypt the backup with:",
+			fmt.Sprintf("^.{%d,}$", input.MinPasswordLength),
+			fmt.Sprintf("Your password must be at least %d characters long. Please try again:", input.MinPasswordLength),
+		)
+		if err != nil {
+			return "", err
+		}
+		confirmation, err := utils.PromptPassword("Please confirm your password:", "^.*$", "")
+		if err != nil {
+			return "", err
+		}
+		if password == confirmation {
+			return password, nil
+		}
+		fmt.Fprintln(out, "Password confirmation does not match.")
+		fmt.Fprintln(out, "")
+	}
+}
+
+// Get the names of the project's Validator Clients that are currently running
+func getRunningValidatorClients(hd *client.HyperdriveClient, cfg *client.GlobalConfig) ([]string, error) {
+	vcs, err := hd.GetValidatorContainers(cfg.Hyperdrive.ProjectName.Value + "_")
+	if err != nil {
+		return nil, err
+	}
+	running := []string{}
+	for _, vc := range vcs {
+		status, err := hd.GetDockerStatus(vc)
+		if err != nil {
+			return nil, fmt.Errorf("error getting status of [%s]: %w", vc, err)
+		}
+		if status == "running" {
+			running = append(running, vc)
+		}
+	}
+	return running, nil
+}
@@ -2,23 +2,24 @@ package service
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/nodeset-org/hyperdrive/shared/utils/sys"
 )
 
 // Get the list of features required for modern client containers but not supported by the CPU
-func checkCpuFeatures() error {
+func checkCpuFeatures(out io.Writer) error {
 	unsupportedFeatures := sys.GetMissingModernCpuFeatures()
 	if len(unsupportedFeatures) > 0 {
-		fmt.Println("Your CPU is missing support for the following features:")
+		fmt.Fprintln(out, "Your CPU is missing support for the following features:")
 		for _, name := range unsupportedFeatures {
-			fmt.Printf("  - %s\n", name)
+			fmt.Fprintf(out, "  - %s\n", name)
 		}
 
-		fmt.Println("\nYou must use the 'portable' image.")
+		fmt.Fprintln(out, "\nYou must use the 'portable' image.")
 		return nil
 	}
 
-	fmt.Println("Your CPU supports all required features for 'modern' images.")
+	fmt.Fprintln(out, "Your CPU supports all required features for 'modern' images.")
 	return nil
 }
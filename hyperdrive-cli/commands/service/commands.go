@@ -6,6 +6,7 @@ import (
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/wallet"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/output"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/utils/input"
@@ -110,7 +111,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:    "config",
 				Aliases: []string{"c"},
 				Usage:   "Configure the Hyperdrive service",
-				Flags:   configFlags,
+				Flags:   append(append([]cli.Flag{}, configFlags...), configApplyFlag, expectedDowntimeFlag, utils.YesFlag),
 				Action: func(c *cli.Context) error {
 					// Validate args
 					if err := input.ValidateArgCount(c, 0); err != nil {
@@ -160,6 +161,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					ignoreSlashTimerFlag,
 					wallet.PasswordFlag,
 					wallet.SavePasswordFlag,
+					expectedDowntimeFlag,
 					utils.YesFlag,
 				},
 				Action: func(c *cli.Context) error {
@@ -169,7 +171,27 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Run command
-					return startService(c, false)
+					return startService(c, false, false)
+				},
+			},
+
+			{
+				Name:  "upgrade",
+				Usage: "Upgrade the Hyperdrive service to the latest settings and images one stage at a time, waiting for each stage to be healthy and synced, and roll everything back if a stage fails",
+				Flags: []cli.Flag{
+					upgradeStageTimeoutFlag,
+					ignoreSlashTimerFlag,
+					expectedDowntimeFlag,
+					utils.YesFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return upgradeService(c)
 				},
 			},
 
@@ -178,6 +200,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Aliases: []string{"pause", "p"},
 				Usage:   "Pause the Hyperdrive service",
 				Flags: []cli.Flag{
+					expectedDowntimeFlag,
 					utils.YesFlag,
 				},
 				Action: func(c *cli.Context) error {
@@ -191,6 +214,120 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:    "maintenance-window",
+				Aliases: []string{"mw"},
+				Usage:   "List the upcoming duties for your validators and find the next window where you can safely take them offline",
+				Flags: []cli.Flag{
+					maintenanceWindowMinutesFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return findMaintenanceWindow(c)
+				},
+			},
+
+			{
+				Name:  "test-notification",
+				Usage: "Send a test alert to your configured notification webhook and email recipients",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return sendTestNotification(c)
+				},
+			},
+
+			{
+				Name:  "tasks",
+				Usage: "View the status of the daemon's background tasks",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return listTasks(c)
+				},
+			},
+
+			{
+				Name:      "run-task",
+				Usage:     "Run one of the daemon's background tasks immediately",
+				ArgsUsage: "task-name",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run command
+					return runTask(c, c.Args().Get(0))
+				},
+			},
+
+			{
+				Name:  "audit-log",
+				Usage: "View the log of sensitive operations the daemon has performed, such as exporting or signing with the node wallet, and verify that it hasn't been tampered with",
+				Flags: []cli.Flag{
+					auditLogLimitFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return viewAuditLog(c)
+				},
+			},
+
+			{
+				Name:  "api-spec",
+				Usage: "Print the OpenAPI specification of the Hyperdrive daemon's API or an enabled module's API, for integrating external tools",
+				Flags: []cli.Flag{
+					apiSpecDaemonFlag,
+					apiSpecFileFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return getApiSpec(c)
+				},
+			},
+
+			output.Streaming(&cli.Command{
+				Name:    "watch",
+				Aliases: []string{"w"},
+				Usage:   "Watch the events published by the Hyperdrive daemon and enabled modules live, such as task runs, transactions, and client failovers",
+				Flags: []cli.Flag{
+					watchTypesFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return watchEvents(c)
+				},
+			}),
+
 			{
 				Name:      "logs",
 				Aliases:   []string{"l"},
@@ -276,7 +413,25 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Run command
-					return checkCpuFeatures()
+					return checkCpuFeatures(utils.GetOutput(c))
+				},
+			},
+
+			{
+				Name:    "doctor",
+				Aliases: []string{"dr"},
+				Usage:   "Check your system for problems that would stop Hyperdrive from running properly, such as an old Docker version, low disk space, clock drift, port conflicts, or unreachable external clients",
+				Flags: []cli.Flag{
+					doctorNtpServerFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return runDoctor(c)
 				},
 			},
 
@@ -333,10 +488,57 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					},
 				},
 			*/
+			{
+				Name:  "backup",
+				Usage: "Create an encrypted backup of your Hyperdrive configuration, node wallet, module data, validator keys, and slashing protection databases",
+				Flags: []cli.Flag{
+					backupOutputFlag,
+					backupPasswordFlag,
+					utils.YesFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return backupNode(c)
+				},
+			},
+
+			{
+				Name:      "restore",
+				Usage:     "Restore your Hyperdrive configuration, node wallet, module data, validator keys, and slashing protection databases from a backup created with `hyperdrive service backup`",
+				ArgsUsage: "backup-file",
+				Flags: []cli.Flag{
+					backupPasswordFlag,
+					ignoreSlashTimerFlag,
+					wallet.PasswordFlag,
+					wallet.SavePasswordFlag,
+					expectedDowntimeFlag,
+					utils.YesFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					backupPath := c.Args().Get(0)
+
+					// Run command
+					return restoreNode(c, backupPath)
+				},
+			},
+
 			{
 				Name:    "resync-ec",
 				Aliases: []string{"resync-eth1"},
 				Usage:   fmt.Sprintf("%sDeletes the main Execution client's chain data and resyncs it from scratch. Only use this as a last resort!%s", terminal.ColorRed, terminal.ColorReset),
+				Flags: []cli.Flag{
+					expectedDowntimeFlag,
+					utils.YesFlag,
+				},
 				Action: func(c *cli.Context) error {
 					// Validate args
 					if err := input.ValidateArgCount(c, 0); err != nil {
@@ -352,6 +554,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:    "resync-bn",
 				Aliases: []string{"resync-eth2"},
 				Usage:   fmt.Sprintf("%sDeletes the Beacon Node's chain data and resyncs it from scratch. Only use this as a last resort!%s", terminal.ColorRed, terminal.ColorReset),
+				Flags: []cli.Flag{
+					expectedDowntimeFlag,
+					utils.YesFlag,
+				},
 				Action: func(c *cli.Context) error {
 					// Validate args
 					if err := input.ValidateArgCount(c, 0); err != nil {
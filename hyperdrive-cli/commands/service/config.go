@@ -2,27 +2,41 @@ package service
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
 	cliconfig "github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/service/config"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/context"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 	"github.com/nodeset-org/hyperdrive/shared"
+	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/rivo/tview"
 	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	configApplyFlag *cli.StringFlag = &cli.StringFlag{
+		Name:  "apply",
+		Usage: "Apply a YAML or JSON settings document (laid out like the user-settings.yml file, but only including the settings you want to change) without opening the interactive editor. Use '-' to read it from stdin.",
+	}
 )
 
 // Configure the service
 func configureService(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
 	// Make sure the config directory exists first
 	err := os.MkdirAll(hd.Context.ConfigPath, 0700)
 	if err != nil {
-		fmt.Printf("%sYour Hyperdrive user configuration directory of [%s] could not be created:%s.%s\n", terminal.ColorYellow, hd.Context.ConfigPath, err.Error(), terminal.ColorReset)
+		fmt.Fprintf(out, "%sYour Hyperdrive user configuration directory of [%s] could not be created:%s.%s\n", terminal.ColorYellow, hd.Context.ConfigPath, err.Error(), terminal.ColorReset)
 		return nil
 	}
 
@@ -45,6 +59,11 @@ func configureService(c *cli.Context) error {
 		cfg.UpdateDefaults()
 	}
 
+	// Apply a settings document instead of running the editor if one was provided
+	if c.IsSet(configApplyFlag.Name) {
+		return applyConfigFile(hd, c.String(configApplyFlag.Name), oldCfg, cfg, isNew, isUpdate)
+	}
+
 	// Save the config and exit in headless mode
 	if c.NumFlags() > 0 {
 		return fmt.Errorf("NYI")
@@ -58,6 +77,13 @@ func configureService(c *cli.Context) error {
 		*/
 	}
 
+	// The config editor is interactive
+	if hd.Context.OutputFormat != context.OutputFormat_Text {
+		return &utils.InputRequiredError{
+			Prompt: "the interactive configuration editor",
+		}
+	}
+
 	app := tview.NewApplication()
 	md := cliconfig.NewMainDisplay(app, oldCfg, cfg, isNew, isUpdate)
 	err = app.Run()
@@ -72,7 +98,7 @@ func configureService(c *cli.Context) error {
 		if err != nil {
 			return fmt.Errorf("error saving config: %w", err)
 		}
-		fmt.Println("Your changes have been saved!")
+		fmt.Fprintln(out, "Your changes have been saved!")
 
 		// Handle network changes
 		prefix := fmt.Sprint(md.PreviousConfig.Hyperdrive.ProjectName.Value)
@@ -84,60 +110,181 @@ func configureService(c *cli.Context) error {
 				return fmt.Errorf("error saving config: %w", err)
 			}
 
-			fmt.Printf("%sWARNING: You have requested to change networks.\n\nAll of your existing chain data, your node wallet, and your validator keys will be removed. If you had a Checkpoint Sync URL provided for your Beacon Node, it will be removed and you will need to specify a different one that supports the new network.\n\nPlease confirm you have backed up everything you want to keep, because it will be deleted if you answer `y` to the prompt below.\n\n%s", terminal.ColorYellow, terminal.ColorReset)
+			fmt.Fprintf(out, "%sWARNING: You have requested to change networks.\n\nAll of your existing chain data, your node wallet, and your validator keys will be removed. If you had a Checkpoint Sync URL provided for your Beacon Node, it will be removed and you will need to specify a different one that supports the new network.\n\nPlease confirm you have backed up everything you want to keep, because it will be deleted if you answer `y` to the prompt below.\n\n%s", terminal.ColorYellow, terminal.ColorReset)
 
-			if !utils.Confirm("Would you like Hyperdrive to automatically switch networks for you? This will destroy and rebuild your `data` folder and all of Hyperdrive's Docker containers.") {
-				fmt.Println("Please clean up the data folder manually before proceeding.")
+			confirmed, err := utils.Confirm("Would you like Hyperdrive to automatically switch networks for you? This will destroy and rebuild your `data` folder and all of Hyperdrive's Docker containers.")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				fmt.Fprintln(out, "Please clean up the data folder manually before proceeding.")
 				return nil
 			}
 
 			err = changeNetworks(c, hd)
 			if err != nil {
-				fmt.Printf("%s%s%s\nHyperdrive could not automatically change networks for you, so you will have to remove your old data folder manually.\n", terminal.ColorRed, err.Error(), terminal.ColorReset)
+				fmt.Fprintf(out, "%s%s%s\nHyperdrive could not automatically change networks for you, so you will have to remove your old data folder manually.\n", terminal.ColorRed, err.Error(), terminal.ColorReset)
 			}
 			return nil
 		}
 
 		// Query for service start if this is a new installation
 		if isNew {
-			if !utils.Confirm("Would you like to start the Hyperdrive services automatically now?") {
-				fmt.Println("Please run `hyperdrive service start` when you are ready to launch.")
+			confirmed, err := utils.Confirm("Would you like to start the Hyperdrive services automatically now?")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				fmt.Fprintln(out, "Please run `hyperdrive service start` when you are ready to launch.")
 				return nil
 			}
-			return startService(c, true)
+			return startService(c, true, false)
 		}
 
 		// Query for service start if this is old and there are containers to change
 		if len(md.ContainersToRestart) > 0 {
-			fmt.Println("The following containers must be restarted for the changes to take effect:")
+			fmt.Fprintln(out, "The following containers must be restarted for the changes to take effect:")
 			for _, container := range md.ContainersToRestart {
-				fmt.Printf("\t%s_%s\n", prefix, container)
+				fmt.Fprintf(out, "\t%s_%s\n", prefix, container)
 			}
-			if !utils.Confirm("Would you like to restart them automatically now?") {
-				fmt.Println("Please run `hyperdrive service start` when you are ready to apply the changes.")
+			confirmed, err := utils.Confirm("Would you like to restart them automatically now?")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				fmt.Fprintln(out, "Please run `hyperdrive service start` when you are ready to apply the changes.")
 				return nil
 			}
 
-			fmt.Println()
+			fmt.Fprintln(out)
 			for _, container := range md.ContainersToRestart {
 				fullName := fmt.Sprintf("%s_%s", prefix, container)
-				fmt.Printf("Stopping %s... ", fullName)
+				fmt.Fprintf(out, "Stopping %s... ", fullName)
 				hd.StopContainer(fullName)
-				fmt.Print("done!\n")
+				fmt.Fprint(out, "done!\n")
 			}
 
-			fmt.Println()
-			fmt.Println("Applying changes and restarting containers...")
-			return startService(c, true)
+			fmt.Fprintln(out)
+			fmt.Fprintln(out, "Applying changes and restarting containers...")
+			return startService(c, true, false)
 		}
 	} else {
-		fmt.Println("Your changes have not been saved. Your Hyperdrive configuration is the same as it was before.")
+		fmt.Fprintln(out, "Your changes have not been saved. Your Hyperdrive configuration is the same as it was before.")
 		return nil
 	}
 
 	return err
 }
 
+// Merge a partial settings document over the current config, then validate and save it
+func applyConfigFile(hd *client.HyperdriveClient, path string, oldCfg *client.GlobalConfig, cfg *client.GlobalConfig, isNew bool, isUpdate bool) error {
+	out := hd.Context.Output
+
+	// Read the document
+	var bytes []byte
+	var err error
+	if path == "-" {
+		bytes, err = io.ReadAll(os.Stdin)
+	} else {
+		bytes, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading settings document: %w", err)
+	}
+
+	// YAML is a superset of JSON, so this handles both
+	var settings map[string]any
+	err = yaml.Unmarshal(bytes, &settings)
+	if err != nil {
+		return fmt.Errorf("error parsing settings document: %w", err)
+	}
+
+	// Apply it to a copy of the config, keeping the original to compare against
+	if oldCfg == nil {
+		oldCfg = cfg
+		cfg = cfg.CreateCopy()
+	}
+	err = cfg.ApplyPartial(settings)
+	if err != nil {
+		return fmt.Errorf("error applying settings document: %w", err)
+	}
+	errs := cfg.Validate()
+	if len(errs) > 0 {
+		fmt.Fprintf(out, "%sThe resulting configuration is invalid:%s\n", terminal.ColorRed, terminal.ColorReset)
+		for _, err := range errs {
+			// Include the path so the setting can be found in the settings document
+			fmt.Fprintf(out, "\t%s: %s (%s)\n", err.Path, err.Message, err.Section)
+		}
+		return fmt.Errorf("the settings document was not applied")
+	}
+
+	// Get the changes
+	changedSections, affectedContainers, changeNetworks := cfg.GetChanges(oldCfg)
+	if changeNetworks && !isNew {
+		return fmt.Errorf("changing networks removes your chain data, node wallet, and validator keys, so it can't be done with --%s; please use the interactive editor instead", configApplyFlag.Name)
+	}
+	if isUpdate {
+		affectedContainers[config.ContainerID_Daemon] = true
+	}
+	if len(changedSections) == 0 && !isNew && !isUpdate {
+		fmt.Fprintln(out, "No changes; your Hyperdrive configuration already matches the settings document.")
+		return nil
+	}
+
+	// Print the changes
+	if isUpdate {
+		fmt.Fprintf(out, "Updated to Hyperdrive v%s (will affect several containers)\n\n", shared.HyperdriveVersion)
+	}
+	for _, section := range changedSections {
+		printChangedSection(out, section, "")
+	}
+
+	// Save
+	err = hd.SaveConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("error saving config: %w", err)
+	}
+	fmt.Fprintln(out, "Your changes have been saved!")
+	if isNew {
+		fmt.Fprintln(out, "Please run `hyperdrive service start` when you are ready to launch.")
+		return nil
+	}
+
+	// List the containers to restart
+	if len(affectedContainers) > 0 {
+		containers := make([]string, 0, len(affectedContainers))
+		for container := range affectedContainers {
+			containers = append(containers, oldCfg.Hyperdrive.GetDockerArtifactName(string(container)))
+		}
+		sort.Strings(containers)
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, "The following containers must be restarted for the changes to take effect:")
+		for _, container := range containers {
+			fmt.Fprintf(out, "\t%s\n", container)
+		}
+		fmt.Fprintln(out, "Please run `hyperdrive service start` when you are ready to apply the changes.")
+	}
+	return nil
+}
+
+// Print a changed config section and its changed subsections
+func printChangedSection(out io.Writer, section *config.ChangedSection, titlePrefix string) {
+	sectionName := section.Name
+	if titlePrefix != "" {
+		sectionName = fmt.Sprintf("%s > %s", titlePrefix, section.Name)
+	}
+	if len(section.Settings) > 0 {
+		fmt.Fprintf(out, "%s{%s}%s\n", terminal.ColorBold, sectionName, terminal.ColorReset)
+		for _, setting := range section.Settings {
+			fmt.Fprintf(out, "\t%s: %s => %s\n", setting.Name, setting.OldValue, setting.NewValue)
+		}
+		fmt.Fprintln(out)
+	}
+	for _, subsection := range section.Subsections {
+		printChangedSection(out, subsection, sectionName)
+	}
+}
+
 // TODO: HEADLESS MODE
 /*
 // Updates a configuration from the provided CLI arguments headlessly
@@ -17,8 +17,10 @@ type settingsHome struct {
 	hyperdrivePage   *HyperdriveConfigPage
 	ecPage           *ExecutionConfigPage
 	fallbackPage     *FallbackConfigPage
+	nativePage       *NativeConfigPage
 	ccPage           *BeaconConfigPage
 	metricsPage      *MetricsConfigPage
+	notificationPage *NotificationsConfigPage
 	modulesPage      *ModulesPage
 	categoryList     *tview.List
 	settingsSubpages []settingsPage
@@ -41,14 +43,18 @@ func newSettingsHome(md *mainDisplay) *settingsHome {
 	home.ecPage = NewExecutionConfigPage(home)
 	home.ccPage = NewBeaconConfigPage(home)
 	home.fallbackPage = NewFallbackConfigPage(home)
+	home.nativePage = NewNativeConfigPage(home)
 	home.metricsPage = NewMetricsConfigPage(home)
+	home.notificationPage = NewNotificationsConfigPage(home)
 	home.modulesPage = NewModulesPage(home)
 	settingsSubpages := []settingsPage{
 		home.hyperdrivePage,
 		home.ecPage,
 		home.ccPage,
 		home.fallbackPage,
+		home.nativePage,
 		home.metricsPage,
+		home.notificationPage,
 		home.modulesPage,
 	}
 	home.settingsSubpages = settingsSubpages
@@ -226,6 +232,10 @@ func (home *settingsHome) refresh() {
 		home.fallbackPage.layout.refresh()
 	}
 
+	if home.nativePage != nil {
+		home.nativePage.layout.refresh()
+	}
+
 	if home.metricsPage != nil {
 		home.metricsPage.layout.refresh()
 	}
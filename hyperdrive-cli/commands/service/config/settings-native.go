@@ -0,0 +1,92 @@
+package config
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+)
+
+// The page wrapper for the native mode config
+type NativeConfigPage struct {
+	home         *settingsHome
+	page         *page
+	layout       *standardLayout
+	masterConfig *client.GlobalConfig
+	nativeItems  []*parameterizedFormItem
+}
+
+// Creates a new page for the native mode settings
+func NewNativeConfigPage(home *settingsHome) *NativeConfigPage {
+
+	configPage := &NativeConfigPage{
+		home:         home,
+		masterConfig: home.md.Config,
+	}
+	configPage.createContent()
+
+	configPage.page = newPage(
+		home.homePage,
+		"settings-native",
+		"Native Mode",
+		"Select this to configure how Hyperdrive runs its services as systemd units when its Deployment Mode is set to Native, such as where the clients listen and where their data is stored.",
+		configPage.layout.grid,
+	)
+
+	return configPage
+
+}
+
+// Get the underlying page
+func (configPage *NativeConfigPage) getPage() *page {
+	return configPage.page
+}
+
+// Creates the content for the native mode settings page
+func (configPage *NativeConfigPage) createContent() {
+
+	// Create the layout
+	configPage.layout = newStandardLayout()
+	configPage.layout.createForm(&configPage.masterConfig.Hyperdrive.Network, "Native Mode Settings")
+
+	// Return to the home page after pressing Escape
+	configPage.layout.form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			// Close all dropdowns and break if one was open
+			for _, param := range configPage.layout.parameters {
+				dropDown, ok := param.item.(*DropDown)
+				if ok && dropDown.open {
+					dropDown.CloseList(configPage.home.md.app)
+					return nil
+				}
+			}
+
+			// Return to the home page
+			configPage.home.md.setPage(configPage.home.homePage)
+			return nil
+		}
+		return event
+	})
+
+	// Set up the form items
+	configPage.nativeItems = createParameterizedFormItems(configPage.masterConfig.Hyperdrive.Native.GetParameters(), configPage.layout.descriptionBox)
+
+	// Map the parameters to the form items in the layout
+	configPage.layout.mapParameterizedFormItems(configPage.nativeItems...)
+
+	// Do the initial draw
+	configPage.handleLayoutChanged()
+}
+
+// Handle a bulk redraw request
+func (configPage *NativeConfigPage) handleLayoutChanged() {
+	configPage.layout.form.Clear(true)
+
+	// The client hosts and chain data folder only apply to locally managed clients
+	nativeItems := []*parameterizedFormItem{}
+	for _, item := range configPage.nativeItems {
+		if configPage.masterConfig.Hyperdrive.Native.IsInUse(item.parameter.GetCommon().ID) {
+			nativeItems = append(nativeItems, item)
+		}
+	}
+	configPage.layout.addFormItems(nativeItems)
+	configPage.layout.refresh()
+}
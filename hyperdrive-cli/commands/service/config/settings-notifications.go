@@ -0,0 +1,112 @@
+package config
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/rivo/tview"
+)
+
+// The page wrapper for the notifications config
+type NotificationsConfigPage struct {
+	home                   *settingsHome
+	page                   *page
+	layout                 *standardLayout
+	masterConfig           *client.GlobalConfig
+	enableNotificationsBox *parameterizedFormItem
+	notificationItems      []*parameterizedFormItem
+}
+
+// Creates a new page for the notification settings
+func NewNotificationsConfigPage(home *settingsHome) *NotificationsConfigPage {
+
+	configPage := &NotificationsConfigPage{
+		home:         home,
+		masterConfig: home.md.Config,
+	}
+	configPage.createContent()
+
+	configPage.page = newPage(
+		home.homePage,
+		"settings-notifications",
+		"Notifications",
+		"Select this to have Hyperdrive alert you through a webhook (such as Discord or Slack) or by email when something goes wrong with your node.",
+		configPage.layout.grid,
+	)
+
+	return configPage
+
+}
+
+// Get the underlying page
+func (configPage *NotificationsConfigPage) getPage() *page {
+	return configPage.page
+}
+
+// Creates the content for the notification settings page
+func (configPage *NotificationsConfigPage) createContent() {
+
+	// Create the layout
+	configPage.layout = newStandardLayout()
+	configPage.layout.createForm(&configPage.masterConfig.Hyperdrive.Network, "Notification Settings")
+
+	// Return to the home page after pressing Escape
+	configPage.layout.form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			// Close all dropdowns and break if one was open
+			for _, param := range configPage.layout.parameters {
+				dropDown, ok := param.item.(*DropDown)
+				if ok && dropDown.open {
+					dropDown.CloseList(configPage.home.md.app)
+					return nil
+				}
+			}
+
+			// Return to the home page
+			configPage.home.md.setPage(configPage.home.homePage)
+			return nil
+		}
+		return event
+	})
+
+	// Set up the form items
+	configPage.enableNotificationsBox = createParameterizedCheckbox(&configPage.masterConfig.Hyperdrive.Notifications.EnableNotifications)
+	configPage.notificationItems = createParameterizedFormItems(configPage.masterConfig.Hyperdrive.Notifications.GetParameters(), configPage.layout.descriptionBox)
+
+	// Take the enable out since it's done explicitly
+	notificationItems := []*parameterizedFormItem{}
+	for _, item := range configPage.notificationItems {
+		if item.parameter.GetCommon().ID == config.EnableNotificationsID {
+			continue
+		}
+		notificationItems = append(notificationItems, item)
+	}
+	configPage.notificationItems = notificationItems
+
+	// Map the parameters to the form items in the layout
+	configPage.layout.mapParameterizedFormItems(configPage.enableNotificationsBox)
+	configPage.layout.mapParameterizedFormItems(configPage.notificationItems...)
+
+	// Set up the setting callbacks
+	configPage.enableNotificationsBox.item.(*tview.Checkbox).SetChangedFunc(func(checked bool) {
+		if configPage.masterConfig.Hyperdrive.Notifications.EnableNotifications.Value == checked {
+			return
+		}
+		configPage.masterConfig.Hyperdrive.Notifications.EnableNotifications.Value = checked
+		configPage.handleLayoutChanged()
+	})
+
+	// Do the initial draw
+	configPage.handleLayoutChanged()
+}
+
+// Handle all of the form changes when the Enable Notifications box has changed
+func (configPage *NotificationsConfigPage) handleLayoutChanged() {
+	configPage.layout.form.Clear(true)
+	configPage.layout.form.AddFormItem(configPage.enableNotificationsBox.item)
+
+	if configPage.masterConfig.Hyperdrive.Notifications.EnableNotifications.Value {
+		configPage.layout.addFormItems(configPage.notificationItems)
+	}
+	configPage.layout.refresh()
+}
@@ -0,0 +1,544 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/docker/docker/api/types/versions"
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/utils"
+	"github.com/nodeset-org/hyperdrive/shared/utils/sys"
+	"github.com/pbnjay/memory"
+)
+
+const (
+	// Check categories
+	doctorCategory_Config      string = "Configuration"
+	doctorCategory_Docker      string = "Docker"
+	doctorCategory_System      string = "System"
+	doctorCategory_Storage     string = "Storage"
+	doctorCategory_Ports       string = "Ports"
+	doctorCategory_Permissions string = "Permissions"
+	doctorCategory_Clients     string = "External Clients"
+
+	// How long to wait for each network request
+	doctorTimeout time.Duration = 5 * time.Second
+
+	// Clock offset thresholds; validators start missing duties well before the clock is a full slot off
+	clockOffsetWarnThreshold time.Duration = 500 * time.Millisecond
+	clockOffsetFailThreshold time.Duration = 2 * time.Second
+
+	// Memory thresholds, in GiB; these are a bit under the nominal sizes since some memory is always reserved by the system
+	localModeMinMemoryGiB         uint64 = 7
+	localModeRecommendedMemoryGiB uint64 = 15
+	externalModeMinMemoryGiB      uint64 = 3
+
+	// The minimum free space for the user data directory, in GiB
+	userDataMinFreeGiB uint64 = 1
+
+	// The number of bytes in a GiB
+	gibibyte uint64 = 1024 * 1024 * 1024
+)
+
+// Rough disk space needed for each Execution client's chain data, in GiB, including some room for growth
+var expectedEcDataSizes = map[config.Network]map[config.ExecutionClient]uint64{
+	config.Network_Mainnet: {
+		config.ExecutionClient_Geth:       1300,
+		config.ExecutionClient_Nethermind: 1200,
+		config.ExecutionClient_Besu:       1300,
+	},
+	config.Network_Holesky: {
+		config.ExecutionClient_Geth:       200,
+		config.ExecutionClient_Nethermind: 250,
+		config.ExecutionClient_Besu:       250,
+	},
+}
+
+// Rough disk space needed for each Beacon Node's chain data, in GiB, including some room for growth
+var expectedBnDataSizes = map[config.Network]map[config.BeaconNode]uint64{
+	config.Network_Mainnet: {
+		config.BeaconNode_Lighthouse: 250,
+		config.BeaconNode_Lodestar:   300,
+		config.BeaconNode_Nimbus:     250,
+		config.BeaconNode_Prysm:      300,
+		config.BeaconNode_Teku:       250,
+	},
+	config.Network_Holesky: {
+		config.BeaconNode_Lighthouse: 100,
+		config.BeaconNode_Lodestar:   120,
+		config.BeaconNode_Nimbus:     100,
+		config.BeaconNode_Prysm:      120,
+		config.BeaconNode_Teku:       100,
+	},
+}
+
+// A port that Hyperdrive publishes on the host
+type hostPort struct {
+	name string
+	port uint16
+	tcp  bool
+	udp  bool
+}
+
+// Check the Docker Engine and Docker Compose versions
+func checkDocker(hd *client.HyperdriveClient, report *doctorReport) {
+	// Docker Engine
+	d, err := hd.GetDocker()
+	if err != nil {
+		report.add(doctorCategory_Docker, "Docker Engine", doctorStatus_Fail, "%s", err.Error())
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), doctorTimeout)
+		defer cancel()
+		version, err := d.ServerVersion(ctx)
+		if err != nil {
+			report.add(doctorCategory_Docker, "Docker Engine", doctorStatus_Fail, "Couldn't connect to the Docker daemon (%s). Make sure Docker is installed and running, and that your user is in the `docker` group.", err.Error())
+		} else if versions.LessThan(version.APIVersion, config.DockerApiVersion) {
+			report.add(doctorCategory_Docker, "Docker Engine", doctorStatus_Fail, "Docker %s supports API version %s, but Hyperdrive requires %s or newer. Please upgrade Docker.", version.Version, version.APIVersion, config.DockerApiVersion)
+		} else {
+			report.add(doctorCategory_Docker, "Docker Engine", doctorStatus_Pass, "Docker %s (API version %s, Hyperdrive requires %s or newer).", version.Version, version.APIVersion, config.DockerApiVersion)
+		}
+	}
+
+	// Docker Compose
+	composeVersionString, err := hd.GetDockerComposeVersion()
+	if err != nil {
+		report.add(doctorCategory_Docker, "Docker Compose", doctorStatus_Fail, "The Docker Compose plugin isn't installed or couldn't be run: %s. Please install the `docker-compose-plugin` package.", err.Error())
+		return
+	}
+	composeVersion, err := semver.ParseTolerant(composeVersionString)
+	if err != nil {
+		report.add(doctorCategory_Docker, "Docker Compose", doctorStatus_Warn, "Couldn't parse the Docker Compose version [%s]: %s", composeVersionString, err.Error())
+	} else if composeVersion.Major < 2 {
+		report.add(doctorCategory_Docker, "Docker Compose", doctorStatus_Fail, "Docker Compose %s is too old; Hyperdrive requires v2 or newer. Please install the `docker-compose-plugin` package.", composeVersion)
+	} else {
+		report.add(doctorCategory_Docker, "Docker Compose", doctorStatus_Pass, "Docker Compose %s.", composeVersion)
+	}
+}
+
+// Check the system's memory, CPU features, and clock
+func checkSystem(cfg *client.GlobalConfig, ntpServer string, report *doctorReport) {
+	// Memory
+	totalMemory := memory.TotalMemory()
+	totalMemoryString := formatGiB(totalMemory)
+	if cfg == nil || cfg.Hyperdrive.IsLocalMode() {
+		switch {
+		case totalMemory < localModeMinMemoryGiB*gibibyte:
+			report.add(doctorCategory_System, "Memory", doctorStatus_Fail, "Your system has %s of RAM, which isn't enough to run an Execution client and Beacon Node. At least 16 GB is recommended, or use external clients.", totalMemoryString)
+		case totalMemory < localModeRecommendedMemoryGiB*gibibyte:
+			report.add(doctorCategory_System, "Memory", doctorStatus_Warn, "Your system has %s of RAM. At least 16 GB is recommended for running an Execution client and Beacon Node.", totalMemoryString)
+		default:
+			report.add(doctorCategory_System, "Memory", doctorStatus_Pass, "Your system has %s of RAM.", totalMemoryString)
+		}
+	} else {
+		if totalMemory < externalModeMinMemoryGiB*gibibyte {
+			report.add(doctorCategory_System, "Memory", doctorStatus_Warn, "Your system has %s of RAM. At least 4 GB is recommended.", totalMemoryString)
+		} else {
+			report.add(doctorCategory_System, "Memory", doctorStatus_Pass, "Your system has %s of RAM.", totalMemoryString)
+		}
+	}
+
+	// CPU features
+	missingFeatures := sys.GetMissingModernCpuFeatures()
+	if len(missingFeatures) > 0 {
+		report.add(doctorCategory_System, "CPU Features", doctorStatus_Warn, "Your CPU is missing the following features: %s. You must use the 'portable' images of your clients.", strings.Join(missingFeatures, ", "))
+	} else {
+		report.add(doctorCategory_System, "CPU Features", doctorStatus_Pass, "Your CPU supports all of the features required by 'modern' images.")
+	}
+
+	// Clock
+	offset, err := sys.GetClockOffset(ntpServer, doctorTimeout)
+	if err != nil {
+		report.add(doctorCategory_System, "Clock Synchronization", doctorStatus_Warn, "Couldn't check the system clock: %s", err.Error())
+		return
+	}
+	if offset < 0 {
+		offset = -offset
+	}
+	switch {
+	case offset >= clockOffsetFailThreshold:
+		report.add(doctorCategory_System, "Clock Synchronization", doctorStatus_Fail, "Your system clock is off by %s compared to %s, which will cause your validators to miss duties. Make sure time synchronization (e.g. chrony or systemd-timesyncd) is enabled.", offset.Round(time.Millisecond), ntpServer)
+	case offset >= clockOffsetWarnThreshold:
+		report.add(doctorCategory_System, "Clock Synchronization", doctorStatus_Warn, "Your system clock is off by %s compared to %s. Make sure time synchronization (e.g. chrony or systemd-timesyncd) is enabled.", offset.Round(time.Millisecond), ntpServer)
+	default:
+		report.add(doctorCategory_System, "Clock Synchronization", doctorStatus_Pass, "Your system clock is within %s of %s.", offset.Round(time.Millisecond), ntpServer)
+	}
+}
+
+// Check the free space for the user data and the Docker volumes
+func checkStorage(hd *client.HyperdriveClient, cfg *client.GlobalConfig, report *doctorReport) {
+	// User data
+	userDataPath, err := homedir.Expand(cfg.Hyperdrive.UserDataPath.Value)
+	if err != nil {
+		report.add(doctorCategory_Storage, "User Data", doctorStatus_Fail, "Error expanding the user data path [%s]: %s", cfg.Hyperdrive.UserDataPath.Value, err.Error())
+	} else {
+		free, checkedPath, err := sys.GetFreeSpace(userDataPath)
+		switch {
+		case err != nil:
+			report.add(doctorCategory_Storage, "User Data", doctorStatus_Warn, "Couldn't check the free space for [%s]: %s", userDataPath, err.Error())
+		case free < userDataMinFreeGiB*gibibyte:
+			report.add(doctorCategory_Storage, "User Data", doctorStatus_Warn, "Only %s is free on the filesystem holding [%s].", formatGiB(free), checkedPath)
+		default:
+			report.add(doctorCategory_Storage, "User Data", doctorStatus_Pass, "%s is free on the filesystem holding [%s].", formatGiB(free), checkedPath)
+		}
+	}
+
+	// Docker volumes
+	d, err := hd.GetDocker()
+	if err != nil {
+		report.add(doctorCategory_Storage, "Docker Volumes", doctorStatus_Warn, "Couldn't check the free space for Docker volumes: %s", err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), doctorTimeout)
+	defer cancel()
+	info, err := d.Info(ctx)
+	if err != nil {
+		report.add(doctorCategory_Storage, "Docker Volumes", doctorStatus_Warn, "Couldn't get the Docker data directory: %s", err.Error())
+		return
+	}
+	free, checkedPath, err := sys.GetFreeSpace(info.DockerRootDir)
+	if err != nil {
+		report.add(doctorCategory_Storage, "Docker Volumes", doctorStatus_Warn, "Couldn't check the free space for [%s]: %s", info.DockerRootDir, err.Error())
+		return
+	}
+	if !cfg.Hyperdrive.IsLocalMode() {
+		report.add(doctorCategory_Storage, "Docker Volumes", doctorStatus_Pass, "%s is free on the filesystem holding [%s]; your clients are external, so no chain data is stored locally.", formatGiB(free), checkedPath)
+		return
+	}
+
+	// Compare against the space the selected clients need, minus what their existing chain data already takes up
+	network := cfg.Hyperdrive.Network.Value
+	if network == config.Network_HoleskyDev {
+		network = config.Network_Holesky
+	}
+	ec := cfg.Hyperdrive.LocalExecutionConfig.ExecutionClient.Value
+	bn := cfg.Hyperdrive.LocalBeaconConfig.BeaconNode.Value
+	expected := (expectedEcDataSizes[network][ec] + expectedBnDataSizes[network][bn]) * gibibyte
+	if expected == 0 {
+		report.add(doctorCategory_Storage, "Docker Volumes", doctorStatus_Pass, "%s is free on the filesystem holding [%s].", formatGiB(free), checkedPath)
+		return
+	}
+	used := uint64(0)
+	for _, volume := range []string{config.ExecutionClientDataVolume, config.BeaconNodeDataVolume} {
+		size, err := hd.GetVolumeSize(cfg.Hyperdrive.GetDockerArtifactName(volume))
+		if err == nil && size > 0 {
+			used += uint64(size)
+		}
+	}
+	needed := uint64(0)
+	if used < expected {
+		needed = expected - used
+	}
+	switch {
+	case free < needed:
+		report.add(doctorCategory_Storage, "Docker Volumes", doctorStatus_Fail, "%s and %s need about %s for their chain data, but only %s is free on the filesystem holding [%s] (%s is already used). Your clients will run out of space.", ec, bn, formatGiB(expected), formatGiB(free), checkedPath, formatGiB(used))
+	case free < needed+expected/10:
+		report.add(doctorCategory_Storage, "Docker Volumes", doctorStatus_Warn, "%s and %s need about %s for their chain data, and %s is free on the filesystem holding [%s] (%s is already used). There is little room left for growth.", ec, bn, formatGiB(expected), formatGiB(free), checkedPath, formatGiB(used))
+	default:
+		report.add(doctorCategory_Storage, "Docker Volumes", doctorStatus_Pass, "%s and %s need about %s for their chain data, and %s is free on the filesystem holding [%s] (%s is already used).", ec, bn, formatGiB(expected), formatGiB(free), checkedPath, formatGiB(used))
+	}
+}
+
+// Check that the ports Hyperdrive publishes on the host aren't taken by other processes
+func checkHostPorts(hd *client.HyperdriveClient, cfg *client.GlobalConfig, report *doctorReport) {
+	hdCfg := cfg.Hyperdrive
+	ports := []hostPort{}
+	if hdCfg.IsLocalMode() {
+		ec := hdCfg.LocalExecutionConfig
+		bn := hdCfg.LocalBeaconConfig
+		ports = append(ports,
+			hostPort{name: "Execution Client P2P", port: ec.P2pPort.Value, tcp: true, udp: true},
+			hostPort{name: "Beacon Node P2P", port: bn.P2pPort.Value, tcp: true, udp: true},
+		)
+		if bn.BeaconNode.Value == config.BeaconNode_Lighthouse {
+			ports = append(ports, hostPort{name: "Lighthouse QUIC", port: bn.Lighthouse.P2pQuicPort.Value, udp: true})
+		}
+		if ec.OpenApiPorts.Value.IsOpen() {
+			ports = append(ports,
+				hostPort{name: "Execution Client HTTP API", port: ec.HttpPort.Value, tcp: true},
+				hostPort{name: "Execution Client Websocket API", port: ec.WebsocketPort.Value, tcp: true},
+			)
+		}
+		if bn.OpenHttpPort.Value.IsOpen() {
+			ports = append(ports, hostPort{name: "Beacon Node HTTP API", port: bn.HttpPort.Value, tcp: true})
+		}
+		if bn.BeaconNode.Value == config.BeaconNode_Prysm && bn.Prysm.OpenRpcPort.Value.IsOpen() {
+			ports = append(ports, hostPort{name: "Prysm RPC", port: bn.Prysm.RpcPort.Value, tcp: true})
+		}
+	}
+	if hdCfg.Metrics.EnableMetrics.Value {
+		ports = append(ports, hostPort{name: "Grafana", port: hdCfg.Metrics.Grafana.Port.Value, tcp: true})
+		if hdCfg.Metrics.Prometheus.OpenPort.Value.IsOpen() {
+			ports = append(ports, hostPort{name: "Prometheus", port: hdCfg.Metrics.Prometheus.Port.Value, tcp: true})
+		}
+	}
+	if len(ports) == 0 {
+		return
+	}
+
+	// Ports held by Hyperdrive's own containers are fine
+	publishedPorts, err := hd.GetPublishedPorts(hdCfg.ProjectName.Value)
+	if err != nil {
+		publishedPorts = map[uint16]string{}
+	}
+	for _, port := range ports {
+		name := fmt.Sprintf("%s (%s)", port.name, port.getProtocols())
+		err := port.checkAvailable()
+		if err == nil {
+			report.add(doctorCategory_Ports, name, doctorStatus_Pass, "Port %d is available.", port.port)
+			continue
+		}
+		if container, exists := publishedPorts[port.port]; exists {
+			report.add(doctorCategory_Ports, name, doctorStatus_Pass, "Port %d is in use by Hyperdrive's %s container.", port.port, container)
+			continue
+		}
+		if errors.Is(err, fs.ErrPermission) {
+			report.add(doctorCategory_Ports, name, doctorStatus_Warn, "Couldn't check port %d without root privileges.", port.port)
+			continue
+		}
+		report.add(doctorCategory_Ports, name, doctorStatus_Fail, "Port %d is already in use by another process (%s). Stop that process or choose a different port in `hyperdrive service config`.", port.port, err.Error())
+	}
+}
+
+// Get the protocols of the port for display
+func (p hostPort) getProtocols() string {
+	protocols := []string{}
+	if p.tcp {
+		protocols = append(protocols, "TCP")
+	}
+	if p.udp {
+		protocols = append(protocols, "UDP")
+	}
+	return strings.Join(protocols, "/")
+}
+
+// Check if the port can be bound on all interfaces for each of its protocols
+func (p hostPort) checkAvailable() error {
+	address := fmt.Sprintf(":%d", p.port)
+	if p.tcp {
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+		listener.Close()
+	}
+	if p.udp {
+		conn, err := net.ListenPacket("udp", address)
+		if err != nil {
+			return err
+		}
+		conn.Close()
+	}
+	return nil
+}
+
+// Check the ownership and permissions of the Hyperdrive directories and sockets
+func checkPermissions(hd *client.HyperdriveClient, cfg *client.GlobalConfig, report *doctorReport) {
+	// The config directory must be writable so the settings and templates can be saved
+	configPath, err := homedir.Expand(hd.Context.ConfigPath)
+	if err != nil {
+		report.add(doctorCategory_Permissions, "Config Directory", doctorStatus_Fail, "Error expanding the config path [%s]: %s", hd.Context.ConfigPath, err.Error())
+		return
+	}
+	testFile, err := os.CreateTemp(configPath, ".doctor-*")
+	if err != nil {
+		report.add(doctorCategory_Permissions, "Config Directory", doctorStatus_Fail, "Your user can't write to [%s]: %s. Make sure it's owned by your user.", configPath, err.Error())
+	} else {
+		testFile.Close()
+		os.Remove(testFile.Name())
+		report.add(doctorCategory_Permissions, "Config Directory", doctorStatus_Pass, "Your user can write to [%s].", configPath)
+	}
+
+	// The settings file must be writable too, since it may be owned by root if Hyperdrive was ever configured with sudo
+	settingsPath := filepath.Join(configPath, config.ConfigFilename)
+	settingsFile, err := os.OpenFile(settingsPath, os.O_WRONLY, 0)
+	if err != nil {
+		report.add(doctorCategory_Permissions, "Settings File", doctorStatus_Fail, "Your user can't write to [%s]: %s. Make sure it's owned by your user.", settingsPath, err.Error())
+	} else {
+		settingsFile.Close()
+		report.add(doctorCategory_Permissions, "Settings File", doctorStatus_Pass, "Your user can write to [%s].", settingsPath)
+	}
+
+	// The API sockets must be usable by the CLI
+	checkSocket(report, "Hyperdrive Daemon Socket", filepath.Join(configPath, config.HyperdriveSocketFilename))
+	if cfg.Stakewise.Enabled.Value {
+		checkSocket(report, "Stakewise Daemon Socket", filepath.Join(configPath, swconfig.SocketFilename))
+	}
+
+	// The user data directory holds the node wallet and its password, so other users shouldn't be able to access it
+	userDataPath, err := homedir.Expand(cfg.Hyperdrive.UserDataPath.Value)
+	if err != nil {
+		return
+	}
+	info, err := os.Stat(userDataPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		report.add(doctorCategory_Permissions, "User Data Directory", doctorStatus_Pass, "[%s] doesn't exist yet; it will be created when Hyperdrive starts.", userDataPath)
+	case err != nil:
+		report.add(doctorCategory_Permissions, "User Data Directory", doctorStatus_Warn, "Couldn't check [%s]: %s", userDataPath, err.Error())
+	case info.Mode().Perm()&0o007 != 0:
+		report.add(doctorCategory_Permissions, "User Data Directory", doctorStatus_Warn, "[%s] can be accessed by every user on the system, but it holds your node wallet and its password. Restrict it with `sudo chmod o-rwx %s`.", userDataPath, userDataPath)
+	default:
+		report.add(doctorCategory_Permissions, "User Data Directory", doctorStatus_Pass, "[%s] can't be accessed by other users.", userDataPath)
+	}
+}
+
+// Check that a daemon's API socket exists and can be connected to
+func checkSocket(report *doctorReport, name string, path string) {
+	_, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		report.add(doctorCategory_Permissions, name, doctorStatus_Warn, "[%s] doesn't exist, so the daemon isn't running. Start Hyperdrive with `hyperdrive service start`.", path)
+		return
+	}
+	conn, err := net.DialTimeout("unix", path, doctorTimeout)
+	if err != nil {
+		if errors.Is(err, syscall.EACCES) {
+			report.add(doctorCategory_Permissions, name, doctorStatus_Fail, "Your user doesn't have permission to use [%s]. Make sure the Hyperdrive config directory is owned by your user.", path)
+		} else {
+			report.add(doctorCategory_Permissions, name, doctorStatus_Warn, "Couldn't connect to [%s]: %s. The daemon may not be running.", path, err.Error())
+		}
+		return
+	}
+	conn.Close()
+	report.add(doctorCategory_Permissions, name, doctorStatus_Pass, "Your user can connect to [%s].", path)
+}
+
+// Check that the configured external and fallback clients can be reached and are on the right network
+func checkClientReachability(cfg *client.GlobalConfig, report *doctorReport) {
+	hdCfg := cfg.Hyperdrive
+	chainID := utils.NewResources(hdCfg.Network.Value).ChainID
+	isPrysm := hdCfg.GetSelectedBeaconNode() == config.BeaconNode_Prysm
+	if !hdCfg.IsLocalMode() {
+		external := hdCfg.ExternalExecutionConfig
+		checkExecutionClient(report, "External Execution Client HTTP API", external.HttpUrl.Value, chainID)
+		checkTcpEndpoint(report, "External Execution Client Websocket API", external.WebsocketUrl.Value)
+		checkBeaconNode(report, "External Beacon Node HTTP API", hdCfg.ExternalBeaconConfig.HttpUrl.Value, chainID)
+		if isPrysm {
+			checkTcpEndpoint(report, "External Prysm RPC", hdCfg.ExternalBeaconConfig.PrysmRpcUrl.Value)
+		}
+	}
+	if hdCfg.Fallback.UseFallbackClients.Value {
+		checkExecutionClient(report, "Fallback Execution Client", hdCfg.Fallback.EcHttpUrl.Value, chainID)
+		checkBeaconNode(report, "Fallback Beacon Node", hdCfg.Fallback.BnHttpUrl.Value, chainID)
+		if isPrysm {
+			checkTcpEndpoint(report, "Fallback Prysm RPC", hdCfg.Fallback.PrysmRpcUrl.Value)
+		}
+	}
+}
+
+// Check that an Execution client's HTTP API responds and is on the expected chain
+func checkExecutionClient(report *doctorReport, name string, clientUrl string, expectedChainID uint) {
+	var response struct {
+		Result string `json:"result"`
+	}
+	request := []byte(`{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`)
+	err := doctorHttpRequest(http.MethodPost, clientUrl, request, &response)
+	if err != nil {
+		report.add(doctorCategory_Clients, name, doctorStatus_Fail, "Couldn't reach [%s]: %s", clientUrl, err.Error())
+		return
+	}
+	chainID, ok := new(big.Int).SetString(strings.TrimPrefix(response.Result, "0x"), 16)
+	if !ok {
+		report.add(doctorCategory_Clients, name, doctorStatus_Warn, "[%s] responded, but its chain ID [%s] couldn't be parsed.", clientUrl, response.Result)
+		return
+	}
+	if chainID.Uint64() != uint64(expectedChainID) {
+		report.add(doctorCategory_Clients, name, doctorStatus_Fail, "[%s] is on chain %s, but Hyperdrive is configured for chain %d.", clientUrl, chainID, expectedChainID)
+		return
+	}
+	report.add(doctorCategory_Clients, name, doctorStatus_Pass, "[%s] is reachable and on chain %d.", clientUrl, expectedChainID)
+}
+
+// Check that a Beacon Node's HTTP API responds and is on the expected chain
+func checkBeaconNode(report *doctorReport, name string, clientUrl string, expectedChainID uint) {
+	var response struct {
+		Data struct {
+			ChainID string `json:"chain_id"`
+		} `json:"data"`
+	}
+	err := doctorHttpRequest(http.MethodGet, strings.TrimSuffix(clientUrl, "/")+"/eth/v1/config/deposit_contract", nil, &response)
+	if err != nil {
+		report.add(doctorCategory_Clients, name, doctorStatus_Fail, "Couldn't reach [%s]: %s", clientUrl, err.Error())
+		return
+	}
+	if response.Data.ChainID != strconv.FormatUint(uint64(expectedChainID), 10) {
+		report.add(doctorCategory_Clients, name, doctorStatus_Fail, "[%s] is on chain %s, but Hyperdrive is configured for chain %d.", clientUrl, response.Data.ChainID, expectedChainID)
+		return
+	}
+	report.add(doctorCategory_Clients, name, doctorStatus_Pass, "[%s] is reachable and on chain %d.", clientUrl, expectedChainID)
+}
+
+// Check that a TCP endpoint, given as a URL or a host:port address, accepts connections
+func checkTcpEndpoint(report *doctorReport, name string, endpoint string) {
+	address := endpoint
+	if parsedUrl, err := url.Parse(endpoint); err == nil && parsedUrl.Host != "" {
+		address = parsedUrl.Host
+		if parsedUrl.Port() == "" {
+			switch parsedUrl.Scheme {
+			case "https", "wss":
+				address = net.JoinHostPort(parsedUrl.Hostname(), "443")
+			default:
+				address = net.JoinHostPort(parsedUrl.Hostname(), "80")
+			}
+		}
+	}
+	conn, err := net.DialTimeout("tcp", address, doctorTimeout)
+	if err != nil {
+		report.add(doctorCategory_Clients, name, doctorStatus_Fail, "Couldn't connect to [%s]: %s", endpoint, err.Error())
+		return
+	}
+	conn.Close()
+	report.add(doctorCategory_Clients, name, doctorStatus_Pass, "[%s] accepts connections.", endpoint)
+}
+
+// Send an HTTP request with a JSON body and decode the JSON response
+func doctorHttpRequest(method string, requestUrl string, body []byte, result any) error {
+	request, err := http.NewRequest(method, requestUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	httpClient := http.Client{Timeout: doctorTimeout}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with status %s", response.Status)
+	}
+	err = json.Unmarshal(responseBody, result)
+	if err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+}
+
+// Format a number of bytes in GiB
+func formatGiB(size uint64) string {
+	return fmt.Sprintf("%.1f GiB", float64(size)/float64(gibibyte))
+}
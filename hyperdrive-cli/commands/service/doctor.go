@@ -0,0 +1,157 @@
+package service
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	hdcontext "github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/context"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/output"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared/utils/sys"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	doctorNtpServerFlag *cli.StringFlag = &cli.StringFlag{
+		Name:  "ntp-server",
+		Usage: "The NTP server to compare the system clock against",
+		Value: sys.DefaultNtpServer,
+	}
+)
+
+// The result of a single doctor check
+type doctorStatus string
+
+const (
+	doctorStatus_Pass doctorStatus = "pass"
+	doctorStatus_Warn doctorStatus = "warn"
+	doctorStatus_Fail doctorStatus = "fail"
+)
+
+// A single check of the host environment
+type doctorCheck struct {
+	Category string       `json:"category"`
+	Name     string       `json:"name"`
+	Status   doctorStatus `json:"status"`
+	Message  string       `json:"message"`
+}
+
+// The results of all of the doctor checks
+type doctorReport struct {
+	// The worst status of all of the checks
+	Status doctorStatus  `json:"status"`
+	Checks []doctorCheck `json:"checks"`
+}
+
+// Add a check result to the report
+func (r *doctorReport) add(category string, name string, status doctorStatus, format string, args ...any) {
+	r.Checks = append(r.Checks, doctorCheck{
+		Category: category,
+		Name:     name,
+		Status:   status,
+		Message:  fmt.Sprintf(format, args...),
+	})
+	if status == doctorStatus_Fail || (status == doctorStatus_Warn && r.Status == doctorStatus_Pass) {
+		r.Status = status
+	}
+}
+
+// Check the host environment for problems that would stop Hyperdrive from running properly
+func runDoctor(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+	format := hd.Context.OutputFormat
+	if format == hdcontext.OutputFormat_Text {
+		fmt.Fprintln(out, "Checking your system, this may take a few seconds...")
+		fmt.Fprintln(out)
+	}
+
+	// Run the checks
+	report := &doctorReport{
+		Status: doctorStatus_Pass,
+		Checks: []doctorCheck{},
+	}
+	cfg, isNew, err := hd.LoadConfig()
+	if err != nil {
+		report.add(doctorCategory_Config, "Settings", doctorStatus_Fail, "Error loading the Hyperdrive config: %s", err.Error())
+		cfg = nil
+	} else if isNew {
+		report.add(doctorCategory_Config, "Settings", doctorStatus_Warn, "Hyperdrive hasn't been configured yet, so the checks that depend on its settings were skipped. Run `hyperdrive service config` first.")
+		cfg = nil
+	} else {
+		errs := cfg.Validate()
+		if len(errs) > 0 {
+			for _, err := range errs {
+				report.add(doctorCategory_Config, err.Section+" - "+err.ParameterName, doctorStatus_Fail, "%s", err.Message)
+			}
+		} else {
+			report.add(doctorCategory_Config, "Settings", doctorStatus_Pass, "The configuration is valid.")
+		}
+	}
+	checkDocker(hd, report)
+	checkSystem(cfg, c.String(doctorNtpServerFlag.Name), report)
+	if cfg != nil {
+		checkStorage(hd, cfg, report)
+		checkHostPorts(hd, cfg, report)
+		checkPermissions(hd, cfg, report)
+		checkClientReachability(cfg, report)
+	}
+
+	// Print the report
+	if format != hdcontext.OutputFormat_Text {
+		output.SetResult(c, report)
+		if report.Status == doctorStatus_Fail {
+			return fmt.Errorf("one or more checks failed")
+		}
+		return nil
+	}
+	printDoctorReport(out, report)
+	if report.Status == doctorStatus_Fail {
+		return cli.Exit("", output.ExitCode_Error)
+	}
+	return nil
+}
+
+// Print the report in a human-readable format, grouped by category
+func printDoctorReport(out io.Writer, report *doctorReport) {
+	category := ""
+	counts := map[doctorStatus]int{}
+	for _, check := range report.Checks {
+		if check.Category != category {
+			if category != "" {
+				fmt.Fprintln(out)
+			}
+			category = check.Category
+			fmt.Fprintf(out, "%s%s%s\n", terminal.ColorBold, category, terminal.ColorReset)
+		}
+		fmt.Fprintf(out, "  %s %s: %s\n", getDoctorStatusLabel(check.Status), check.Name, check.Message)
+		counts[check.Status]++
+	}
+
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "%d passed, %d warnings, %d failed.\n", counts[doctorStatus_Pass], counts[doctorStatus_Warn], counts[doctorStatus_Fail])
+	switch report.Status {
+	case doctorStatus_Fail:
+		fmt.Fprintf(out, "%sYour system has problems that will prevent Hyperdrive from running properly. Please fix the failed checks above.%s\n", terminal.ColorRed, terminal.ColorReset)
+	case doctorStatus_Warn:
+		fmt.Fprintf(out, "%sYour system can run Hyperdrive, but please review the warnings above.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	default:
+		fmt.Fprintf(out, "%sYour system is ready to run Hyperdrive.%s\n", terminal.ColorGreen, terminal.ColorReset)
+	}
+}
+
+// Get the colored label for a check status
+func getDoctorStatusLabel(status doctorStatus) string {
+	switch status {
+	case doctorStatus_Pass:
+		return fmt.Sprintf("%s[PASS]%s", terminal.ColorGreen, terminal.ColorReset)
+	case doctorStatus_Warn:
+		return fmt.Sprintf("%s[WARN]%s", terminal.ColorYellow, terminal.ColorReset)
+	default:
+		return fmt.Sprintf("%s[FAIL]%s", terminal.ColorRed, terminal.ColorReset)
+	}
+}
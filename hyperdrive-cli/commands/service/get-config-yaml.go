@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/urfave/cli/v2"
 	"gopkg.in/yaml.v2"
@@ -10,12 +11,13 @@ import (
 
 // Generate a YAML file that shows the current configuration schema, including all of the parameters and their descriptions
 func getConfigYaml(c *cli.Context) error {
+	out := utils.GetOutput(c)
 	cfg := config.NewHyperdriveConfig("")
 	bytes, err := yaml.Marshal(cfg)
 	if err != nil {
 		return fmt.Errorf("error serializing configuration schema: %w", err)
 	}
 
-	fmt.Println(string(bytes))
+	fmt.Fprintln(out, string(bytes))
 	return nil
 }
@@ -46,13 +46,21 @@ var (
 
 // Install the Hyperdrive service
 func installService(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Prompt for confirmation
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm(fmt.Sprintf(
-		"Hyperdrive will be installed --Version: %s\n\n%sIf you're upgrading, your existing configuration will be backed up and preserved.\nAll of your previous settings will be migrated automatically.%s\nAre you sure you want to continue?",
-		c.String(installVersionFlag.Name), terminal.ColorGreen, terminal.ColorReset,
-	))) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(fmt.Sprintf(
+			"Hyperdrive will be installed --Version: %s\n\n%sIf you're upgrading, your existing configuration will be backed up and preserved.\nAll of your previous settings will be migrated automatically.%s\nAre you sure you want to continue?",
+			c.String(installVersionFlag.Name), terminal.ColorGreen, terminal.ColorReset,
+		))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Get Hyperdrive client
@@ -71,8 +79,8 @@ func installService(c *cli.Context) error {
 	}
 
 	// Print success message & return
-	fmt.Println("")
-	fmt.Println("The Hyperdrive service was successfully installed!")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "The Hyperdrive service was successfully installed!")
 
 	printPatchNotes(c)
 
@@ -83,13 +91,13 @@ func installService(c *cli.Context) error {
 	}
 
 	// Report next steps
-	fmt.Printf("%s\n=== Next Steps ===\n", terminal.ColorBlue)
-	fmt.Printf("Run 'hyperdrive service config' to review the settings changes for this update, or to continue setting up your node.%s\n", terminal.ColorReset)
+	fmt.Fprintf(out, "%s\n=== Next Steps ===\n", terminal.ColorBlue)
+	fmt.Fprintf(out, "Run 'hyperdrive service config' to review the settings changes for this update, or to continue setting up your node.%s\n", terminal.ColorReset)
 
 	// Print the docker permissions notice
 	if isNew {
-		fmt.Printf("\n%sNOTE:\nSince this is your first time installing Hyperdrive, please start a new shell session by logging out and back in or restarting the machine.\n", terminal.ColorYellow)
-		fmt.Printf("This is necessary for your user account to have permissions to use Docker.%s", terminal.ColorReset)
+		fmt.Fprintf(out, "\n%sNOTE:\nSince this is your first time installing Hyperdrive, please start a new shell session by logging out and back in or restarting the machine.\n", terminal.ColorYellow)
+		fmt.Fprintf(out, "This is necessary for your user account to have permissions to use Docker.%s", terminal.ColorReset)
 	}
 
 	return nil
@@ -99,12 +107,13 @@ func installService(c *cli.Context) error {
 // Print the latest patch notes for this release
 // TODO: get this from an external source and don't hardcode it into the CLI
 func printPatchNotes(c *cli.Context) {
+	out := utils.GetOutput(c)
 
-	fmt.Println()
-	fmt.Println(shared.Logo)
-	fmt.Printf("%s=== Hyperdrive v%s ===%s\n\n", terminal.ColorGreen, shared.HyperdriveVersion, terminal.ColorReset)
-	fmt.Printf("Changes you should be aware of before starting:\n\n")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, shared.Logo)
+	fmt.Fprintf(out, "%s=== Hyperdrive v%s ===%s\n\n", terminal.ColorGreen, shared.HyperdriveVersion, terminal.ColorReset)
+	fmt.Fprintf(out, "Changes you should be aware of before starting:\n\n")
 
-	fmt.Printf("%s=== Alpha! ===%s\n", terminal.ColorGreen, terminal.ColorReset)
-	fmt.Println("So it begins.")
+	fmt.Fprintf(out, "%s=== Alpha! ===%s\n", terminal.ColorGreen, terminal.ColorReset)
+	fmt.Fprintln(out, "So it begins.")
 }
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nodeset-org/eth-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	dutyTimeFormat string = "15:04:05 MST"
+)
+
+var (
+	maintenanceWindowMinutesFlag *cli.Uint64Flag = &cli.Uint64Flag{
+		Name:    "minutes",
+		Aliases: []string{"m"},
+		Usage:   "The minimum length of the maintenance window to look for, in minutes. Proposals are only assigned up to two epochs ahead (12.8 minutes on Mainnet), so this can't be longer than that.",
+		Value:   5,
+	}
+	expectedDowntimeFlag *cli.DurationFlag = &cli.DurationFlag{
+		Name:    "expected-downtime",
+		Aliases: []string{"d"},
+		Usage:   "How long you expect your validators to be offline (e.g. 10m); you will be warned if any of their known duties fall inside this period. Proposals are only assigned up to two epochs ahead (12.8 minutes on Mainnet), so duties after that can't be checked.",
+		Value:   5 * time.Minute,
+	}
+)
+
+// A period of time where none of the node's validators have any known duties
+type maintenanceWindow struct {
+	// The time the window starts
+	Start time.Time
+
+	// The end of the window, or the end of the duty lookahead if no duties were found after the start
+	End time.Time
+
+	// True if the window ends at the end of the lookahead, so duties after it are not known yet
+	IsOpenEnded bool
+}
+
+// Print the upcoming duties for the node's validators and find the next maintenance window
+func findMaintenanceWindow(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get the client
+	hd := client.NewHyperdriveClientFromCtx(c)
+	cfg, isNew, err := hd.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading user settings: %w", err)
+	}
+	if isNew {
+		return fmt.Errorf("No configuration detected. Please run `hyperdrive service config` to set up Hyperdrive before running it.")
+	}
+	minDuration := time.Duration(c.Uint64(maintenanceWindowMinutesFlag.Name)) * time.Minute
+
+	// Get the duties
+	duties, err := getUpcomingDuties(c, hd, cfg)
+	if err != nil {
+		return err
+	}
+	if duties == nil {
+		fmt.Fprintln(out, "None of your modules have any validators, so you can perform maintenance at any time.")
+		return nil
+	}
+	lookahead := getDutyLookahead(duties)
+	if minDuration > lookahead {
+		return fmt.Errorf("Validator duties are only assigned %s ahead, so a maintenance window longer than that can't be confirmed. Please use a shorter --%s.", lookahead, maintenanceWindowMinutesFlag.Name)
+	}
+
+	// Print the duties
+	if len(duties.InactiveValidators) > 0 {
+		fmt.Fprintf(out, "%d validator(s) are not active on the Beacon Chain yet and have no duties.\n", len(duties.InactiveValidators))
+	}
+	if len(duties.Duties) == 0 {
+		fmt.Fprintf(out, "%sYour validators have no proposals or sync committee duties in epochs %d - %d.%s\n", terminal.ColorGreen, duties.CurrentEpoch, duties.CurrentEpoch+1, terminal.ColorReset)
+	} else {
+		fmt.Fprintln(out, "Upcoming duties:")
+		hasSyncDuties := false
+		for _, duty := range duties.Duties {
+			fmt.Fprintf(out, "\tEpoch %d (%s - %s): validator %s (%s) %s\n", duty.Epoch, duty.StartTime.Local().Format(dutyTimeFormat), duty.EndTime.Local().Format(dutyTimeFormat), duty.Index, duty.Pubkey.HexWithPrefix(), getDutyDescription(duty))
+			if duty.Type == api.ValidatorDutyType_SyncCommittee {
+				hasSyncDuties = true
+			}
+		}
+		if hasSyncDuties {
+			fmt.Fprintf(out, "%sNOTE: sync committee membership lasts for roughly 27 hours, so there may not be a window without duties until it ends.%s\n", terminal.ColorYellow, terminal.ColorReset)
+		}
+	}
+	fmt.Fprintln(out)
+
+	// Find the window
+	window := getMaintenanceWindow(duties, minDuration, time.Now())
+	if !window.IsOpenEnded {
+		fmt.Fprintf(out, "%sThe next maintenance window of at least %s is from %s to %s.%s\n", terminal.ColorGreen, minDuration, window.Start.Local().Format(dutyTimeFormat), window.End.Local().Format(dutyTimeFormat), terminal.ColorReset)
+		return nil
+	}
+	if window.End.Sub(window.Start) >= minDuration {
+		fmt.Fprintf(out, "%sYour validators have no known duties from %s onward, so the next maintenance window of at least %s starts then.%s\n", terminal.ColorGreen, window.Start.Local().Format(dutyTimeFormat), minDuration, terminal.ColorReset)
+	} else {
+		fmt.Fprintf(out, "%sYour validators have no known duties from %s onward, but duties after %s have not been assigned yet.%s\n", terminal.ColorYellow, window.Start.Local().Format(dutyTimeFormat), window.End.Local().Format(dutyTimeFormat), terminal.ColorReset)
+		fmt.Fprintf(out, "Run this command again closer to that time to confirm a window of at least %s.\n", minDuration)
+	}
+	return nil
+}
+
+// Warn the user if any of their validators have duties during the expected downtime of a service stop or restart.
+// Returns true if the operation should proceed.
+func confirmNoDutiesDuringDowntime(c *cli.Context, hd *client.HyperdriveClient, cfg *client.GlobalConfig) (bool, error) {
+	hasDuties, err := warnAboutDutiesDuringDowntime(c, hd, cfg)
+	if err != nil {
+		return false, err
+	}
+	if !hasDuties {
+		return true, nil
+	}
+	if c.Bool(utils.YesFlag.Name) {
+		return true, nil
+	}
+	return utils.Confirm("Are you sure you want to continue?")
+}
+
+// Print a warning if any of the user's validators have duties during the expected downtime of a service stop or restart.
+// Returns true if there are any.
+func warnAboutDutiesDuringDowntime(c *cli.Context, hd *client.HyperdriveClient, cfg *client.GlobalConfig) (bool, error) {
+	out := utils.GetOutput(c)
+	duties, err := getUpcomingDuties(c, hd, cfg)
+	if err != nil {
+		return false, err
+	}
+	if duties == nil {
+		return false, nil
+	}
+
+	// Find the duties that overlap the downtime
+	now := time.Now()
+	downtimeEnd := now.Add(c.Duration(expectedDowntimeFlag.Name))
+	affectedDuties := []api.ValidatorDuty{}
+	for _, duty := range duties.Duties {
+		if duty.EndTime.After(now) && duty.StartTime.Before(downtimeEnd) {
+			affectedDuties = append(affectedDuties, duty)
+		}
+	}
+
+	// Duties past the end of the lookahead haven't been assigned yet
+	if len(duties.Epochs) > 0 {
+		lookaheadEnd := duties.Epochs[len(duties.Epochs)-1].EndTime
+		if downtimeEnd.After(lookaheadEnd) {
+			fmt.Fprintf(out, "%sNOTE: validator duties after %s haven't been assigned yet, so the rest of the expected downtime (until %s) can't be checked.%s\n", terminal.ColorYellow, lookaheadEnd.Local().Format(dutyTimeFormat), downtimeEnd.Local().Format(dutyTimeFormat), terminal.ColorReset)
+		}
+	}
+	if len(affectedDuties) == 0 {
+		return false, nil
+	}
+
+	// Print the warning
+	fmt.Fprintf(out, "%sWARNING: your validators have duties scheduled during the expected downtime (until %s):\n", terminal.ColorYellow, downtimeEnd.Local().Format(dutyTimeFormat))
+	for _, duty := range affectedDuties {
+		fmt.Fprintf(out, "\tEpoch %d (%s - %s): validator %s %s\n", duty.Epoch, duty.StartTime.Local().Format(dutyTimeFormat), duty.EndTime.Local().Format(dutyTimeFormat), duty.Index, getDutyDescription(duty))
+	}
+	fmt.Fprintf(out, "These duties will likely be missed. Use `hyperdrive service maintenance-window` to find a better time.%s\n\n", terminal.ColorReset)
+	return true, nil
+}
+
+// Get the upcoming duties for all of the validators belonging to the enabled modules.
+// Returns nil if there aren't any validators.
+func getUpcomingDuties(c *cli.Context, hd *client.HyperdriveClient, cfg *client.GlobalConfig) (*api.ServiceGetUpcomingDutiesData, error) {
+	pubkeys, err := getModuleValidators(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(pubkeys) == 0 {
+		return nil, nil
+	}
+
+	response, err := hd.Api.Service.GetUpcomingDuties(pubkeys)
+	if err != nil {
+		return nil, fmt.Errorf("error getting upcoming validator duties: %w", err)
+	}
+	return response.Data, nil
+}
+
+// Get the pubkeys of the validators managed by each enabled module
+func getModuleValidators(c *cli.Context, cfg *client.GlobalConfig) ([]beacon.ValidatorPubkey, error) {
+	pubkeys := []beacon.ValidatorPubkey{}
+	if cfg.Stakewise.Enabled.Value {
+		sw := client.NewStakewiseClientFromCtx(c)
+		response, err := sw.Api.Status.GetActiveValidators()
+		if err != nil {
+			return nil, fmt.Errorf("error getting Stakewise validators: %w", err)
+		}
+		pubkeys = append(pubkeys, response.Data.ActiveValidators...)
+	}
+	return pubkeys, nil
+}
+
+// Find the first period of at least the provided duration, starting from now, where no validators have known duties
+func getMaintenanceWindow(duties *api.ServiceGetUpcomingDutiesData, minDuration time.Duration, now time.Time) maintenanceWindow {
+	start := now
+	for _, epoch := range duties.Epochs {
+		if !epoch.HasDuties || !epoch.EndTime.After(start) {
+			continue
+		}
+		if epoch.StartTime.Sub(start) >= minDuration {
+			return maintenanceWindow{
+				Start: start,
+				End:   epoch.StartTime,
+			}
+		}
+		start = epoch.EndTime
+	}
+
+	// Nothing is known after the end of the lookahead
+	end := start
+	if len(duties.Epochs) > 0 {
+		lookaheadEnd := duties.Epochs[len(duties.Epochs)-1].EndTime
+		if lookaheadEnd.After(end) {
+			end = lookaheadEnd
+		}
+	}
+	return maintenanceWindow{
+		Start:       start,
+		End:         end,
+		IsOpenEnded: true,
+	}
+}
+
+// Get how far ahead validator duties are known, which is the total length of the epochs that were checked
+func getDutyLookahead(duties *api.ServiceGetUpcomingDutiesData) time.Duration {
+	if len(duties.Epochs) == 0 {
+		return 0
+	}
+	return duties.Epochs[len(duties.Epochs)-1].EndTime.Sub(duties.Epochs[0].StartTime)
+}
+
+// Get a description of a validator duty
+func getDutyDescription(duty api.ValidatorDuty) string {
+	switch duty.Type {
+	case api.ValidatorDutyType_Proposal:
+		return fmt.Sprintf("has %d block proposal(s)", duty.Count)
+	case api.ValidatorDutyType_SyncCommittee:
+		return "is in the sync committee"
+	default:
+		return string(duty.Type)
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+const testSecondsPerEpoch uint64 = 384
+
+// Create the duties for a lookahead of two epochs starting at the provided time, where the provided epochs have duties
+func getTestDuties(start time.Time, dutyEpochs ...int) *api.ServiceGetUpcomingDutiesData {
+	epochLength := time.Duration(testSecondsPerEpoch) * time.Second
+	duties := &api.ServiceGetUpcomingDutiesData{
+		CurrentEpoch:    100,
+		SecondsPerEpoch: testSecondsPerEpoch,
+	}
+	for i := 0; i < 2; i++ {
+		epochStart := start.Add(time.Duration(i) * epochLength)
+		epoch := api.DutyEpoch{
+			Epoch:     100 + uint64(i),
+			StartTime: epochStart,
+			EndTime:   epochStart.Add(epochLength),
+		}
+		for _, dutyEpoch := range dutyEpochs {
+			if dutyEpoch == i {
+				epoch.HasDuties = true
+			}
+		}
+		duties.Epochs = append(duties.Epochs, epoch)
+	}
+	return duties
+}
+
+func TestGetMaintenanceWindow(t *testing.T) {
+	epochStart := time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)
+	epochLength := time.Duration(testSecondsPerEpoch) * time.Second
+	lookaheadEnd := epochStart.Add(2 * epochLength)
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		dutyEpochs  []int
+		minDuration time.Duration
+		expected    maintenanceWindow
+	}{
+		{
+			name:        "no duties",
+			now:         epochStart.Add(time.Minute),
+			minDuration: 5 * time.Minute,
+			expected:    maintenanceWindow{Start: epochStart.Add(time.Minute), End: lookaheadEnd, IsOpenEnded: true},
+		},
+		{
+			name:        "duty in the next epoch with enough time before it",
+			now:         epochStart.Add(time.Minute),
+			dutyEpochs:  []int{1},
+			minDuration: 5 * time.Minute,
+			expected:    maintenanceWindow{Start: epochStart.Add(time.Minute), End: epochStart.Add(epochLength)},
+		},
+		{
+			name:        "duty in the next epoch without enough time before it",
+			now:         epochStart.Add(4 * time.Minute),
+			dutyEpochs:  []int{1},
+			minDuration: 5 * time.Minute,
+			expected:    maintenanceWindow{Start: lookaheadEnd, End: lookaheadEnd, IsOpenEnded: true},
+		},
+		{
+			name:        "duty in the current epoch",
+			now:         epochStart.Add(time.Minute),
+			dutyEpochs:  []int{0},
+			minDuration: 5 * time.Minute,
+			expected:    maintenanceWindow{Start: epochStart.Add(epochLength), End: lookaheadEnd, IsOpenEnded: true},
+		},
+		{
+			name:        "duties in both epochs",
+			now:         epochStart.Add(time.Minute),
+			dutyEpochs:  []int{0, 1},
+			minDuration: 5 * time.Minute,
+			expected:    maintenanceWindow{Start: lookaheadEnd, End: lookaheadEnd, IsOpenEnded: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			duties := getTestDuties(epochStart, test.dutyEpochs...)
+			window := getMaintenanceWindow(duties, test.minDuration, test.now)
+			if !window.Start.Equal(test.expected.Start) || !window.End.Equal(test.expected.End) || window.IsOpenEnded != test.expected.IsOpenEnded {
+				t.Fatalf("expected window %+v but got %+v", test.expected, window)
+			}
+		})
+	}
+}
+
+func TestGetDutyLookahead(t *testing.T) {
+	duties := getTestDuties(time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC))
+	lookahead := getDutyLookahead(duties)
+	if lookahead != 2*time.Duration(testSecondsPerEpoch)*time.Second {
+		t.Fatalf("expected a lookahead of 2 epochs but got %s", lookahead)
+	}
+
+	// The default flag values have to fit inside it, or a window could never be confirmed
+	if time.Duration(maintenanceWindowMinutesFlag.Value)*time.Minute > lookahead {
+		t.Fatalf("default --%s of %d minutes is longer than the lookahead of %s", maintenanceWindowMinutesFlag.Name, maintenanceWindowMinutesFlag.Value, lookahead)
+	}
+	if expectedDowntimeFlag.Value > lookahead {
+		t.Fatalf("default --%s of %s is longer than the lookahead of %s", expectedDowntimeFlag.Name, expectedDowntimeFlag.Value, lookahead)
+	}
+}
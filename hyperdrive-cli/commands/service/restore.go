@@ -0,0 +1,138 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/urfave/cli/v2"
+)
+
+// Restore the node from an encrypted backup
+func restoreNode(c *cli.Context, backupPath string) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Read the backup
+	backupBytes, err := os.ReadFile(backupPath)
+	if err != nil {
+		return fmt.Errorf("error reading backup file [%s]: %w", backupPath, err)
+	}
+	password := c.String(backupPasswordFlag.Name)
+	if password == "" {
+		password, err = utils.PromptPassword(
+			"Please enter the password the backup was encrypted with:",
+			fmt.Sprintf("^.{%d,}$", input.MinPasswordLength),
+			fmt.Sprintf("Your password must be at least %d characters long. Please try again:", input.MinPasswordLength),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	backup, err := hd.ReadBackup(backupBytes, password)
+	if err != nil {
+		return err
+	}
+
+	// Print the backup details
+	manifest := backup.Manifest
+	fmt.Fprintf(out, "Backup created on %s with Hyperdrive v%s.\n", manifest.CreatedAt.Local().Format(dutyTimeFormat), manifest.HyperdriveVersion)
+	fmt.Fprintf(out, "Network: %s\n", manifest.Network)
+	if manifest.NodeAddress != nil {
+		fmt.Fprintf(out, "Node address: %s%s%s\n", terminal.ColorBlue, manifest.NodeAddress.Hex(), terminal.ColorReset)
+	} else {
+		fmt.Fprintln(out, "Node address: none")
+	}
+	if len(manifest.Modules) > 0 {
+		fmt.Fprintf(out, "Modules: %s\n", strings.Join(manifest.Modules, ", "))
+	}
+	fmt.Fprintln(out)
+
+	// Check the current node for consistency with the backup
+	cfg, isNew, err := hd.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading configuration: %w", err)
+	}
+	if !isNew {
+		// The service must be stopped so the VCs don't sign anything while their keys and slashing protection are replaced
+		runningVcs, err := getRunningValidatorClients(hd, cfg)
+		if err != nil {
+			return fmt.Errorf("error checking if your Validator Clients are running: %w", err)
+		}
+		if len(runningVcs) > 0 {
+			fmt.Fprintf(out, "%sYour Validator Clients are still running (%s). Please stop Hyperdrive with `hyperdrive service stop` before restoring a backup.%s\n", terminal.ColorRed, strings.Join(runningVcs, ", "), terminal.ColorReset)
+			return nil
+		}
+
+		if cfg.Hyperdrive.Network.Value != manifest.Network {
+			fmt.Fprintf(out, "%sWARNING: this node is currently configured for the %s network, but the backup is for the %s network.%s\n", terminal.ColorYellow, cfg.Hyperdrive.Network.Value, manifest.Network, terminal.ColorReset)
+			if !c.Bool(utils.YesFlag.Name) {
+				confirmed, err := utils.Confirm("Are you sure you want to switch networks by restoring this backup?")
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					fmt.Fprintln(out, "Cancelled.")
+					return nil
+				}
+			}
+		}
+
+		currentAddress, err := hd.GetStoredNodeAddress(cfg)
+		if err != nil {
+			return err
+		}
+		if currentAddress != nil && (manifest.NodeAddress == nil || *currentAddress != *manifest.NodeAddress) {
+			fmt.Fprintf(out, "%sWARNING: this node currently has the address %s, which will be replaced by the backup's node wallet.\nMake sure you have a copy of the current wallet's recovery mnemonic before continuing, or its keys will be lost.%s\n", terminal.ColorRed, currentAddress.Hex(), terminal.ColorReset)
+			if !c.Bool(utils.YesFlag.Name) {
+				confirmed, err := utils.Confirm("Are you sure you want to replace the current node wallet?")
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					fmt.Fprintln(out, "Cancelled.")
+					return nil
+				}
+			}
+		}
+	}
+
+	// Make sure the validators aren't running somewhere else
+	fmt.Fprintf(out, "%sIf the validator keys in this backup are still active on another machine, running them here as well will get them slashed!\nMake sure the other machine has been shut down and its Validator Client has been offline for at least 15 minutes.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm("Are you sure you want to restore this backup?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
+	}
+
+	// Restore it
+	err = hd.RestoreBackup(backup)
+	if err != nil {
+		return fmt.Errorf("error restoring backup: %w", err)
+	}
+	fmt.Fprintf(out, "%sBackup restored successfully.%s\n\n", terminal.ColorGreen, terminal.ColorReset)
+
+	// Start the service
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm("Would you like to start Hyperdrive now?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Please start Hyperdrive with `hyperdrive service start` when you're ready.")
+			return nil
+		}
+	}
+	return startService(c, true, false)
+}
@@ -12,6 +12,8 @@ import (
 
 // Destroy and resync the Beacon Node from scratch
 func resyncBeaconNode(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -23,36 +25,45 @@ func resyncBeaconNode(c *cli.Context) error {
 	if isNew {
 		return fmt.Errorf("Settings file not found. Please run `hyperdrive service config` to set up Hyperdrive.")
 	}
+	if cfg.Hyperdrive.IsNativeMode() {
+		return fmt.Errorf("Resyncing isn't automated in Native Mode. To resync your Beacon Node, stop it with `systemctl stop %s`, delete the contents of %s, and run `hyperdrive service start`.", cfg.Hyperdrive.GetNativeUnitName(string(config.ContainerID_BeaconNode)), cfg.Hyperdrive.GetNativeVolumePath(cfg.Hyperdrive.BeaconNodeDataVolume()))
+	}
 
-	fmt.Println("This will delete the chain data of your Beacon Node and resync it from scratch.")
-	fmt.Printf("%sYou should only do this if your Beacon Node has failed and can no longer start or sync properly.\nThis is meant to be a last resort.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
+	fmt.Fprintln(out, "This will delete the chain data of your Beacon Node and resync it from scratch.")
+	fmt.Fprintf(out, "%sYou should only do this if your Beacon Node has failed and can no longer start or sync properly.\nThis is meant to be a last resort.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
 
 	// Check the client mode
 	if cfg.Hyperdrive.ClientMode.Value == config.ClientMode_External {
-		fmt.Println("You use an externally-managed Beacon Node. Hyperdrive cannot resync it for you.")
+		fmt.Fprintln(out, "You use an externally-managed Beacon Node. Hyperdrive cannot resync it for you.")
 		return nil
 	}
 
 	// Get the current checkpoint sync URL
 	checkpointSyncUrl := cfg.Hyperdrive.LocalBeaconConfig.CheckpointSyncProvider.Value
 	if checkpointSyncUrl == "" {
-		fmt.Printf("%sYou do not have a checkpoint sync provider configured.\nIf you have active validators, they %swill be considered offline and will lose ETH%s%s until your Beacon Node finishes syncing.\nWe strongly recommend you configure a checkpoint sync provider with `hyperdrive service config` so it syncs instantly before running this.%s\n\n", terminal.ColorRed, terminal.ColorBold, terminal.ColorReset, terminal.ColorRed, terminal.ColorReset)
+		fmt.Fprintf(out, "%sYou do not have a checkpoint sync provider configured.\nIf you have active validators, they %swill be considered offline and will lose ETH%s%s until your Beacon Node finishes syncing.\nWe strongly recommend you configure a checkpoint sync provider with `hyperdrive service config` so it syncs instantly before running this.%s\n\n", terminal.ColorRed, terminal.ColorBold, terminal.ColorReset, terminal.ColorRed, terminal.ColorReset)
 	} else {
-		fmt.Printf("You have a checkpoint sync provider configured (%s).\nYour Beacon Node will use it to sync to the head of the Beacon Chain instantly after being rebuilt.\n\n", checkpointSyncUrl)
+		fmt.Fprintf(out, "You have a checkpoint sync provider configured (%s).\nYour Beacon Node will use it to sync to the head of the Beacon Chain instantly after being rebuilt.\n\n", checkpointSyncUrl)
 	}
 
 	// Prompt for confirmation
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm(fmt.Sprintf("%sAre you SURE you want to delete and resync your main Beacon Node from scratch? This cannot be undone!%s", terminal.ColorRed, terminal.ColorReset))) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(fmt.Sprintf("%sAre you SURE you want to delete and resync your main Beacon Node from scratch? This cannot be undone!%s", terminal.ColorRed, terminal.ColorReset))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Stop the BN
 	beaconContainerName := cfg.Hyperdrive.GetDockerArtifactName(string(config.ContainerID_BeaconNode))
-	fmt.Printf("Stopping %s...\n", beaconContainerName)
+	fmt.Fprintf(out, "Stopping %s...\n", beaconContainerName)
 	err = hd.StopContainer(beaconContainerName)
 	if err != nil {
-		fmt.Printf("%sWARNING: Stopping Beacon Node container failed: %s%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+		fmt.Fprintf(out, "%sWARNING: Stopping Beacon Node container failed: %s%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
 	}
 
 	// Get the BN volume name
@@ -62,26 +73,26 @@ func resyncBeaconNode(c *cli.Context) error {
 	}
 
 	// Remove the BN
-	fmt.Printf("Deleting %s...\n", beaconContainerName)
+	fmt.Fprintf(out, "Deleting %s...\n", beaconContainerName)
 	err = hd.RemoveContainer(beaconContainerName)
 	if err != nil {
 		return fmt.Errorf("Error deleting Beacon Node container: %w", err)
 	}
 
 	// Delete the BN volume
-	fmt.Printf("Deleting volume %s...\n", volume)
+	fmt.Fprintf(out, "Deleting volume %s...\n", volume)
 	err = hd.DeleteVolume(volume)
 	if err != nil {
 		return fmt.Errorf("Error deleting volume: %w", err)
 	}
 
 	// Restart Hyperdrive
-	fmt.Printf("Rebuilding %s and restarting Hyperdrive...\n", beaconContainerName)
-	err = startService(c, true)
+	fmt.Fprintf(out, "Rebuilding %s and restarting Hyperdrive...\n", beaconContainerName)
+	err = startService(c, true, true)
 	if err != nil {
 		return fmt.Errorf("Error starting Hyperdrive: %s", err)
 	}
 
-	fmt.Printf("\nDone! Your Beacon Node is now resyncing. You can follow its progress with `hyperdrive service logs bn`.\n")
+	fmt.Fprintf(out, "\nDone! Your Beacon Node is now resyncing. You can follow its progress with `hyperdrive service logs bn`.\n")
 	return nil
 }
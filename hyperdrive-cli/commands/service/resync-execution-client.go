@@ -12,6 +12,8 @@ import (
 
 // Destroy and resync the Execution client from scratch
 func resyncExecutionClient(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -23,22 +25,31 @@ func resyncExecutionClient(c *cli.Context) error {
 	if isNew {
 		return fmt.Errorf("Settings file not found. Please run `hyperdrive service config` to set up Hyperdrive.")
 	}
+	if cfg.Hyperdrive.IsNativeMode() {
+		return fmt.Errorf("Resyncing isn't automated in Native Mode. To resync your Execution client, stop it with `systemctl stop %s`, delete the contents of %s, and run `hyperdrive service start`.", cfg.Hyperdrive.GetNativeUnitName(string(config.ContainerID_ExecutionClient)), cfg.Hyperdrive.GetNativeVolumePath(cfg.Hyperdrive.ExecutionClientDataVolume()))
+	}
 
-	fmt.Println("This will delete the chain data of your primary Execution client and resync it from scratch.")
-	fmt.Printf("%sYou should only do this if your Execution client has failed and can no longer start or sync properly.\nThis is meant to be a last resort.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	fmt.Fprintln(out, "This will delete the chain data of your primary Execution client and resync it from scratch.")
+	fmt.Fprintf(out, "%sYou should only do this if your Execution client has failed and can no longer start or sync properly.\nThis is meant to be a last resort.%s\n", terminal.ColorYellow, terminal.ColorReset)
 
 	// Prompt for confirmation
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm(fmt.Sprintf("%sAre you SURE you want to delete and resync your main Execution client from scratch? This cannot be undone!%s", terminal.ColorRed, terminal.ColorReset))) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(fmt.Sprintf("%sAre you SURE you want to delete and resync your main Execution client from scratch? This cannot be undone!%s", terminal.ColorRed, terminal.ColorReset))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Stop Execution
 	executionContainerName := cfg.Hyperdrive.GetDockerArtifactName(string(config.ContainerID_ExecutionClient))
-	fmt.Printf("Stopping %s...\n", executionContainerName)
+	fmt.Fprintf(out, "Stopping %s...\n", executionContainerName)
 	err = hd.StopContainer(executionContainerName)
 	if err != nil {
-		fmt.Printf("%sWARNING: Stopping main Execution container failed: %s%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+		fmt.Fprintf(out, "%sWARNING: Stopping main Execution container failed: %s%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
 	}
 
 	// Get Execution volume name
@@ -48,26 +59,26 @@ func resyncExecutionClient(c *cli.Context) error {
 	}
 
 	// Remove the EC
-	fmt.Printf("Deleting %s...\n", executionContainerName)
+	fmt.Fprintf(out, "Deleting %s...\n", executionContainerName)
 	err = hd.RemoveContainer(executionContainerName)
 	if err != nil {
 		return fmt.Errorf("Error deleting main Execution client container: %w", err)
 	}
 
 	// Delete the EC volume
-	fmt.Printf("Deleting volume %s...\n", volume)
+	fmt.Fprintf(out, "Deleting volume %s...\n", volume)
 	err = hd.DeleteVolume(volume)
 	if err != nil {
 		return fmt.Errorf("Error deleting volume: %w", err)
 	}
 
 	// Restart Hyperdrive
-	fmt.Printf("Rebuilding %s and restarting Hyperdrive...\n", executionContainerName)
-	err = startService(c, true)
+	fmt.Fprintf(out, "Rebuilding %s and restarting Hyperdrive...\n", executionContainerName)
+	err = startService(c, true, false)
 	if err != nil {
 		return fmt.Errorf("Error starting Hyperdrive: %s", err)
 	}
 
-	fmt.Printf("\nDone! Your main Execution client is now resyncing. You can follow its progress with `hyperdrive service logs ec`.\n")
+	fmt.Fprintf(out, "\nDone! Your main Execution client is now resyncing. You can follow its progress with `hyperdrive service logs ec`.\n")
 	return nil
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"io"
 	"regexp"
 	"strings"
 	"time"
@@ -17,7 +18,9 @@ import (
 )
 
 // Start the Hyperdrive service
-func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
+func startService(c *cli.Context, ignoreConfigSuggestion bool, skipCheckpointCheck bool) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -36,18 +39,24 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 	currentVersion := strings.TrimPrefix(shared.HyperdriveVersion, "v")
 	isUpdate := oldVersion != currentVersion
 	if isUpdate && !ignoreConfigSuggestion {
-		if c.Bool(utils.YesFlag.Name) || utils.Confirm("Hyperdrive upgrade detected - starting will overwrite certain settings with the latest defaults (such as container versions).\nYou may want to run `hyperdrive service config` first to see what's changed.\n\nWould you like to continue starting the service?") {
-			cfg.UpdateDefaults()
-			hd.SaveConfig(cfg)
-			fmt.Printf("%sUpdated settings successfully.%s\n", terminal.ColorGreen, terminal.ColorReset)
-		} else {
-			fmt.Println("Cancelled.")
+		confirmed := c.Bool(utils.YesFlag.Name)
+		if !confirmed {
+			confirmed, err = utils.Confirm("Hyperdrive upgrade detected - starting will overwrite certain settings with the latest defaults (such as container versions).\nYou may want to run `hyperdrive service config` first to see what's changed.\n\nWould you like to continue starting the service?")
+			if err != nil {
+				return err
+			}
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
 			return nil
 		}
+		cfg.UpdateDefaults()
+		hd.SaveConfig(cfg)
+		fmt.Fprintf(out, "%sUpdated settings successfully.%s\n", terminal.ColorGreen, terminal.ColorReset)
 	}
 
-	// Update the Prometheus and Grafana config templates with the assigned ports
-	metricsEnabled := cfg.Hyperdrive.Metrics.EnableMetrics.Value
+	// Update the Prometheus and Grafana config templates with the assigned ports; they aren't deployed in Native Mode
+	metricsEnabled := cfg.Hyperdrive.Metrics.EnableMetrics.Value && !cfg.Hyperdrive.IsNativeMode()
 	if metricsEnabled {
 		err := hd.UpdatePrometheusConfiguration(cfg)
 		if err != nil {
@@ -62,67 +71,70 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 	// Validate the config
 	errors := cfg.Validate()
 	if len(errors) > 0 {
-		fmt.Printf("%sYour configuration encountered errors. You must correct the following in order to start Hyperdrive:\n\n", terminal.ColorRed)
+		fmt.Fprintf(out, "%sYour configuration encountered errors. You must correct the following in order to start Hyperdrive:\n\n", terminal.ColorRed)
 		for _, err := range errors {
-			fmt.Printf("%s\n\n", err)
+			fmt.Fprintf(out, "%s\n\n", err)
 		}
-		fmt.Println(terminal.ColorReset)
+		fmt.Fprintln(out, terminal.ColorReset)
 		return nil
 	}
 
 	// TODO: SLASHING DELAY
-	if !c.Bool(ignoreSlashTimerFlag.Name) {
-		// Do the client swap check
-		firstRun, err := checkForValidatorChange(hd, cfg)
-		if err != nil {
-			fmt.Printf("%sWARNING: couldn't verify that the Validator Client containers can be safely restarted:\n\t%s\n", terminal.ColorYellow, err.Error())
-			fmt.Println("If you are changing to a different client, it may resubmit an attestation you have already submitted.")
-			fmt.Println("This will slash your validator!")
-			fmt.Println("To prevent slashing, you must wait 15 minutes from the time you stopped the clients before starting them again.")
-			fmt.Println()
-			fmt.Println("**If you did NOT change clients, you can safely ignore this warning.**")
-			fmt.Println()
-			if !utils.Confirm(fmt.Sprintf("Press y when you understand the above warning, have waited, and are ready to start Hyperdrive:%s", terminal.ColorReset)) {
-				fmt.Println("Cancelled.")
-				return nil
-			}
-		} else if firstRun {
-			fmt.Println("It looks like this is your first time starting a Validator Client.")
-			existingNode := utils.Confirm("Just to be sure, does your node have any existing, active validators attesting on the Beacon Chain?")
-			if !existingNode {
-				fmt.Println("Okay, great! You're safe to start. Have fun!")
-			} else {
-				fmt.Printf("%sSince didn't have any Validator Clients before, Hyperdrive can't determine if you attested in the last 15 minutes.\n", terminal.ColorYellow)
-				fmt.Println("If you did, it may resubmit an attestation you have already submitted.")
-				fmt.Println("This will slash your validator!")
-				fmt.Println("To prevent slashing, you must wait 15 minutes from the time you stopped the clients before starting them again.")
-				fmt.Println()
-				if !utils.Confirm(fmt.Sprintf("Press y when you understand the above warning, have waited, and are ready to start Hyperdrive:%s", terminal.ColorReset)) {
-					fmt.Println("Cancelled.")
-					return nil
-				}
-			}
-		}
-	} else {
-		fmt.Printf("%sIgnoring anti-slashing safety delay.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	confirmed, err := confirmValidatorChange(c, hd, cfg)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Fprintln(out, "Cancelled.")
+		return nil
 	}
 
 	// Write a note on doppelganger protection
 	for _, module := range cfg.GetAllModuleConfigs() {
 		if module.IsDoppelgangerEnabled() {
-			fmt.Printf("%sNOTE: You currently have Doppelganger Protection enabled on at least one module.\nYour Validator Client will miss up to 3 attestations when it starts.\nThis is *intentional* and does not indicate a problem with your node.%s\n\n", terminal.ColorBold, terminal.ColorReset)
+			fmt.Fprintf(out, "%sNOTE: You currently have Doppelganger Protection enabled on at least one module.\nYour Validator Client will miss up to 3 attestations when it starts.\nThis is *intentional* and does not indicate a problem with your node.%s\n\n", terminal.ColorBold, terminal.ColorReset)
 		}
 	}
 
+	// Hold back the VCs if the BN's finalized checkpoint didn't match the verification sources; this is skipped if the service isn't running yet
+	holdBackVcs := false
+	if !skipCheckpointCheck {
+		statusResponse, err := hd.Api.Service.ClientStatus()
+		if err == nil {
+			cpStatus := statusResponse.Data.CheckpointVerification
+			if cpStatus.IsMismatched && !cpStatus.IsOverridden {
+				fmt.Fprintf(out, "%sYour Beacon Node's finalized checkpoint (epoch %d, root %s) does not match at least one of your checkpoint verification sources.\n", terminal.ColorRed, cpStatus.LocalEpoch, cpStatus.LocalRoot.Hex())
+				fmt.Fprintln(out, "It may have synced from a faulty or malicious provider, so Hyperdrive will start everything except your Validator Clients.")
+				fmt.Fprintln(out, "Run `hyperdrive service sync` for details, and resync your Beacon Node from a trusted source with `hyperdrive service resync-bn`.")
+				fmt.Fprintf(out, "If you are certain your Beacon Node is on the correct chain, enable the \"%s\" setting in `hyperdrive service config` to override this check.%s\n\n", cfg.Hyperdrive.LocalBeaconConfig.IgnoreCheckpointMismatch.Name, terminal.ColorReset)
+				holdBackVcs = true
+			}
+		}
+	}
+
+	// Warn about validator duties during the restart; this is skipped if the service isn't running yet
+	proceed, err := confirmNoDutiesDuringDowntime(c, hd, cfg)
+	if err == nil && !proceed {
+		fmt.Fprintln(out, "Cancelled.")
+		return nil
+	}
+
 	// Start service
-	err = hd.StartService(getComposeFiles(c))
+	if holdBackVcs {
+		err = startServiceWithoutValidators(hd, cfg, getComposeFiles(c))
+	} else {
+		err = hd.StartService(getComposeFiles(c))
+	}
 	if err != nil {
 		return fmt.Errorf("error starting service: %w", err)
 	}
+	if holdBackVcs {
+		return fmt.Errorf("the service was started without your Validator Clients because your Beacon Node's finalized checkpoint doesn't match your checkpoint verification sources")
+	}
 
 	// Check wallet status
-	fmt.Println()
-	fmt.Println("Checking node wallet status...")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Checking node wallet status...")
 	var status *types.WalletStatus
 	retries := 5
 	for i := 0; i < retries; i++ {
@@ -137,13 +149,13 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 
 	// Handle errors
 	if status == nil {
-		fmt.Println("Hyperdrive couldn't check your node wallet status yet. Check on it again later with `hyperdrive wallet status`. If you haven't madea wallet yet, you can do so now with `hyperdrive wallet init`.")
+		fmt.Fprintln(out, "Hyperdrive couldn't check your node wallet status yet. Check on it again later with `hyperdrive wallet status`. If you haven't madea wallet yet, you can do so now with `hyperdrive wallet init`.")
 		return nil
 	}
 
 	// All set
 	if status.Wallet.IsLoaded {
-		fmt.Printf("Your node wallet with address %s%s%s is loaded and ready to use.\n", terminal.ColorBlue, status.Wallet.WalletAddress.Hex(), terminal.ColorReset)
+		fmt.Fprintf(out, "Your node wallet with address %s%s%s is loaded and ready to use.\n", terminal.ColorBlue, status.Wallet.WalletAddress.Hex(), terminal.ColorReset)
 		return nil
 	}
 
@@ -153,9 +165,17 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 	}
 
 	// Init
-	fmt.Println("You don't have a node wallet yet.")
-	if c.Bool(utils.YesFlag.Name) || !utils.Confirm("Would you like to create one now?") {
-		fmt.Println("Please create one using `hyperdrive wallet init` when you're ready.")
+	fmt.Fprintln(out, "You don't have a node wallet yet.")
+	if c.Bool(utils.YesFlag.Name) {
+		fmt.Fprintln(out, "Please create one using `hyperdrive wallet init` when you're ready.")
+		return nil
+	}
+	confirmed, err = utils.Confirm("Would you like to create one now?")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Fprintln(out, "Please create one using `hyperdrive wallet init` when you're ready.")
 		return nil
 	}
 	err = wallet.InitWallet(c, hd)
@@ -167,13 +187,37 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 	return nil
 }
 
+// Start every service except the Validator Clients, stopping any of them that are already running
+func startServiceWithoutValidators(hd *client.HyperdriveClient, cfg *client.GlobalConfig, composeFiles []string) error {
+	out := hd.Context.Output
+	_, vcs := getModuleServices(cfg)
+	for _, vc := range vcs {
+		vcName := cfg.Hyperdrive.GetDockerArtifactName(vc)
+		status, err := hd.GetDockerStatus(vcName)
+		if err != nil || status != "running" {
+			continue
+		}
+		fmt.Fprintf(out, "Stopping %s...\n", vcName)
+		err = hd.StopContainer(vcName)
+		if err != nil {
+			return fmt.Errorf("error stopping Validator Client [%s]: %w", vcName, err)
+		}
+	}
+	return hd.StartServices(composeFiles, getNonValidatorServices(cfg))
+}
+
 // Prompt for the wallet password upon startup if it isn't available, but a wallet is on disk
 func promptForPassword(c *cli.Context, hd *client.HyperdriveClient) error {
-	fmt.Println("Your node wallet is saved, but the password is not stored on disk so it cannot be loaded automatically.")
+	out := utils.GetOutput(c)
+	fmt.Fprintln(out, "Your node wallet is saved, but the password is not stored on disk so it cannot be loaded automatically.")
 	// Get the password
 	passwordString := c.String(wallet.PasswordFlag.Name)
 	if passwordString == "" {
-		passwordString = wallet.PromptExistingPassword()
+		var err error
+		passwordString, err = wallet.PromptExistingPassword()
+		if err != nil {
+			return err
+		}
 	}
 	password, err := input.ValidateNodePassword("password", passwordString)
 	if err != nil {
@@ -181,35 +225,97 @@ func promptForPassword(c *cli.Context, hd *client.HyperdriveClient) error {
 	}
 
 	// Get the save flag
-	savePassword := c.Bool(wallet.SavePasswordFlag.Name) || utils.Confirm("Would you like to save the password to disk? If you do, your node will be able to handle transactions automatically after a client restart; otherwise, you will have to repeat this command to manually enter the password after each restart.")
+	savePassword := c.Bool(wallet.SavePasswordFlag.Name)
+	if !savePassword {
+		savePassword, err = utils.Confirm("Would you like to save the password to disk? If you do, your node will be able to handle transactions automatically after a client restart; otherwise, you will have to repeat this command to manually enter the password after each restart.")
+		if err != nil {
+			return err
+		}
+	}
 
 	// Run it
 	_, err = hd.Api.Wallet.SetPassword(password, savePassword)
 	if err != nil {
-		fmt.Printf("%sError setting password: %s%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
-		fmt.Println("Your service has started, but you'll need to provide the node wallet password later with `hyperdrive wallet set-password`.")
+		fmt.Fprintf(out, "%sError setting password: %s%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+		fmt.Fprintln(out, "Your service has started, but you'll need to provide the node wallet password later with `hyperdrive wallet set-password`.")
 		return nil
 	}
 
 	// Refresh the status
 	response, err := hd.Api.Wallet.Status()
 	if err != nil {
-		fmt.Printf("Wallet password set.\n%sError checking node wallet: %s%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
-		fmt.Println("Please check the service logs with `hyperdrive service logs daemon` for more information.")
+		fmt.Fprintf(out, "Wallet password set.\n%sError checking node wallet: %s%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+		fmt.Fprintln(out, "Please check the service logs with `hyperdrive service logs daemon` for more information.")
 		return nil
 	}
 	status := response.Data.WalletStatus
 	if !status.Wallet.IsLoaded {
-		fmt.Println("Wallet password set, but the node wallet could not be loaded.")
-		fmt.Println("Please check the service logs with `hyperdrive service logs daemon` for more information.")
+		fmt.Fprintln(out, "Wallet password set, but the node wallet could not be loaded.")
+		fmt.Fprintln(out, "Please check the service logs with `hyperdrive service logs daemon` for more information.")
 		return nil
 	}
-	fmt.Printf("Your node wallet with address %s%s%s is now loaded and ready to use.\n", terminal.ColorBlue, status.Wallet.WalletAddress.Hex(), terminal.ColorReset)
+	fmt.Fprintf(out, "Your node wallet with address %s%s%s is now loaded and ready to use.\n", terminal.ColorBlue, status.Wallet.WalletAddress.Hex(), terminal.ColorReset)
 	return nil
 }
 
+// Make sure none of the Validator Clients can be slashed by restarting them with the given config, waiting out the slashing prevention delay if one of them has changed.
+// Returns true if the containers can be started.
+func confirmValidatorChange(c *cli.Context, hd *client.HyperdriveClient, cfg *client.GlobalConfig) (bool, error) {
+	out := utils.GetOutput(c)
+	if !c.Bool(ignoreSlashTimerFlag.Name) {
+		// Do the client swap check
+		firstRun, err := checkForValidatorChange(hd, cfg)
+		if err != nil {
+			fmt.Fprintf(out, "%sWARNING: couldn't verify that the Validator Client containers can be safely restarted:\n\t%s\n", terminal.ColorYellow, err.Error())
+			fmt.Fprintln(out, "If you are changing to a different client, it may resubmit an attestation you have already submitted.")
+			fmt.Fprintln(out, "This will slash your validator!")
+			fmt.Fprintln(out, "To prevent slashing, you must wait 15 minutes from the time you stopped the clients before starting them again.")
+			fmt.Fprintln(out)
+			fmt.Fprintln(out, "**If you did NOT change clients, you can safely ignore this warning.**")
+			fmt.Fprintln(out)
+			confirmed, err := utils.Confirm(fmt.Sprintf("Press y when you understand the above warning, have waited, and are ready to start Hyperdrive:%s", terminal.ColorReset))
+			if err != nil {
+				return false, err
+			}
+			if !confirmed {
+				return false, nil
+			}
+		} else if firstRun {
+			fmt.Fprintln(out, "It looks like this is your first time starting a Validator Client.")
+			existingNode, err := utils.Confirm("Just to be sure, does your node have any existing, active validators attesting on the Beacon Chain?")
+			if err != nil {
+				return false, err
+			}
+			if !existingNode {
+				fmt.Fprintln(out, "Okay, great! You're safe to start. Have fun!")
+			} else {
+				fmt.Fprintf(out, "%sSince didn't have any Validator Clients before, Hyperdrive can't determine if you attested in the last 15 minutes.\n", terminal.ColorYellow)
+				fmt.Fprintln(out, "If you did, it may resubmit an attestation you have already submitted.")
+				fmt.Fprintln(out, "This will slash your validator!")
+				fmt.Fprintln(out, "To prevent slashing, you must wait 15 minutes from the time you stopped the clients before starting them again.")
+				fmt.Fprintln(out)
+				confirmed, err := utils.Confirm(fmt.Sprintf("Press y when you understand the above warning, have waited, and are ready to start Hyperdrive:%s", terminal.ColorReset))
+				if err != nil {
+					return false, err
+				}
+				if !confirmed {
+					return false, nil
+				}
+			}
+		}
+	} else {
+		fmt.Fprintf(out, "%sIgnoring anti-slashing safety delay.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	}
+	return true, nil
+}
+
 // Check if any of the VCs has changed and force a wait for slashing protection, since all VCs are tied to the BN selection
 func checkForValidatorChange(hd *client.HyperdriveClient, cfg *client.GlobalConfig) (bool, error) {
+	// Native VCs aren't tied to an image, so there's no record of which client ran last
+	if cfg.Hyperdrive.IsNativeMode() {
+		return false, fmt.Errorf("Hyperdrive can't track Validator Client changes in Native Mode")
+	}
+
 	// Get all of the VCs belonging to the project
 	prefix := cfg.Hyperdrive.ProjectName.Value
 	vcs, err := hd.GetValidatorContainers(prefix + "_")
@@ -253,12 +359,14 @@ func checkForValidatorChange(hd *client.HyperdriveClient, cfg *client.GlobalConf
 
 	// Show the slashing prevention dialog
 	if longestRemainingTime > 0 {
-		showSlashingDelay(longestRemainingTime)
+		showSlashingDelay(hd.Context.Output, longestRemainingTime)
 	}
 	return false, nil
 }
 
 func checkValidatorClient(hd *client.HyperdriveClient, vcName string, newTagMap map[string]string) (time.Duration, error) {
+	out := hd.Context.Output
+
 	// Get the current and pending VC images
 	currentTag, err := hd.GetDockerImage(vcName)
 	if err != nil {
@@ -276,7 +384,7 @@ func checkValidatorClient(hd *client.HyperdriveClient, vcName string, newTagMap
 
 	// Compare the clients and warn if necessary
 	if currentVcType == pendingVcType {
-		fmt.Printf("Validator Client [%s] is still [%s] - no slashing prevention delay necessary.\n", vcName, currentVcType)
+		fmt.Fprintf(out, "Validator Client [%s] is still [%s] - no slashing prevention delay necessary.\n", vcName, currentVcType)
 		return 0, nil
 	} else {
 		validatorFinishTime, err := hd.GetDockerContainerShutdownTime(vcName)
@@ -291,7 +399,7 @@ func checkValidatorClient(hd *client.HyperdriveClient, vcName string, newTagMap
 			return 0, fmt.Errorf("error getting VC [%s] status: %w", vcName, err)
 		}
 		if validatorFinishTime == zeroTime || status == "running" {
-			fmt.Printf("%sValidator Client [%s] is currently running, stopping it...%s\n", terminal.ColorYellow, vcName, terminal.ColorReset)
+			fmt.Fprintf(out, "%sValidator Client [%s] is currently running, stopping it...%s\n", terminal.ColorYellow, vcName, terminal.ColorReset)
 			err := hd.StopContainer(vcName)
 			if err != nil {
 				return 0, fmt.Errorf("error stopping VC [%s]: %w", vcName, err)
@@ -303,14 +411,14 @@ func checkValidatorClient(hd *client.HyperdriveClient, vcName string, newTagMap
 		safeStartTime := validatorFinishTime.Add(15 * time.Minute)
 		remainingTime := time.Until(safeStartTime)
 		if remainingTime <= 0 {
-			fmt.Printf("Validator Client [%s] has been offline for %s, which is long enough to prevent slashing.\n", vcName, time.Since(validatorFinishTime))
+			fmt.Fprintf(out, "Validator Client [%s] has been offline for %s, which is long enough to prevent slashing.\n", vcName, time.Since(validatorFinishTime))
 			return 0, nil
 		}
 
 		// If this VC has remaining time before it can be safely started, add it to the list
 		if remainingTime > 0 {
-			fmt.Printf("Validator Client [%s] has changed types from [%s] to [%s].\n", vcName, currentVcType, pendingVcType)
-			fmt.Printf("Only %s has elapsed since you stopped it.\n", time.Since(validatorFinishTime))
+			fmt.Fprintf(out, "Validator Client [%s] has changed types from [%s] to [%s].\n", vcName, currentVcType, pendingVcType)
+			fmt.Fprintf(out, "Only %s has elapsed since you stopped it.\n", time.Since(validatorFinishTime))
 		}
 
 		// This can't be safely started, return its info
@@ -318,24 +426,24 @@ func checkValidatorClient(hd *client.HyperdriveClient, vcName string, newTagMap
 	}
 }
 
-func showSlashingDelay(remainingTime time.Duration) {
-	fmt.Printf("%s=== WARNING ===\n", terminal.ColorRed)
-	fmt.Println("You have changed validator clients. You must wait at least 15 minutes before safely starting them to prevent attesting to the same block twice, which would result in slashing your ETH.")
-	fmt.Println("To prevent slashing, Hyperdrive will delay activating the new client until it is safe.")
-	fmt.Println("See the documentation for a more detailed explanation: https://docs.nodeset.io")
-	fmt.Printf("If you have read the documentation, understand the risks, and want to bypass this cooldown, run `hyperdrive service start --%s`.%s\n\n", ignoreSlashTimerFlag.Name, terminal.ColorReset)
+func showSlashingDelay(out io.Writer, remainingTime time.Duration) {
+	fmt.Fprintf(out, "%s=== WARNING ===\n", terminal.ColorRed)
+	fmt.Fprintln(out, "You have changed validator clients. You must wait at least 15 minutes before safely starting them to prevent attesting to the same block twice, which would result in slashing your ETH.")
+	fmt.Fprintln(out, "To prevent slashing, Hyperdrive will delay activating the new client until it is safe.")
+	fmt.Fprintln(out, "See the documentation for a more detailed explanation: https://docs.nodeset.io")
+	fmt.Fprintf(out, "If you have read the documentation, understand the risks, and want to bypass this cooldown, run `hyperdrive service start --%s`.%s\n\n", ignoreSlashTimerFlag.Name, terminal.ColorReset)
 
 	// Wait for 15 minutes
 	safeStartTime := time.Now().Add(remainingTime)
 	for remainingTime > 0 {
-		fmt.Printf("Remaining time: %s", remainingTime)
+		fmt.Fprintf(out, "Remaining time: %s", remainingTime)
 		time.Sleep(1 * time.Second)
 		remainingTime = time.Until(safeStartTime)
-		fmt.Printf("%s\r", terminal.ClearLine)
+		fmt.Fprintf(out, "%s\r", terminal.ClearLine)
 	}
 
-	fmt.Println(terminal.ColorReset)
-	fmt.Println("You may now safely start Hyperdrive without fear of being slashed.")
+	fmt.Fprintln(out, terminal.ColorReset)
+	fmt.Fprintln(out, "You may now safely start Hyperdrive without fear of being slashed.")
 }
 
 // Get the map of tags
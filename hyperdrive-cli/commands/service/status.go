@@ -10,6 +10,8 @@ import (
 
 // View the Hyperdrive service status
 func serviceStatus(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -20,7 +22,7 @@ func serviceStatus(c *cli.Context) error {
 	}
 
 	// Print what network we're on
-	err = utils.PrintNetwork(cfg.Hyperdrive.Network.Value, isNew)
+	err = utils.PrintNetwork(out, cfg.Hyperdrive.Network.Value, isNew)
 	if err != nil {
 		return err
 	}
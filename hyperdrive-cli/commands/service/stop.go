@@ -5,18 +5,42 @@ import (
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 	"github.com/urfave/cli/v2"
 )
 
 // Pause the Hyperdrive service
 func stopService(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
+	// Check for validator duties during the downtime
+	cfg, isNew, err := hd.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading user settings: %w", err)
+	}
+	prompt := "Are you sure you want to pause the Hyperdrive service?"
+	if !isNew {
+		hasDuties, err := warnAboutDutiesDuringDowntime(c, hd, cfg)
+		if err != nil {
+			fmt.Fprintf(out, "%sWARNING: couldn't check for upcoming validator duties: %s%s\n\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+		} else if hasDuties {
+			prompt = "Are you sure you want to pause the Hyperdrive service anyway?"
+		}
+	}
+
 	// Prompt for confirmation
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm("Are you sure you want to pause the Hyperdrive service?")) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(prompt)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Pause service
@@ -2,12 +2,14 @@ package service
 
 import (
 	"fmt"
+	"io"
 	"math"
 	"strings"
 	"time"
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 	"github.com/nodeset-org/hyperdrive/shared/types/api"
 	"github.com/urfave/cli/v2"
 )
@@ -25,39 +27,78 @@ const (
 	ethClientRecentBlockThreshold time.Duration = 5 * time.Minute
 )
 
-func printClientStatus(status *api.ClientStatus, name string) {
+func printClientStatus(out io.Writer, status *api.ClientStatus, name string) {
 
 	if status.Error != "" {
-		fmt.Printf("Your %s is unavailable (%s).\n", name, status.Error)
+		fmt.Fprintf(out, "Your %s is unavailable (%s).\n", name, status.Error)
 		return
 	}
 
 	if status.IsSynced {
-		fmt.Printf("Your %s is fully synced.\n", name)
+		fmt.Fprintf(out, "Your %s is fully synced.\n", name)
 		return
 	}
 
-	fmt.Printf("Your %s is still syncing (%0.2f%%).\n", name, client.SyncRatioToPercent(status.SyncProgress))
+	fmt.Fprintf(out, "Your %s is still syncing (%0.2f%%).\n", name, client.SyncRatioToPercent(status.SyncProgress))
 	if strings.Contains(name, "execution") && status.SyncProgress == 0 {
-		fmt.Printf("\tNOTE: your %s may not report sync progress.\n\tYou should check its logs to review it.\n", name)
+		fmt.Fprintf(out, "\tNOTE: your %s may not report sync progress.\n\tYou should check its logs to review it.\n", name)
 	}
 }
 
-func printSyncProgress(status *api.ClientManagerStatus, name string) {
+func printSyncProgress(out io.Writer, status *api.ClientManagerStatus, name string) {
 
 	// Print primary client status
-	printClientStatus(&status.PrimaryClientStatus, fmt.Sprintf("primary %s client", name))
+	printClientStatus(out, &status.PrimaryClientStatus, fmt.Sprintf("primary %s client", name))
 
 	if !status.FallbackEnabled {
-		fmt.Printf("You do not have a fallback %s client enabled.\n", name)
+		fmt.Fprintf(out, "You do not have a fallback %s client enabled.\n", name)
 		return
 	}
 
 	// A fallback is enabled, so print fallback client status
-	printClientStatus(&status.FallbackClientStatus, fmt.Sprintf("fallback %s client", name))
+	printClientStatus(out, &status.FallbackClientStatus, fmt.Sprintf("fallback %s client", name))
+}
+
+func printCheckpointVerification(out io.Writer, status *api.CheckpointVerificationStatus) {
+	if !status.Enabled {
+		return
+	}
+	if !status.HasChecked {
+		fmt.Fprintln(out, "Your Beacon Node's finalized checkpoint hasn't been verified yet.")
+		return
+	}
+	if status.Error != "" {
+		fmt.Fprintf(out, "%sYour Beacon Node's finalized checkpoint couldn't be verified (%s).%s\n", terminal.ColorYellow, status.Error, terminal.ColorReset)
+		return
+	}
+
+	fmt.Fprintf(out, "Your Beacon Node's finalized checkpoint is epoch %d, root %s (checked %s).\n", status.LocalEpoch, status.LocalRoot.Hex(), status.CheckTime.Format(time.RFC822))
+	for _, source := range status.Sources {
+		if source.Error != "" {
+			fmt.Fprintf(out, "\t%s: %sunavailable (%s)%s\n", source.Url, terminal.ColorYellow, source.Error, terminal.ColorReset)
+		} else if source.IsMatched {
+			fmt.Fprintf(out, "\t%s: %smatches%s (epoch %d)\n", source.Url, terminal.ColorGreen, terminal.ColorReset, source.Epoch)
+		} else {
+			fmt.Fprintf(out, "\t%s: %sMISMATCH%s (epoch %d, root %s)\n", source.Url, terminal.ColorRed, terminal.ColorReset, source.Epoch, source.Root.Hex())
+		}
+	}
+
+	if status.IsMismatched {
+		if status.IsOverridden {
+			fmt.Fprintf(out, "%sYour Beacon Node disagrees with at least one verification source, but you have chosen to ignore checkpoint mismatches so your Validator Clients are allowed to run.%s\n", terminal.ColorYellow, terminal.ColorReset)
+		} else {
+			fmt.Fprintf(out, "%sYour Beacon Node disagrees with at least one verification source, so it may have synced from a faulty or malicious provider. Your Validator Clients will not be allowed to run until this is resolved.\nResync your Beacon Node from a trusted source with `hyperdrive service resync-bn`.%s\n", terminal.ColorRed, terminal.ColorReset)
+		}
+	} else if status.IsVerified {
+		fmt.Fprintf(out, "%sYour Beacon Node's finalized checkpoint has been verified.%s\n", terminal.ColorGreen, terminal.ColorReset)
+	} else {
+		fmt.Fprintf(out, "%sNone of the verification sources were available, so your Beacon Node's finalized checkpoint couldn't be verified.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	}
 }
 
 func getSyncProgress(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -68,7 +109,7 @@ func getSyncProgress(c *cli.Context) error {
 	}
 
 	// Print what network we're on
-	err = utils.PrintNetwork(cfg.Hyperdrive.Network.Value, isNew)
+	err = utils.PrintNetwork(out, cfg.Hyperdrive.Network.Value, isNew)
 	if err != nil {
 		return err
 	}
@@ -80,10 +121,13 @@ func getSyncProgress(c *cli.Context) error {
 	}
 
 	// Print EC status
-	printSyncProgress(&status.Data.EcManagerStatus, "execution")
+	printSyncProgress(out, &status.Data.EcManagerStatus, "execution")
 
 	// Print CC status
-	printSyncProgress(&status.Data.BcManagerStatus, "beacon")
+	printSyncProgress(out, &status.Data.BcManagerStatus, "beacon")
+
+	// Print the checkpoint verification status
+	printCheckpointVerification(out, &status.Data.CheckpointVerification)
 
 	// Return
 	return nil
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/urfave/cli/v2"
+)
+
+// Print the status of the daemon's background tasks
+func listTasks(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Get the tasks
+	response, err := hd.Api.Tasks.List()
+	if err != nil {
+		return err
+	}
+	utils.PrintTaskStatuses(out, response.Data.Tasks)
+	return nil
+}
+
+// Run one of the daemon's background tasks immediately
+func runTask(c *cli.Context, name string) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Trigger the task
+	_, err := hd.Api.Tasks.Run(name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%sTask [%s] has been scheduled to run now.%s Use `hyperdrive service tasks` to check its result.\n", terminal.ColorGreen, name, terminal.ColorReset)
+	return nil
+}
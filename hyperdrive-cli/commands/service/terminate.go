@@ -11,10 +11,18 @@ import (
 
 // Terminate the Hyperdrive service
 func terminateService(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Prompt for confirmation
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm(fmt.Sprintf("%sWARNING: Are you sure you want to terminate the Hyperdrive service? Any staking minipools will be penalized, your ETH1 and ETH2 chain databases will be deleted, you will lose ALL of your sync progress, and you will lose your Prometheus metrics database!\nAfter doing this, you will have to **reinstall** Hyperdrive uses `hyperdrive service install -d` in order to use it again.%s", terminal.ColorRed, terminal.ColorReset))) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(fmt.Sprintf("%sWARNING: Are you sure you want to terminate the Hyperdrive service? Any staking minipools will be penalized, your ETH1 and ETH2 chain databases will be deleted, you will lose ALL of your sync progress, and you will lose your Prometheus metrics database!\nAfter doing this, you will have to **reinstall** Hyperdrive uses `hyperdrive service install -d` in order to use it again.%s", terminal.ColorRed, terminal.ColorReset))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Get Hyperdrive client
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/urfave/cli/v2"
+)
+
+// Send a test alert through the daemon's notification sinks
+func sendTestNotification(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Send the notification
+	response, err := hd.Api.Service.SendTestNotification()
+	if err != nil {
+		return err
+	}
+	if !response.Data.NotificationsEnabled {
+		fmt.Fprintln(out, "Notifications are not enabled, or no webhook or email recipients have been configured.")
+		fmt.Fprintln(out, "You can set them up in the Notifications section of `hyperdrive service config`. Note that the daemon must be restarted for changes to take effect.")
+		return nil
+	}
+
+	fmt.Fprintf(out, "%sTest notification sent successfully.%s\n", terminal.ColorGreen, terminal.ColorReset)
+	return nil
+}
@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// The compose file that pins each service to the image it used before the upgrade during a rollback
+	upgradeRollbackComposeFile string = "upgrade-rollback.yml"
+
+	// How long a container has to stay up without restarting before it's considered healthy
+	upgradeSettleTime time.Duration = 30 * time.Second
+
+	// How often to check on the containers of the stage being upgraded
+	upgradePollInterval time.Duration = 5 * time.Second
+)
+
+var (
+	upgradeStageTimeoutFlag *cli.DurationFlag = &cli.DurationFlag{
+		Name:  "stage-timeout",
+		Usage: "How long to wait for the containers of each stage to become healthy and synced before rolling back the upgrade",
+		Value: 15 * time.Minute,
+	}
+)
+
+// A group of services that get upgraded together, before moving on to the next group
+type upgradeStage struct {
+	// The name of the stage, for printing
+	name string
+
+	// The compose services in the stage
+	services []string
+
+	// Checks whether the stage's clients are synced once its containers are running; returns a description of what it's waiting for, or an empty string if they're ready
+	checkSync func(hd *client.HyperdriveClient) string
+}
+
+// Upgrade the Hyperdrive service one stage at a time, rolling back to the previous settings and images if any stage fails
+func upgradeService(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+	cfg, isNew, err := hd.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading user settings: %w", err)
+	}
+	if isNew {
+		return fmt.Errorf("No configuration detected. Please run `hyperdrive service config` to set up Hyperdrive before running it.")
+	}
+	if cfg.Hyperdrive.IsNativeMode() {
+		return fmt.Errorf("Staged upgrades rely on container images, so they aren't available in Native Mode. Please install the new versions of your clients and Hyperdrive's binaries, then run `hyperdrive service start`.")
+	}
+
+	// Make sure the service is running, since the health checks rely on the daemon
+	daemonName := cfg.Hyperdrive.GetDockerArtifactName(string(config.ContainerID_Daemon))
+	status, err := hd.GetDockerStatus(daemonName)
+	if err != nil || status != "running" {
+		return fmt.Errorf("The Hyperdrive service isn't running. Please use `hyperdrive service start` instead.")
+	}
+
+	// Describe the upgrade
+	oldVersion := strings.TrimPrefix(cfg.Hyperdrive.Version, "v")
+	currentVersion := strings.TrimPrefix(shared.HyperdriveVersion, "v")
+	isUpdate := oldVersion != currentVersion
+	if isUpdate {
+		fmt.Fprintf(out, "This will upgrade your node from Hyperdrive v%s to v%s, overwriting certain settings with the latest defaults (such as container versions).\n", oldVersion, currentVersion)
+	} else {
+		fmt.Fprintf(out, "Your settings are already up to date with Hyperdrive v%s, so this will only pull and restart the containers with their latest images.\n", currentVersion)
+	}
+	fmt.Fprintln(out, "Hyperdrive will pull the new images, then restart the containers one stage at a time and wait for each stage to be healthy and synced before moving on to the next.")
+	fmt.Fprintf(out, "If a stage isn't ready within %s, your previous settings and images will be restored automatically.\n\n", c.Duration(upgradeStageTimeoutFlag.Name))
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm("Would you like to continue?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
+	}
+
+	// Snapshot the current settings and images
+	projectName := cfg.Hyperdrive.ProjectName.Value
+	images, err := hd.GetServiceImages(projectName)
+	if err != nil {
+		return fmt.Errorf("error getting the current container images: %w", err)
+	}
+	err = hd.SaveBackupConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("error backing up user settings: %w", err)
+	}
+	fmt.Fprintf(out, "Saved a snapshot of your current settings and %d container images.\n", len(images))
+
+	// Update the settings
+	if isUpdate {
+		cfg.UpdateDefaults()
+	}
+	errors := cfg.Validate()
+	if len(errors) > 0 {
+		fmt.Fprintf(out, "%sYour configuration encountered errors. You must correct the following in order to upgrade Hyperdrive:\n\n", terminal.ColorRed)
+		for _, err := range errors {
+			fmt.Fprintf(out, "%s\n\n", err)
+		}
+		fmt.Fprintln(out, terminal.ColorReset)
+		return nil
+	}
+
+	// Make sure the restart is safe
+	confirmed, err := confirmValidatorChange(c, hd, cfg)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Fprintln(out, "Cancelled.")
+		return nil
+	}
+	proceed, err := confirmNoDutiesDuringDowntime(c, hd, cfg)
+	if err == nil && !proceed {
+		fmt.Fprintln(out, "Cancelled.")
+		return nil
+	}
+
+	// Apply the new settings and pull the images
+	composeFiles := getComposeFiles(c)
+	err = applyUpgradeSettings(hd, cfg)
+	if err == nil {
+		fmt.Fprintln(out, "Pulling the new images...")
+		err = hd.PullServiceImages(composeFiles)
+	}
+	if err != nil {
+		return rollbackUpgrade(c, images, fmt.Errorf("error preparing the upgrade: %w", err))
+	}
+
+	// Restart each stage in dependency order
+	timeout := c.Duration(upgradeStageTimeoutFlag.Name)
+	for _, stage := range getUpgradeStages(cfg) {
+		fmt.Fprintf(out, "\n%sUpgrading the %s...%s\n", terminal.ColorBlue, stage.name, terminal.ColorReset)
+		err = hd.StartServices(composeFiles, stage.services)
+		if err == nil {
+			err = waitForUpgradeStage(hd, cfg, stage, timeout, true)
+		}
+		if err != nil {
+			return rollbackUpgrade(c, images, fmt.Errorf("the %s failed to upgrade: %w", stage.name, err))
+		}
+		fmt.Fprintf(out, "%sFinished upgrading the %s.%s\n", terminal.ColorGreen, stage.name, terminal.ColorReset)
+	}
+
+	// Clean up any leftover containers from services that were removed
+	err = hd.StartService(composeFiles)
+	if err != nil {
+		return fmt.Errorf("error cleaning up old containers: %w", err)
+	}
+
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "%sHyperdrive was upgraded successfully.%s\n", terminal.ColorGreen, terminal.ColorReset)
+	fmt.Fprintf(out, "Your previous settings are saved in %s if you need them.\n", filepath.Join(hd.Context.ConfigPath, client.BackupSettingsFile))
+	return nil
+}
+
+// Save the upgraded settings and regenerate the metrics config templates
+func applyUpgradeSettings(hd *client.HyperdriveClient, cfg *client.GlobalConfig) error {
+	err := hd.SaveConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("error saving user settings: %w", err)
+	}
+	if cfg.Hyperdrive.Metrics.EnableMetrics.Value {
+		err = hd.UpdatePrometheusConfiguration(cfg)
+		if err != nil {
+			return err
+		}
+		err = hd.UpdateGrafanaDatabaseConfiguration(cfg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore the settings from the snapshot and restart the containers with the images they were using before the upgrade
+func rollbackUpgrade(c *cli.Context, images map[string]string, upgradeErr error) error {
+	out := utils.GetOutput(c)
+	fmt.Fprintf(out, "\n%s%s\nRolling back to your previous settings and images...%s\n", terminal.ColorRed, upgradeErr.Error(), terminal.ColorReset)
+
+	// Restore the settings; this uses a new client so the old settings get loaded from disk
+	hd := client.NewHyperdriveClientFromCtx(c)
+	cfg, err := hd.LoadBackupConfig()
+	if err != nil {
+		return fmt.Errorf("%w\nerror loading the settings snapshot: %s", upgradeErr, err.Error())
+	}
+	if cfg == nil {
+		return fmt.Errorf("%w\nthe settings snapshot is missing, so Hyperdrive couldn't roll back", upgradeErr)
+	}
+	err = applyUpgradeSettings(hd, cfg)
+	if err != nil {
+		return fmt.Errorf("%w\nerror restoring the settings snapshot: %s", upgradeErr, err.Error())
+	}
+
+	// Pin each service to its old image, since some of the images are tied to the CLI version instead of the settings
+	configPath, err := homedir.Expand(hd.Context.ConfigPath)
+	if err != nil {
+		return fmt.Errorf("%w\nerror expanding config path: %s", upgradeErr, err.Error())
+	}
+	stages := getUpgradeStages(cfg)
+	pinnedServices := map[string]any{}
+	for _, stage := range stages {
+		for _, service := range stage.services {
+			if image, exists := images[service]; exists {
+				pinnedServices[service] = map[string]string{
+					"image": image,
+				}
+			}
+		}
+	}
+	bytes, err := yaml.Marshal(map[string]any{
+		"services": pinnedServices,
+	})
+	if err != nil {
+		return fmt.Errorf("%w\nerror serializing the image snapshot: %s", upgradeErr, err.Error())
+	}
+	rollbackFile := filepath.Join(configPath, upgradeRollbackComposeFile)
+	err = os.WriteFile(rollbackFile, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("%w\nerror writing the image snapshot: %s", upgradeErr, err.Error())
+	}
+	defer os.Remove(rollbackFile)
+
+	// Restart the containers and wait for them to come back up
+	composeFiles := append([]string{}, getComposeFiles(c)...)
+	composeFiles = append(composeFiles, rollbackFile)
+	err = hd.StartService(composeFiles)
+	if err != nil {
+		return fmt.Errorf("%w\nerror restarting the service with the previous images: %s", upgradeErr, err.Error())
+	}
+	timeout := c.Duration(upgradeStageTimeoutFlag.Name)
+	for _, stage := range stages {
+		err = waitForUpgradeStage(hd, cfg, stage, timeout, false)
+		if err != nil {
+			return fmt.Errorf("%w\nthe %s didn't come back up after rolling back: %s", upgradeErr, stage.name, err.Error())
+		}
+	}
+
+	fmt.Fprintf(out, "%sYour node was rolled back to its previous settings and images.%s\n", terminal.ColorGreen, terminal.ColorReset)
+	oldVersion := strings.TrimPrefix(cfg.Hyperdrive.Version, "v")
+	currentVersion := strings.TrimPrefix(shared.HyperdriveVersion, "v")
+	if oldVersion != currentVersion {
+		fmt.Fprintf(out, "%sNOTE: the Hyperdrive daemon images are tied to the version of the CLI you have installed, so the next `hyperdrive service start` will move them to v%s.\n", terminal.ColorYellow, currentVersion)
+		fmt.Fprintf(out, "To stay on v%s, reinstall that version of Hyperdrive before restarting the service.%s\n", oldVersion, terminal.ColorReset)
+	}
+	return upgradeErr
+}
+
+// Get the stages of an upgrade, in the order their services depend on each other
+func getUpgradeStages(cfg *client.GlobalConfig) []upgradeStage {
+	stages := []upgradeStage{}
+	if cfg.Hyperdrive.IsLocalMode() {
+		stages = append(stages,
+			upgradeStage{
+				name:      "Execution Client",
+				services:  []string{string(config.ContainerID_ExecutionClient)},
+				checkSync: getEcUpgradeStatus,
+			},
+			upgradeStage{
+				name:      "Beacon Node",
+				services:  []string{string(config.ContainerID_BeaconNode)},
+				checkSync: getBnUpgradeStatus,
+			},
+		)
+	}
+
+	moduleDaemons, vcs := getModuleServices(cfg)
+	stages = append(stages, upgradeStage{
+		name:      "daemons",
+		services:  append([]string{string(config.ContainerID_Daemon)}, moduleDaemons...),
+		checkSync: getDaemonUpgradeStatus,
+	})
+	if len(vcs) > 0 {
+		stages = append(stages, upgradeStage{
+			name:     "Validator Clients",
+			services: vcs,
+		})
+	}
+
+	if cfg.Hyperdrive.Metrics.EnableMetrics.Value {
+		stages = append(stages, upgradeStage{
+			name: "metrics containers",
+			services: []string{
+				string(config.ContainerID_Exporter),
+				string(config.ContainerID_Prometheus),
+				string(config.ContainerID_Grafana),
+			},
+		})
+	}
+	return stages
+}
+
+// Wait for all of the containers in a stage to be healthy, and optionally for its clients to be synced
+func waitForUpgradeStage(hd *client.HyperdriveClient, cfg *client.GlobalConfig, stage upgradeStage, timeout time.Duration, checkSync bool) error {
+	out := hd.Context.Output
+	deadline := time.Now().Add(timeout)
+	lastStatus := ""
+	for {
+		status, err := getUpgradeStageStatus(hd, cfg, stage, checkSync)
+		if err != nil {
+			return err
+		}
+		if status == "" {
+			return nil
+		}
+		if status != lastStatus {
+			fmt.Fprintf(out, "\t%s\n", status)
+			lastStatus = status
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s (%s)", timeout, status)
+		}
+		time.Sleep(upgradePollInterval)
+	}
+}
+
+// Get a description of what a stage is waiting for, or an empty string if it's ready.
+// Returns an error if one of its containers has stopped, since it won't recover on its own.
+func getUpgradeStageStatus(hd *client.HyperdriveClient, cfg *client.GlobalConfig, stage upgradeStage, checkSync bool) (string, error) {
+	d, err := hd.GetDocker()
+	if err != nil {
+		return "", err
+	}
+	for _, service := range stage.services {
+		name := cfg.Hyperdrive.GetDockerArtifactName(service)
+		ci, err := d.ContainerInspect(context.Background(), name)
+		if err != nil {
+			return fmt.Sprintf("waiting for %s to be created", name), nil
+		}
+
+		state := ci.State
+		switch {
+		case state.Restarting:
+			return fmt.Sprintf("%s is restarting", name), nil
+		case state.Status == "exited" || state.Status == "dead":
+			return "", fmt.Errorf("%s stopped with exit code %d; check its logs with `hyperdrive service logs %s`", name, state.ExitCode, service)
+		case state.Status != "running":
+			return fmt.Sprintf("%s is %s", name, state.Status), nil
+		case state.Health != nil && state.Health.Status != "healthy":
+			return fmt.Sprintf("%s health check is %s", name, state.Health.Status), nil
+		}
+
+		// Make sure it isn't stuck in a restart loop
+		startTime, err := time.Parse(time.RFC3339Nano, state.StartedAt)
+		if err != nil {
+			return "", fmt.Errorf("error parsing %s start time [%s]: %w", name, state.StartedAt, err)
+		}
+		if time.Since(startTime) < upgradeSettleTime {
+			return fmt.Sprintf("waiting for %s to settle", name), nil
+		}
+	}
+
+	if checkSync && stage.checkSync != nil {
+		return stage.checkSync(hd), nil
+	}
+	return "", nil
+}
+
+// Check if the primary Execution Client is synced
+func getEcUpgradeStatus(hd *client.HyperdriveClient) string {
+	response, err := hd.Api.Service.ClientStatus()
+	if err != nil {
+		return fmt.Sprintf("waiting for the daemon to respond (%s)", err.Error())
+	}
+	return getClientUpgradeStatus("Execution Client", response.Data.EcManagerStatus.PrimaryClientStatus)
+}
+
+// Check if the primary Beacon Node is synced
+func getBnUpgradeStatus(hd *client.HyperdriveClient) string {
+	response, err := hd.Api.Service.ClientStatus()
+	if err != nil {
+		return fmt.Sprintf("waiting for the daemon to respond (%s)", err.Error())
+	}
+	return getClientUpgradeStatus("Beacon Node", response.Data.BcManagerStatus.PrimaryClientStatus)
+}
+
+// Check if the new daemon is responding and can see synced clients
+func getDaemonUpgradeStatus(hd *client.HyperdriveClient) string {
+	response, err := hd.Api.Service.ClientStatus()
+	if err != nil {
+		return fmt.Sprintf("waiting for the daemon to respond (%s)", err.Error())
+	}
+	status := getClientUpgradeStatus("Execution Client", response.Data.EcManagerStatus.PrimaryClientStatus)
+	if status != "" {
+		return status
+	}
+	return getClientUpgradeStatus("Beacon Node", response.Data.BcManagerStatus.PrimaryClientStatus)
+}
+
+// Describe why a client isn't ready yet, or return an empty string if it is
+func getClientUpgradeStatus(name string, status api.ClientStatus) string {
+	if !status.IsWorking {
+		return fmt.Sprintf("waiting for the %s to respond (%s)", name, status.Error)
+	}
+	if !status.IsSynced {
+		return fmt.Sprintf("waiting for the %s to sync (%.2f%%)", name, status.SyncProgress*100)
+	}
+	return ""
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/urfave/cli/v2"
 )
@@ -27,39 +28,40 @@ func getComposeFiles(c *cli.Context) []string {
 // Handle a network change by terminating the service, deleting everything, and starting over
 // TODO
 func changeNetworks(c *cli.Context, hd *client.HyperdriveClient) error {
+	out := utils.GetOutput(c)
 	return fmt.Errorf("NYI")
 
 	// Stop all of the containers
-	fmt.Print("Stopping containers... ")
+	fmt.Fprint(out, "Stopping containers... ")
 	err := hd.PauseService(getComposeFiles(c))
 	if err != nil {
 		return fmt.Errorf("error stopping service: %w", err)
 	}
-	fmt.Println("done")
+	fmt.Fprintln(out, "done")
 
 	// Delete the data folder
-	fmt.Print("Removing data folder... ")
+	fmt.Fprint(out, "Removing data folder... ")
 	_, err = hd.Api.Service.TerminateDataFolder()
 	if err != nil {
 		return err
 	}
-	fmt.Println("done")
+	fmt.Fprintln(out, "done")
 
 	// Terminate the current setup
-	fmt.Print("Removing old installation... ")
+	fmt.Fprint(out, "Removing old installation... ")
 	err = hd.StopService(getComposeFiles(c))
 	if err != nil {
 		return fmt.Errorf("error terminating old installation: %w", err)
 	}
-	fmt.Println("done")
+	fmt.Fprintln(out, "done")
 
 	// Start the service
-	fmt.Print("Starting Hyperdrive... ")
+	fmt.Fprint(out, "Starting Hyperdrive... ")
 	err = hd.StartService(getComposeFiles(c))
 	if err != nil {
 		return fmt.Errorf("error starting service: %w", err)
 	}
-	fmt.Println("done")
+	fmt.Fprintln(out, "done")
 
 	return nil
 }
@@ -84,3 +86,36 @@ func getPartitionFreeSpace(hd *client.HyperdriveClient, targetDir string) (uint6
 	}
 	return diskUsage.Free, nil
 }
+
+// Get the compose services of the enabled modules, split into the daemons and the Validator Clients
+func getModuleServices(cfg *client.GlobalConfig) ([]string, []string) {
+	daemons := []string{}
+	vcs := []string{}
+	for _, module := range cfg.GetAllModuleConfigs() {
+		if !module.IsEnabled() {
+			continue
+		}
+		vcInfo := module.GetValidatorContainerTagInfo()
+		for _, container := range module.GetContainersToDeploy() {
+			if _, isVc := vcInfo[container]; isVc {
+				vcs = append(vcs, string(container))
+			} else {
+				daemons = append(daemons, string(container))
+			}
+		}
+	}
+	return daemons, vcs
+}
+
+// Get every compose service of the Hyperdrive service except for the Validator Clients
+func getNonValidatorServices(cfg *client.GlobalConfig) []string {
+	services := []string{string(config.ContainerID_Daemon)}
+	if cfg.Hyperdrive.IsLocalMode() {
+		services = append(services, string(config.ContainerID_ExecutionClient), string(config.ContainerID_BeaconNode))
+	}
+	if cfg.Hyperdrive.Metrics.EnableMetrics.Value && !cfg.Hyperdrive.IsNativeMode() {
+		services = append(services, string(config.ContainerID_Exporter), string(config.ContainerID_Prometheus), string(config.ContainerID_Grafana))
+	}
+	moduleDaemons, _ := getModuleServices(cfg)
+	return append(services, moduleDaemons...)
+}
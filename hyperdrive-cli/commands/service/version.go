@@ -11,6 +11,8 @@ import (
 
 // View the Hyperdrive service version information
 func serviceVersion(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -21,7 +23,7 @@ func serviceVersion(c *cli.Context) error {
 	}
 
 	// Print what network we're on
-	err = utils.PrintNetwork(cfg.Hyperdrive.Network.Value, isNew)
+	err = utils.PrintNetwork(out, cfg.Hyperdrive.Network.Value, isNew)
 	if err != nil {
 		return err
 	}
@@ -108,9 +110,9 @@ func serviceVersion(c *cli.Context) error {
 	}
 
 	// Print version info
-	fmt.Printf("Hyperdrive client version: %s\n", c.App.Version)
-	fmt.Printf("Hyperdrive daemon version: %s\n", serviceVersion)
-	fmt.Printf("Selected Execution Client: %s\n", executionClientString)
-	fmt.Printf("Selected Beacon Node: %s\n", beaconNodeString)
+	fmt.Fprintf(out, "Hyperdrive client version: %s\n", c.App.Version)
+	fmt.Fprintf(out, "Hyperdrive daemon version: %s\n", serviceVersion)
+	fmt.Fprintf(out, "Selected Execution Client: %s\n", executionClientString)
+	fmt.Fprintf(out, "Selected Beacon Node: %s\n", beaconNodeString)
 	return nil
 }
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/eth-utils/eth"
+	hdclient "github.com/nodeset-org/hyperdrive/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	hdcontext "github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/context"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/output"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	watchTypesFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "types",
+		Aliases: []string{"t"},
+		Usage:   fmt.Sprintf("Comma-separated list of event types to show; leave blank to show all of them. Options: %s", strings.Join(allEventTypes(), ", ")),
+	}
+)
+
+// Tail the events published by the daemons
+func watchEvents(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+	cfg, _, err := hd.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading Hyperdrive config: %w", err)
+	}
+
+	// Get the event types
+	types := []api.EventType{}
+	typesString := c.String(watchTypesFlag.Name)
+	if typesString != "" {
+		for _, eventType := range strings.Split(typesString, ",") {
+			types = append(types, api.EventType(strings.TrimSpace(eventType)))
+		}
+	}
+
+	// Subscribe to the daemon and any enabled modules
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	subs := []*hdclient.EventSubscription{}
+	sub, err := hd.Api.SubscribeToEvents(ctx, types...)
+	if err != nil {
+		return err
+	}
+	subs = append(subs, sub)
+	if cfg.Stakewise.Enabled.Value {
+		sw := client.NewStakewiseClientFromCtx(c)
+		sub, err := sw.Api.SubscribeToEvents(ctx, types...)
+		if err != nil {
+			return fmt.Errorf("error subscribing to Stakewise events: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+
+	// Merge the streams
+	events := make(chan api.Event)
+	errs := make(chan error, len(subs))
+	for _, sub := range subs {
+		go func(sub *hdclient.EventSubscription) {
+			for event := range sub.Events {
+				events <- event
+			}
+			errs <- sub.Err()
+		}(sub)
+	}
+
+	format := hd.Context.OutputFormat
+	if format == hdcontext.OutputFormat_Text {
+		fmt.Fprintln(out, "Watching for events, press Ctrl+C to exit...")
+		fmt.Fprintln(out)
+	}
+	for {
+		select {
+		case event := <-events:
+			if format == hdcontext.OutputFormat_Text {
+				printEvent(out, event)
+				continue
+			}
+			err := output.PrintStreamItem(format, event)
+			if err != nil {
+				return err
+			}
+		case err := <-errs:
+			return err
+		}
+	}
+}
+
+// Print a single event on one line
+func printEvent(out io.Writer, event api.Event) {
+	color, summary := getEventSummary(event)
+	fmt.Fprintf(out, "%s  %-10s  %s%-20s%s  %s\n", event.Time.Format(time.DateTime), event.Source, color, event.Type, terminal.ColorReset, summary)
+}
+
+// Get the color to print an event with and a human-readable summary of it
+func getEventSummary(event api.Event) (string, string) {
+	switch event.Type {
+	case api.EventType_TaskRun:
+		var data api.TaskStatus
+		if json.Unmarshal(event.Data, &data) != nil {
+			break
+		}
+		switch data.LastResult {
+		case api.TaskResult_Succeeded:
+			return terminal.ColorGreen, fmt.Sprintf("%s succeeded in %s", data.Name, data.LastRunDuration.Round(time.Millisecond))
+		case api.TaskResult_Skipped:
+			return terminal.ColorYellow, fmt.Sprintf("%s skipped: %s", data.Name, data.LastError)
+		default:
+			return terminal.ColorRed, fmt.Sprintf("%s %s: %s", data.Name, data.LastResult, data.LastError)
+		}
+
+	case api.EventType_DepositDataUpdated:
+		var data api.DepositDataUpdatedEvent
+		if json.Unmarshal(event.Data, &data) != nil {
+			break
+		}
+		return terminal.ColorGreen, fmt.Sprintf("version %d -> %d (%d entries)", data.OldVersion, data.NewVersion, data.Count)
+
+	case api.EventType_OperatorBalanceLow:
+		var data api.OperatorBalanceLowEvent
+		if json.Unmarshal(event.Data, &data) != nil {
+			break
+		}
+		return terminal.ColorYellow, fmt.Sprintf("%s has %.6f ETH (minimum %.6f)", data.Address.Hex(), eth.WeiToEth(data.Balance), eth.WeiToEth(data.MinBalance))
+
+	case api.EventType_TxSubmitted, api.EventType_TxMined, api.EventType_TxFailed:
+		var data api.TxEvent
+		if json.Unmarshal(event.Data, &data) != nil {
+			break
+		}
+		if event.Type == api.EventType_TxFailed {
+			return terminal.ColorRed, fmt.Sprintf("%s: %s", data.TxHash.Hex(), data.Error)
+		}
+		if event.Type == api.EventType_TxMined {
+			return terminal.ColorGreen, data.TxHash.Hex()
+		}
+		return terminal.ColorBlue, data.TxHash.Hex()
+
+	case api.EventType_ClientFailover:
+		var data api.ClientFailoverEvent
+		if json.Unmarshal(event.Data, &data) != nil {
+			break
+		}
+		which := "fallback"
+		if data.IsPrimary {
+			which = "primary"
+		}
+		action := "no clients are available"
+		if data.UsingFallback {
+			action = "using the fallback"
+		}
+		return terminal.ColorYellow, fmt.Sprintf("%s %s client failed (%s), %s", which, data.Client, data.Error, action)
+
+	case api.EventType_WalletLoaded, api.EventType_WalletUnloaded:
+		var data api.WalletEvent
+		if json.Unmarshal(event.Data, &data) != nil {
+			break
+		}
+		return terminal.ColorBlue, data.Address.Hex()
+	}
+	return terminal.ColorReset, string(event.Data)
+}
+
+// Get the names of all of the event types
+func allEventTypes() []string {
+	return []string{
+		string(api.EventType_TaskRun),
+		string(api.EventType_DepositDataUpdated),
+		string(api.EventType_OperatorBalanceLow),
+		string(api.EventType_TxSubmitted),
+		string(api.EventType_TxMined),
+		string(api.EventType_TxFailed),
+		string(api.EventType_ClientFailover),
+		string(api.EventType_WalletLoaded),
+		string(api.EventType_WalletUnloaded),
+	}
+}
@@ -3,6 +3,7 @@ package swcmd
 import (
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/stakewise/nodeset"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/stakewise/status"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/stakewise/tasks"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/stakewise/validator"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/stakewise/wallet"
 
@@ -21,6 +22,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 	wallet.RegisterCommands(cmd, "wallet", []string{"w"})
 	status.RegisterCommands(cmd, "status", []string{"s"})
 	validator.RegisterCommands(cmd, "validator", []string{"v"})
+	tasks.RegisterCommands(cmd, "tasks", []string{"t"})
 
 	app.Commands = append(app.Commands, cmd)
 }
@@ -17,6 +17,8 @@ var validatorsRootFlag *cli.StringFlag = &cli.StringFlag{
 }
 
 func setValidatorsRoot(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get the client
 	hd := client.NewHyperdriveClientFromCtx(c)
 	sw := client.NewStakewiseClientFromCtx(c)
@@ -24,7 +26,11 @@ func setValidatorsRoot(c *cli.Context) error {
 	// Get the root
 	rootString := c.String(validatorsRootFlag.Name)
 	if rootString == "" {
-		rootString = utils.Prompt("Please enter the root of the aggregated validator deposit data Merkle tree:", "^0x[0-9a-fA-F]{64}$", "Invalid hash format")
+		var err error
+		rootString, err = utils.Prompt("Please enter the root of the aggregated validator deposit data Merkle tree:", "^0x[0-9a-fA-F]{64}$", "Invalid hash format")
+		if err != nil {
+			return err
+		}
 	}
 	root, err := input.ValidateHash("root", rootString)
 	if err != nil {
@@ -48,6 +54,6 @@ func setValidatorsRoot(c *cli.Context) error {
 	}
 
 	// Log & return
-	fmt.Println("Validators root successfully set.")
+	fmt.Fprintln(out, "Validators root successfully set.")
 	return nil
 }
@@ -4,21 +4,23 @@ import (
 	"fmt"
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/urfave/cli/v2"
 )
 
 func getNodeStatus(c *cli.Context) error {
+	out := utils.GetOutput(c)
 	sw := client.NewStakewiseClientFromCtx(c)
 	response, err := sw.Api.Status.GetActiveValidators()
 	if err != nil {
-		fmt.Printf("error fetching active validators: %v\n", err)
+		fmt.Fprintf(out, "error fetching active validators: %v\n", err)
 		return err
 	}
 
-	fmt.Printf("Active Validator Pubkeys: \n")
+	fmt.Fprintf(out, "Active Validator Pubkeys: \n")
 
 	for _, validator := range response.Data.ActiveValidators {
-		fmt.Printf("%v\n", validator.HexWithPrefix())
+		fmt.Fprintf(out, "%v\n", validator.HexWithPrefix())
 	}
 
 	return nil
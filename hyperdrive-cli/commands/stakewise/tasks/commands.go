@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/urfave/cli/v2"
+)
+
+// Register commands
+func RegisterCommands(cmd *cli.Command, name string, aliases []string) {
+	cmd.Subcommands = append(cmd.Subcommands, &cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Manage the Stakewise daemon's background tasks",
+		Subcommands: []*cli.Command{
+			{
+				Name:    "list",
+				Aliases: []string{"l"},
+				Usage:   "View the status of the Stakewise daemon's background tasks",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run command
+					return listTasks(c)
+				},
+			},
+			{
+				Name:      "run",
+				Aliases:   []string{"r"},
+				Usage:     "Run one of the Stakewise daemon's background tasks immediately, such as 'update-deposit-data' to refresh the deposit data from NodeSet",
+				ArgsUsage: "task-name",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run command
+					return runTask(c, c.Args().Get(0))
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/urfave/cli/v2"
+)
+
+// Print the status of the Stakewise daemon's background tasks
+func listTasks(c *cli.Context) error {
+	// Get the client
+	sw := client.NewStakewiseClientFromCtx(c)
+
+	// Get the tasks
+	response, err := sw.Api.Tasks.List()
+	if err != nil {
+		return err
+	}
+	utils.PrintTaskStatuses(utils.GetOutput(c), response.Data.Tasks)
+	return nil
+}
+
+// Run one of the Stakewise daemon's background tasks immediately
+func runTask(c *cli.Context, name string) error {
+	out := utils.GetOutput(c)
+
+	// Get the client
+	sw := client.NewStakewiseClientFromCtx(c)
+
+	// Trigger the task
+	_, err := sw.Api.Tasks.Run(name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%sTask [%s] has been scheduled to run now.%s Use `hyperdrive stakewise tasks list` to check its result.\n", terminal.ColorGreen, name, terminal.ColorReset)
+	return nil
+}
@@ -24,6 +24,8 @@ var (
 )
 
 func getSignedExitMessages(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get the client
 	sw := client.NewStakewiseClientFromCtx(c)
 
@@ -56,9 +58,15 @@ func getSignedExitMessages(c *cli.Context) error {
 		fmt.Printf("Once your funds have been withdrawn, you can run `rocketpool minipool close` to distribute them to your withdrawal address and close the minipool.\n\n%s", terminal.ColorReset)
 
 		// Prompt for confirmation
-		if !(c.Bool("yes") || utils.ConfirmWithIAgree(fmt.Sprintf("Are you sure you want to exit %d minipool(s)? This action cannot be undone!", len(selectedMinipools)))) {
-			fmt.Println("Cancelled.")
-			return nil
+		if !c.Bool("yes") {
+			confirmed, err := utils.ConfirmWithIAgree(fmt.Sprintf("Are you sure you want to exit %d minipool(s)? This action cannot be undone!", len(selectedMinipools)))
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+					fmt.Println("Cancelled.")
+					return nil
+			}
 		}
 	*/
 
@@ -80,12 +88,12 @@ func getSignedExitMessages(c *cli.Context) error {
 	}
 
 	// Print them all
-	fmt.Printf("Exit epoch: %d\n", response.Data.Epoch)
-	fmt.Println()
+	fmt.Fprintf(out, "Exit epoch: %d\n", response.Data.Epoch)
+	fmt.Fprintln(out)
 	for pubkey, info := range response.Data.ExitInfos {
-		fmt.Printf("Validator %d (%s):\n", info.Index, pubkey)
-		fmt.Printf("\tSignature: %s\n", info.Signature.HexWithPrefix())
-		fmt.Println()
+		fmt.Fprintf(out, "Validator %d (%s):\n", info.Index, pubkey)
+		fmt.Fprintf(out, "\tSignature: %s\n", info.Signature.HexWithPrefix())
+		fmt.Fprintln(out)
 	}
 
 	// Return
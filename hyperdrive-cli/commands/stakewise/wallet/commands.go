@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/shared/utils/input"
 	"github.com/urfave/cli/v2"
 )
@@ -15,7 +16,7 @@ func RegisterCommands(cmd *cli.Command, name string, aliases []string) {
 			{
 				Name:    "init",
 				Aliases: []string{"i"},
-				Usage:   "Clone the node wallet file into a wallet that the Stakewise operator service can use.",
+				Usage:   "Derive a hot wallet for the Stakewise operator service from the node wallet.",
 				Action: func(c *cli.Context) error {
 					// Validate args
 					if err := input.ValidateArgCount(c, 0); err != nil {
@@ -26,6 +27,53 @@ func RegisterCommands(cmd *cli.Command, name string, aliases []string) {
 					return initialize(c)
 				},
 			},
+			{
+				Name:    "operator-status",
+				Aliases: []string{"o"},
+				Usage:   "Show the address and balance of the Stakewise operator's hot wallet.",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return operatorStatus(c)
+				},
+			},
+			{
+				Name:      "top-up-operator",
+				Aliases:   []string{"t"},
+				Usage:     "Send ETH from the node wallet to the Stakewise operator's hot wallet so it can pay for its transactions.",
+				ArgsUsage: "amount",
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					amount := c.Args().Get(0)
+
+					// Run
+					return topUpOperator(c, amount)
+				},
+			},
+			{
+				Name:  "rotate-operator",
+				Usage: "Replace the Stakewise operator's hot wallet with a new one derived from the node wallet, moving the old one's ETH to it.",
+				Flags: []cli.Flag{
+					rotateOperatorNoRestartFlag,
+					utils.YesFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return rotateOperator(c)
+				},
+			},
 			{
 				Name:    "generate-keys",
 				Aliases: []string{"g"},
@@ -44,6 +92,25 @@ func RegisterCommands(cmd *cli.Command, name string, aliases []string) {
 					return generateKeys(c)
 				},
 			},
+			{
+				Name:    "recover-keys",
+				Aliases: []string{"r"},
+				Usage:   "Recover the validator keys derived from your node wallet that have already been registered with NodeSet or the Beacon Chain, such as after recovering your node wallet.",
+				Flags: []cli.Flag{
+					recoverKeysStartIndexFlag,
+					recoverKeysGapLimitFlag,
+					recoverKeysNoRestartFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return recoverKeys(c)
+				},
+			},
 		},
 	})
 }
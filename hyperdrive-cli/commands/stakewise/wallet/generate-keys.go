@@ -26,6 +26,7 @@ var (
 )
 
 func generateKeys(c *cli.Context) error {
+	out := utils.GetOutput(c)
 	hd := client.NewHyperdriveClientFromCtx(c)
 	sw := client.NewStakewiseClientFromCtx(c)
 	noRestart := c.Bool(generateKeysNoRestartFlag.Name)
@@ -38,11 +39,11 @@ func generateKeys(c *cli.Context) error {
 	status := response.Data.WalletStatus
 	if !status.Wallet.IsLoaded {
 		if !status.Wallet.IsOnDisk {
-			fmt.Println("Your node wallet has not been initialized yet. Please run `hyperdrive wallet init` first to create it, then run this again.")
+			fmt.Fprintln(out, "Your node wallet has not been initialized yet. Please run `hyperdrive wallet init` first to create it, then run this again.")
 			return nil
 		}
 		if !status.Password.IsPasswordSaved {
-			fmt.Println("Your node wallet has been initialized, but Hyperdrive doesn't have a password loaded for it so it cannot be used. Please run `hyperdrive wallet set-password` to enter it, then run this command again.")
+			fmt.Fprintln(out, "Your node wallet has been initialized, but Hyperdrive doesn't have a password loaded for it so it cannot be used. Please run `hyperdrive wallet set-password` to enter it, then run this command again.")
 			return nil
 		}
 		return nil
@@ -51,15 +52,18 @@ func generateKeys(c *cli.Context) error {
 	// Get the count
 	count := c.Uint64(generateKeysCountFlag.Name)
 	if count == 0 {
-		countString := utils.Prompt("How many keys would you like to generate?", "^\\d+$", "Invalid count, try again")
+		countString, err := utils.Prompt("How many keys would you like to generate?", "^\\d+$", "Invalid count, try again")
+		if err != nil {
+			return err
+		}
 		count, err = input.ValidateUint("count", countString)
 		if err != nil {
 			return fmt.Errorf("invalid count [%s]: %w", countString, err)
 		}
 	}
 
-	fmt.Println("Note: key generation is an expensive process, this may take a long time! Progress will be printed as each key is generated.")
-	fmt.Println()
+	fmt.Fprintln(out, "Note: key generation is an expensive process, this may take a long time! Progress will be printed as each key is generated.")
+	fmt.Fprintln(out)
 
 	// Generate the new keys
 	startTime := time.Now()
@@ -76,42 +80,42 @@ func generateKeys(c *cli.Context) error {
 		elapsed := time.Since(latestTime)
 		latestTime = time.Now()
 		pubkey := response.Data.Pubkeys[0]
-		fmt.Printf("Generated %s (%d/%d) in %s\n", pubkey.HexWithPrefix(), (i + 1), count, elapsed)
+		fmt.Fprintf(out, "Generated %s (%d/%d) in %s\n", pubkey.HexWithPrefix(), (i + 1), count, elapsed)
 	}
-	fmt.Printf("Completed in %s.\n", time.Since(startTime))
-	fmt.Println()
+	fmt.Fprintf(out, "Completed in %s.\n", time.Since(startTime))
+	fmt.Fprintln(out)
 
 	// Restart the Stakewise Operator
 	if noRestart {
-		fmt.Printf("%sYou have automatic restarting turned off.\nPlease restart your Stakewise Operator container at your earliest convenience in order to deposit your new keys once it's your turn. Failure to do so will prevent your validators from ever being activated.%s\n", terminal.ColorYellow, terminal.ColorReset)
+		fmt.Fprintf(out, "%sYou have automatic restarting turned off.\nPlease restart your Stakewise Operator container at your earliest convenience in order to deposit your new keys once it's your turn. Failure to do so will prevent your validators from ever being activated.%s\n", terminal.ColorYellow, terminal.ColorReset)
 	} else {
-		fmt.Print("Restarting Stakewise Operator... ")
+		fmt.Fprint(out, "Restarting Stakewise Operator... ")
 		_, err = hd.Api.Service.RestartContainer(string(swconfig.ContainerID_StakewiseOperator))
 		if err != nil {
-			fmt.Println("error")
-			fmt.Printf("%sWARNING: error restarting stakewise operator: %s%s\n", terminal.ColorRed, err.Error(), terminal.ColorReset)
-			fmt.Println("Please restart your Stakewise Operator container in order to be able to deposit for your new keys,")
+			fmt.Fprintln(out, "error")
+			fmt.Fprintf(out, "%sWARNING: error restarting stakewise operator: %s%s\n", terminal.ColorRed, err.Error(), terminal.ColorReset)
+			fmt.Fprintln(out, "Please restart your Stakewise Operator container in order to be able to deposit for your new keys,")
 		} else {
-			fmt.Println("done!")
+			fmt.Fprintln(out, "done!")
 		}
 	}
-	fmt.Println()
+	fmt.Fprintln(out)
 
 	// Restart the VC
 	if noRestart {
-		fmt.Printf("%sYou have automatic restarting turned off.\nPlease restart your Validator Client at your earliest convenience in order to attest with your new keys. Failure to do so will result in any new validators being offline and *losing ETH* until you restart it.%s\n", terminal.ColorYellow, terminal.ColorReset)
+		fmt.Fprintf(out, "%sYou have automatic restarting turned off.\nPlease restart your Validator Client at your earliest convenience in order to attest with your new keys. Failure to do so will result in any new validators being offline and *losing ETH* until you restart it.%s\n", terminal.ColorYellow, terminal.ColorReset)
 	} else {
-		fmt.Print("Restarting Validator Client... ")
+		fmt.Fprint(out, "Restarting Validator Client... ")
 		_, err = hd.Api.Service.RestartContainer(string(swconfig.ContainerID_StakewiseValidator))
 		if err != nil {
-			fmt.Println("error")
-			fmt.Printf("%sWARNING: error restarting validator client: %s%s\n", terminal.ColorRed, err.Error(), terminal.ColorReset)
-			fmt.Println("Please restart your Validator Client in order to attest with your new keys!")
+			fmt.Fprintln(out, "error")
+			fmt.Fprintf(out, "%sWARNING: error restarting validator client: %s%s\n", terminal.ColorRed, err.Error(), terminal.ColorReset)
+			fmt.Fprintln(out, "Please restart your Validator Client in order to attest with your new keys!")
 		} else {
-			fmt.Println("done!")
+			fmt.Fprintln(out, "done!")
 		}
 	}
-	fmt.Println()
+	fmt.Fprintln(out)
 
 	// Upload to the server
 	err = swcmdutils.UploadDepositData(sw)
@@ -120,10 +124,10 @@ func generateKeys(c *cli.Context) error {
 	}
 
 	if !noRestart {
-		fmt.Println()
-		fmt.Println("Your new keys are now ready for use. When one of them is selected for activation, your system will deposit it and begin attesting automatically.")
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, "Your new keys are now ready for use. When one of them is selected for activation, your system will deposit it and begin attesting automatically.")
 	} else {
-		fmt.Println("Your new keys are uploaded, but you *must* restart your Validator Client at your earliest convenience to begin attesting once they are selected for depositing.")
+		fmt.Fprintln(out, "Your new keys are uploaded, but you *must* restart your Validator Client at your earliest convenience to begin attesting once they are selected for depositing.")
 	}
 
 	return nil
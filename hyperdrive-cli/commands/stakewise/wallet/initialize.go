@@ -4,11 +4,14 @@ import (
 	"fmt"
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 	"github.com/urfave/cli/v2"
 )
 
 func initialize(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get client
 	hd := client.NewHyperdriveClientFromCtx(c)
 	sw := client.NewStakewiseClientFromCtx(c)
@@ -21,11 +24,11 @@ func initialize(c *cli.Context) error {
 	status := response.Data.WalletStatus
 	if !status.Wallet.IsLoaded {
 		if !status.Wallet.IsOnDisk {
-			fmt.Println("Your node wallet has not been initialized yet. Please run `hyperdrive wallet init` first to create it, then run this again.")
+			fmt.Fprintln(out, "Your node wallet has not been initialized yet. Please run `hyperdrive wallet init` first to create it, then run this again.")
 			return nil
 		}
 		if !status.Password.IsPasswordSaved {
-			fmt.Println("Your node wallet has been initialized, but Hyperdrive doesn't have a password loaded for it so it cannot be used. Please run `hyperdrive wallet set-password` to enter it, then run this command again.")
+			fmt.Fprintln(out, "Your node wallet has been initialized, but Hyperdrive doesn't have a password loaded for it so it cannot be used. Please run `hyperdrive wallet set-password` to enter it, then run this command again.")
 			return nil
 		}
 		return nil
@@ -37,6 +40,7 @@ func initialize(c *cli.Context) error {
 		return err
 	}
 
-	fmt.Printf("Your node wallet has been successfully copied to the Stakewise module with address %s%s%s.", terminal.ColorBlue, swResponse.Data.AccountAddress.Hex(), terminal.ColorReset)
+	fmt.Fprintf(out, "The Stakewise operator's hot wallet has been created with address %s%s%s.\n", terminal.ColorBlue, swResponse.Data.AccountAddress.Hex(), terminal.ColorReset)
+	fmt.Fprintln(out, "It's derived from your node wallet but is a separate account, so the operator can't spend your node wallet's funds. Use `hyperdrive stakewise wallet top-up-operator` to send it ETH for its transactions.")
 	return nil
 }
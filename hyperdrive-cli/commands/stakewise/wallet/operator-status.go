@@ -0,0 +1,42 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/eth-utils/eth"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/urfave/cli/v2"
+)
+
+func operatorStatus(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get client
+	sw := client.NewStakewiseClientFromCtx(c)
+
+	// Get the status
+	response, err := sw.Api.Wallet.OperatorStatus()
+	if err != nil {
+		return err
+	}
+	data := response.Data
+
+	if !data.IsInitialized {
+		fmt.Fprintln(out, "The Stakewise operator wallet hasn't been initialized yet. Please run `hyperdrive stakewise wallet init` to create it.")
+		return nil
+	}
+	if data.IsNodeAccount {
+		fmt.Fprintf(out, "%sThe Stakewise operator is still using a copy of your node wallet. Please run `hyperdrive stakewise wallet init` to give it its own hot wallet so a compromised operator container can't access your node wallet's funds.%s\n", terminal.ColorYellow, terminal.ColorReset)
+		return nil
+	}
+
+	fmt.Fprintf(out, "Operator wallet: %s%s%s (account %d)\n", terminal.ColorBlue, data.AccountAddress.Hex(), terminal.ColorReset, data.Account)
+	fmt.Fprintf(out, "Balance:         %.6f ETH\n", eth.WeiToEth(data.Balance))
+	fmt.Fprintf(out, "Minimum balance: %.6f ETH\n", eth.WeiToEth(data.MinBalance))
+	if data.IsBalanceLow {
+		fmt.Fprintf(out, "%sThe operator wallet's balance is low, so it may not be able to pay for its transactions. You can send it ETH from your node wallet with `hyperdrive stakewise wallet top-up-operator`.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	recoverKeysStartIndexFlag *cli.Uint64Flag = &cli.Uint64Flag{
+		Name:    "start-index",
+		Aliases: []string{"s"},
+		Usage:   "The key index to start searching from",
+		Value:   0,
+	}
+	recoverKeysGapLimitFlag *cli.Uint64Flag = &cli.Uint64Flag{
+		Name:    "gap-limit",
+		Aliases: []string{"g"},
+		Usage:   "The number of unused keys in a row to search past the last used key before stopping",
+		Value:   20,
+	}
+	recoverKeysNoRestartFlag *cli.BoolFlag = &cli.BoolFlag{
+		Name:  "no-restart",
+		Usage: fmt.Sprintf("Don't automatically restart the Stakewise Operator or Validator Client containers after recovering keys. %sOnly use this if you know what you're doing and can restart them manually.%s", terminal.ColorRed, terminal.ColorReset),
+	}
+)
+
+func recoverKeys(c *cli.Context) error {
+	out := utils.GetOutput(c)
+	hd := client.NewHyperdriveClientFromCtx(c)
+	sw := client.NewStakewiseClientFromCtx(c)
+	noRestart := c.Bool(recoverKeysNoRestartFlag.Name)
+
+	// Make sure there's a wallet loaded
+	response, err := hd.Api.Wallet.Status()
+	if err != nil {
+		return fmt.Errorf("error checking wallet status: %w", err)
+	}
+	status := response.Data.WalletStatus
+	if !status.Wallet.IsLoaded {
+		if !status.Wallet.IsOnDisk {
+			fmt.Fprintln(out, "Your node wallet has not been initialized yet. Please run `hyperdrive wallet recover` first to restore it, then run this again.")
+			return nil
+		}
+		fmt.Fprintln(out, "Your node wallet has been initialized, but Hyperdrive doesn't have a password loaded for it so it cannot be used. Please run `hyperdrive wallet set-password` to enter it, then run this command again.")
+		return nil
+	}
+
+	// Recover the keys
+	startIndex := c.Uint64(recoverKeysStartIndexFlag.Name)
+	gapLimit := c.Uint64(recoverKeysGapLimitFlag.Name)
+	if gapLimit == 0 {
+		return fmt.Errorf("the gap limit must be greater than 0")
+	}
+	fmt.Fprintf(out, "Searching for validator keys from index %d, stopping after %d unused keys in a row. This may take a while...\n", startIndex, gapLimit)
+	recoverResponse, err := sw.Api.Wallet.RecoverKeys(startIndex, gapLimit, false)
+	if err != nil {
+		return fmt.Errorf("error recovering keys: %w", err)
+	}
+	data := recoverResponse.Data
+	fmt.Fprintf(out, "Searched %d keys.\n\n", data.SearchedCount)
+
+	if len(data.RecoveredKeys) == 0 {
+		fmt.Fprintln(out, "No validator keys were found that have been registered with NodeSet or the Beacon Chain.")
+		return nil
+	}
+	for _, key := range data.RecoveredKeys {
+		fmt.Fprintf(out, "Recovered %s (index %d)", key.Pubkey.HexWithPrefix(), key.Account)
+		if !key.IsRegistered {
+			fmt.Fprintf(out, " %s[not registered with NodeSet]%s", terminal.ColorYellow, terminal.ColorReset)
+		}
+		if !key.IsOnBeacon {
+			fmt.Fprint(out, " [not on Beacon Chain yet]")
+		}
+		fmt.Fprintln(out)
+	}
+	fmt.Fprintf(out, "%sRecovered %d validator key(s).%s New keys will be generated starting at index %d.\n\n", terminal.ColorGreen, len(data.RecoveredKeys), terminal.ColorReset, data.NextAccount)
+
+	// Restart the containers so they load the recovered keys
+	if noRestart {
+		fmt.Fprintf(out, "%sYou have automatic restarting turned off.\nPlease restart your Stakewise Operator and Validator Client containers at your earliest convenience so they load the recovered keys. Failure to do so will result in your validators being offline and *losing ETH* until you restart them.%s\n", terminal.ColorYellow, terminal.ColorReset)
+		return nil
+	}
+	containers := map[string]string{
+		"Stakewise Operator": string(swconfig.ContainerID_StakewiseOperator),
+		"Validator Client":   string(swconfig.ContainerID_StakewiseValidator),
+	}
+	for _, name := range []string{"Stakewise Operator", "Validator Client"} {
+		fmt.Fprintf(out, "Restarting %s... ", name)
+		_, err = hd.Api.Service.RestartContainer(containers[name])
+		if err != nil {
+			fmt.Fprintln(out, "error")
+			fmt.Fprintf(out, "%sWARNING: error restarting %s: %s%s\n", terminal.ColorRed, name, err.Error(), terminal.ColorReset)
+			fmt.Fprintf(out, "Please restart your %s so it loads the recovered keys.\n", name)
+		} else {
+			fmt.Fprintln(out, "done!")
+		}
+	}
+	return nil
+}
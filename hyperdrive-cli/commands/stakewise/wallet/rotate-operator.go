@@ -0,0 +1,55 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	rotateOperatorNoRestartFlag *cli.BoolFlag = &cli.BoolFlag{
+		Name:  "no-restart",
+		Usage: fmt.Sprintf("Don't automatically restart the Stakewise Operator container after rotating its wallet. %sIt will keep using the old wallet until you restart it manually.%s", terminal.ColorRed, terminal.ColorReset),
+	}
+)
+
+func rotateOperator(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get client
+	sw := client.NewStakewiseClientFromCtx(c)
+	noRestart := c.Bool(rotateOperatorNoRestartFlag.Name)
+
+	// Prompt for confirmation
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm("This will replace the Stakewise operator's wallet with a new one derived from your node wallet, and move any ETH in the old one to it. Are you sure you want to rotate the operator wallet?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
+	}
+
+	// Rotate the wallet
+	response, err := sw.Api.Wallet.RotateOperator(!noRestart)
+	if err != nil {
+		return err
+	}
+	data := response.Data
+
+	fmt.Fprintf(out, "The Stakewise operator wallet has been rotated from %s to %s%s%s (account %d).\n", data.OldAddress.Hex(), terminal.ColorBlue, data.NewAddress.Hex(), terminal.ColorReset, data.Account)
+	if data.SweepTxHash != nil {
+		fmt.Fprintf(out, "The old wallet's ETH is being moved to the new one in transaction %s.\n", data.SweepTxHash.Hex())
+	} else {
+		fmt.Fprintln(out, "The old wallet didn't have enough ETH to move, so you may need to top up the new one with `hyperdrive stakewise wallet top-up-operator`.")
+	}
+	if noRestart {
+		fmt.Fprintf(out, "%sYou have automatic restarting turned off.\nPlease restart your Stakewise Operator container so it uses the new wallet.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/eth-utils/eth"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/tx"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/urfave/cli/v2"
+)
+
+func topUpOperator(c *cli.Context, amountString string) error {
+	out := utils.GetOutput(c)
+
+	// Get the clients
+	hd := client.NewHyperdriveClientFromCtx(c)
+	sw := client.NewStakewiseClientFromCtx(c)
+
+	// Get the amount
+	amount, err := input.ValidateEthAmount("amount", amountString)
+	if err != nil {
+		return err
+	}
+	amountWei := eth.EthToWei(amount)
+	if amountWei.Sign() <= 0 {
+		return fmt.Errorf("the amount must be greater than 0")
+	}
+
+	// Build the TX
+	response, err := sw.Api.Wallet.TopUpOperator(amountWei)
+	if err != nil {
+		return err
+	}
+
+	// Run the TX
+	err = tx.HandleTx(c, hd, response.Data.TxInfo,
+		fmt.Sprintf("Are you sure you want to send %.6f ETH from your node wallet to the Stakewise operator wallet?", amount),
+		"topping up the operator wallet",
+		"Sending ETH to the operator wallet...",
+	)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Fprintf(out, "Successfully sent %.6f ETH to the operator wallet.\n", amount)
+	return nil
+}
@@ -0,0 +1,57 @@
+package validator
+
+import (
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/urfave/cli/v2"
+)
+
+// Register commands
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, &cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Manage validators that were created outside of Hyperdrive",
+		Subcommands: []*cli.Command{
+			{
+				Name:    "set-withdrawal-address",
+				Aliases: []string{"w"},
+				Usage:   "Change the 0x00 BLS withdrawal credentials of one or more validators to an execution address. This can only be done once per validator and cannot be undone!",
+				Flags: []cli.Flag{
+					pubkeysFlag,
+					withdrawalAddressFlag,
+					mnemonicFlag,
+					validatorPathFlag,
+					startIndexFlag,
+					searchLimitFlag,
+					offlineOutputFlag,
+					indicesFlag,
+					genesisForkVersionFlag,
+					genesisValidatorsRootFlag,
+					utils.YesFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String(withdrawalAddressFlag.Name) != "" {
+						if _, err := input.ValidateAddress("address", c.String(withdrawalAddressFlag.Name)); err != nil {
+							return err
+						}
+					}
+					if c.String(mnemonicFlag.Name) != "" {
+						if _, err := input.ValidateWalletMnemonic("mnemonic", c.String(mnemonicFlag.Name)); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return setWithdrawalAddress(c)
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,305 @@
+package validator
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/eth-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/wallet"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	changesFilePerms os.FileMode = 0644
+)
+
+var (
+	pubkeysFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "pubkeys",
+		Aliases: []string{"p"},
+		Usage:   "Comma-separated list of pubkeys (including 0x prefix) of the validators to change the withdrawal credentials of",
+	}
+	withdrawalAddressFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "address",
+		Aliases: []string{"a"},
+		Usage:   "The execution address the validators' balances will be withdrawn to",
+	}
+	mnemonicFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "mnemonic",
+		Aliases: []string{"m"},
+		Usage:   "The mnemonic phrase the validators' withdrawal keys were generated from",
+	}
+	validatorPathFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "validator-path",
+		Aliases: []string{"vp"},
+		Usage:   fmt.Sprintf("The derivation path of the validator keys, where %%d is the key index. The withdrawal key path is derived from it by removing the trailing '/0'. Omit this flag for the deposit CLI's default of \"%s\".", types.DepositCliValidatorPath),
+	}
+	startIndexFlag *cli.Uint64Flag = &cli.Uint64Flag{
+		Name:    "start-index",
+		Aliases: []string{"s"},
+		Usage:   "The first key index to search the mnemonic from",
+		Value:   0,
+	}
+	searchLimitFlag *cli.Uint64Flag = &cli.Uint64Flag{
+		Name:    "search-limit",
+		Aliases: []string{"l"},
+		Usage:   "The number of key indices to search the mnemonic for the validators' withdrawal keys",
+		Value:   500,
+	}
+	offlineOutputFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "offline",
+		Aliases: []string{"o"},
+		Usage:   "Don't submit the changes; instead, save the signed BLS-to-execution changes to this file so they can be broadcast later or from another machine. This doesn't use the Beacon Node, so it requires the validator indices and the network's genesis fork version and genesis validators root.",
+	}
+	indicesFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "indices",
+		Aliases: []string{"i"},
+		Usage:   "Comma-separated list of the validators' indices on the Beacon Chain, in the same order as the pubkeys (offline mode only)",
+	}
+	genesisForkVersionFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "genesis-fork-version",
+		Aliases: []string{"gfv"},
+		Usage:   "The network's genesis fork version, such as 0x00000000 for Mainnet (offline mode only)",
+	}
+	genesisValidatorsRootFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "genesis-validators-root",
+		Aliases: []string{"gvr"},
+		Usage:   "The network's genesis validators root (offline mode only)",
+	}
+)
+
+func setWithdrawalAddress(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Get the pubkeys
+	pubkeysString := c.String(pubkeysFlag.Name)
+	if pubkeysString == "" {
+		var err error
+		pubkeysString, err = utils.Prompt("Please enter the pubkeys of the validators to change, separated by commas:", "^.+$", "Please enter at least one pubkey.")
+		if err != nil {
+			return err
+		}
+	}
+	pubkeys, err := input.ValidateBatch("pubkeys", pubkeysString, input.ValidatePubkey)
+	if err != nil {
+		return err
+	}
+
+	// Get the withdrawal address
+	addressString := c.String(withdrawalAddressFlag.Name)
+	if addressString == "" {
+		addressString, err = utils.Prompt("Please enter the execution address to withdraw the validators' balances to:", "^0x[0-9a-fA-F]{40}$", "Invalid address.")
+		if err != nil {
+			return err
+		}
+	}
+	address, err := input.ValidateAddress("address", addressString)
+	if err != nil {
+		return err
+	}
+
+	// Get the mnemonic
+	mnemonic := c.String(mnemonicFlag.Name)
+	if mnemonic == "" {
+		mnemonic, err = wallet.PromptMnemonic(out)
+		if err != nil {
+			return err
+		}
+	}
+	mnemonic = strings.TrimSpace(mnemonic)
+
+	// Get the search settings
+	var validatorPath *string
+	if c.IsSet(validatorPathFlag.Name) {
+		path := c.String(validatorPathFlag.Name)
+		validatorPath = &path
+	}
+	startIndex := c.Uint64(startIndexFlag.Name)
+	searchLimit := c.Uint64(searchLimitFlag.Name)
+
+	// Sign and save the changes without the Beacon Node if running offline
+	outputPath := c.String(offlineOutputFlag.Name)
+	if outputPath != "" {
+		return signWithdrawalChangesOffline(c, hd, outputPath, mnemonic, address, pubkeys, validatorPath, startIndex, searchLimit)
+	}
+
+	// Sign the changes
+	fmt.Fprintf(out, "Searching key indices %d to %d for the withdrawal keys of %d validator(s)...\n", startIndex, startIndex+searchLimit-1, len(pubkeys))
+	response, err := hd.Api.Validator.SetWithdrawalAddress(mnemonic, address, pubkeys, validatorPath, &startIndex, &searchLimit, false)
+	if err != nil {
+		return err
+	}
+	changes := printWithdrawalChanges(out, response.Data.Validators)
+	if len(changes) == 0 {
+		fmt.Fprintln(out, "There are no validators that can have their withdrawal credentials changed.")
+		return nil
+	}
+
+	// Confirm the change
+	fmt.Fprintf(out, "%sWARNING: each validator's withdrawal credentials can only be changed once. Once this change is processed, all of the validator's rewards and its exited balance will be sent to %s permanently.\nMake sure you control this address!%s\n", terminal.ColorYellow, address.Hex(), terminal.ColorReset)
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.ConfirmWithIAgree(fmt.Sprintf("Are you sure you want to set the withdrawal address of %d validator(s) to %s? This action cannot be undone!", len(changes), address.Hex()))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
+	}
+
+	// Submit the changes
+	response, err = hd.Api.Validator.SetWithdrawalAddress(mnemonic, address, pubkeys, validatorPath, &startIndex, &searchLimit, true)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	submitted := 0
+	for _, info := range response.Data.Validators {
+		switch info.Status {
+		case api.WithdrawalChangeStatus_Submitted:
+			submitted++
+		case api.WithdrawalChangeStatus_SubmitFailed:
+			failed++
+			fmt.Fprintf(out, "%sValidator %s was rejected by the Beacon Node: %s%s\n", terminal.ColorRed, info.Pubkey.HexWithPrefix(), info.Error, terminal.ColorReset)
+		}
+	}
+	if submitted > 0 {
+		fmt.Fprintf(out, "%sSubmitted withdrawal credential changes for %d validator(s).%s They will be processed by the Beacon Chain over the next several epochs.\n", terminal.ColorGreen, submitted, terminal.ColorReset)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d withdrawal credential change(s) could not be submitted", failed)
+	}
+	return nil
+}
+
+// Sign the changes with the provided validator indices and genesis values instead of querying the Beacon Node, and save them to a file
+func signWithdrawalChangesOffline(c *cli.Context, hd *client.HyperdriveClient, outputPath string, mnemonic string, address common.Address, pubkeys []beacon.ValidatorPubkey, validatorPath *string, startIndex uint64, searchLimit uint64) error {
+	out := utils.GetOutput(c)
+
+	// Get the validator indices
+	indicesString := c.String(indicesFlag.Name)
+	if indicesString == "" {
+		var err error
+		indicesString, err = utils.Prompt("Please enter the indices of the validators on the Beacon Chain, separated by commas and in the same order as their pubkeys:", "^[0-9, ]+$", "Invalid indices.")
+		if err != nil {
+			return err
+		}
+	}
+	indices, err := input.ValidateBatch("indices", strings.ReplaceAll(indicesString, " ", ""), input.ValidateUint)
+	if err != nil {
+		return err
+	}
+	if len(indices) != len(pubkeys) {
+		return fmt.Errorf("%d validator indices were provided for %d pubkeys; there must be one index per pubkey", len(indices), len(pubkeys))
+	}
+
+	// Get the genesis values
+	forkVersionString := c.String(genesisForkVersionFlag.Name)
+	if forkVersionString == "" {
+		forkVersionString, err = utils.Prompt("Please enter the network's genesis fork version (e.g. 0x00000000 for Mainnet):", "^(0x)?[0-9a-fA-F]{8}$", "Invalid fork version, it must be 4 bytes.")
+		if err != nil {
+			return err
+		}
+	}
+	forkVersion, err := input.ValidateByteArray("genesis fork version", forkVersionString)
+	if err != nil {
+		return err
+	}
+	if len(forkVersion) != 4 {
+		return fmt.Errorf("Invalid genesis fork version '%s': it must be 4 bytes", forkVersionString)
+	}
+	gvrString := c.String(genesisValidatorsRootFlag.Name)
+	if gvrString == "" {
+		gvrString, err = utils.Prompt("Please enter the network's genesis validators root:", "^(0x)?[0-9a-fA-F]{64}$", "Invalid genesis validators root.")
+		if err != nil {
+			return err
+		}
+	}
+	gvr, err := input.ValidateHash("genesis validators root", gvrString)
+	if err != nil {
+		return err
+	}
+
+	// Sign the changes
+	fmt.Fprintf(out, "Searching key indices %d to %d for the keys of %d validator(s)...\n", startIndex, startIndex+searchLimit-1, len(pubkeys))
+	response, err := hd.Api.Validator.SignWithdrawalAddressOffline(mnemonic, address, pubkeys, indices, forkVersion, gvr, validatorPath, &startIndex, &searchLimit)
+	if err != nil {
+		return err
+	}
+	changes := printWithdrawalChanges(out, response.Data.Validators)
+	if len(changes) == 0 {
+		fmt.Fprintln(out, "None of the validators' keys were found in the searched key indices.")
+		return nil
+	}
+	fmt.Fprintf(out, "%sNOTE: the validators' current withdrawal credentials couldn't be checked offline. Any validator that already has an execution withdrawal address will reject its change when it's broadcast.%s\n", terminal.ColorYellow, terminal.ColorReset)
+	return saveWithdrawalChanges(c, outputPath, changes)
+}
+
+// Print the result for each validator, returning the signed changes
+func printWithdrawalChanges(out io.Writer, validators []api.ValidatorWithdrawalChangeInfo) []*types.SignedBlsToExecutionChange {
+	changes := []*types.SignedBlsToExecutionChange{}
+	for _, info := range validators {
+		switch info.Status {
+		case api.WithdrawalChangeStatus_NotFound:
+			fmt.Fprintf(out, "%s: %snot found on the Beacon Chain%s\n", info.Pubkey.HexWithPrefix(), terminal.ColorYellow, terminal.ColorReset)
+		case api.WithdrawalChangeStatus_AlreadySet:
+			fmt.Fprintf(out, "%s (index %s): already has an execution withdrawal address (%s)\n", info.Pubkey.HexWithPrefix(), info.Index, common.BytesToAddress(info.WithdrawalCredentials[12:]).Hex())
+		case api.WithdrawalChangeStatus_KeyNotFound:
+			fmt.Fprintf(out, "%s (index %s): %sits withdrawal key wasn't found in the searched key indices%s\n", info.Pubkey.HexWithPrefix(), info.Index, terminal.ColorYellow, terminal.ColorReset)
+		default:
+			fmt.Fprintf(out, "%s (index %s): %sready to change%s (withdrawal key %d)\n", info.Pubkey.HexWithPrefix(), info.Index, terminal.ColorGreen, terminal.ColorReset, info.KeyIndex)
+			changes = append(changes, info.Change)
+		}
+	}
+	fmt.Fprintln(out)
+	return changes
+}
+
+// Save the signed changes to a file in the deposit CLI's bls_to_execution_change format
+func saveWithdrawalChanges(c *cli.Context, outputPath string, changes []*types.SignedBlsToExecutionChange) error {
+	out := utils.GetOutput(c)
+	outputPath, err := filepath.Abs(outputPath)
+	if err != nil {
+		return fmt.Errorf("error getting absolute path of [%s]: %w", outputPath, err)
+	}
+	_, err = os.Stat(outputPath)
+	if err == nil && !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(fmt.Sprintf("%s already exists. Would you like to overwrite it?", outputPath))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
+	}
+
+	bytes, err := json.MarshalIndent(changes, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing withdrawal credential changes: %w", err)
+	}
+	err = os.WriteFile(outputPath, bytes, changesFilePerms)
+	if err != nil {
+		return fmt.Errorf("error saving withdrawal credential changes to [%s]: %w", outputPath, err)
+	}
+
+	fmt.Fprintf(out, "%sSaved %d signed withdrawal credential change(s) to %s.%s\n", terminal.ColorGreen, len(changes), outputPath, terminal.ColorReset)
+	fmt.Fprintln(out, "They can be broadcast with any Beacon Node's `/eth/v1/beacon/pool/bls_to_execution_changes` endpoint or a block explorer's broadcast tool.")
+	fmt.Fprintf(out, "%sWARNING: each validator's withdrawal credentials can only be changed once. Make sure you control the address in this file before broadcasting it!%s\n", terminal.ColorYellow, terminal.ColorReset)
+	return nil
+}
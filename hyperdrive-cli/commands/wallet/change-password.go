@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	changePasswordKdfFlag *cli.StringFlag = &cli.StringFlag{
+		Name:  "kdf",
+		Usage: "The key derivation function to encrypt the wallet with ('pbkdf2' or 'scrypt'). Leave it blank to keep the current one.",
+	}
+)
+
+func changePassword(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Get & check wallet status
+	statusResponse, err := hd.Api.Wallet.Status()
+	if err != nil {
+		return err
+	}
+	status := statusResponse.Data.WalletStatus
+	if !status.Wallet.IsLoaded {
+		fmt.Fprintln(out, "The node wallet isn't loaded. Please run `hyperdrive wallet set-password` to load it with its current password first.")
+		return nil
+	}
+
+	// Get the passwords
+	oldPassword, err := utils.PromptPassword(
+		"Please enter your wallet's current password:",
+		fmt.Sprintf("^.{%d,}$", input.MinPasswordLength),
+		fmt.Sprintf("Your password must be at least %d characters long. Please try again:", input.MinPasswordLength),
+	)
+	if err != nil {
+		return err
+	}
+	newPassword, err := PromptNewPassword(out)
+	if err != nil {
+		return err
+	}
+	if newPassword == oldPassword {
+		return fmt.Errorf("the new password must be different from the current one")
+	}
+
+	// Run it
+	_, err = hd.Api.Wallet.ChangePassword(oldPassword, newPassword, c.String(changePasswordKdfFlag.Name))
+	if err != nil {
+		return fmt.Errorf("error changing password: %w", err)
+	}
+	fmt.Fprintln(out, "Your node wallet has been re-encrypted with the new password.")
+	if status.Password.IsPasswordSaved {
+		fmt.Fprintln(out, "The password saved on disk has been updated too.")
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/nodeset-org/hyperdrive/shared/utils/slip39"
 	"github.com/urfave/cli/v2"
 )
 
@@ -62,10 +63,11 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 			{
 				Name:    "recover",
 				Aliases: []string{"r"},
-				Usage:   "Recover a node wallet from a mnemonic phrase",
+				Usage:   "Recover a node wallet from a mnemonic phrase, or from SLIP-39 shares with --shares",
 				Flags: []cli.Flag{
 					PasswordFlag,
 					mnemonicFlag,
+					sharesFlag,
 					skipValidatorRecoveryFlag,
 					derivationPathFlag,
 					walletIndexFlag,
@@ -88,6 +90,14 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 							return err
 						}
 					}
+					if c.Bool(sharesFlag.Name) {
+						if c.String(mnemonicFlag.Name) != "" {
+							return fmt.Errorf("the --%s and --%s flags can't be used together", mnemonicFlag.Name, sharesFlag.Name)
+						}
+						if c.String(addressFlag.Name) != "" {
+							return fmt.Errorf("searching for a wallet by address isn't supported when recovering from shares")
+						}
+					}
 
 					// Run
 					return recoverWallet(c)
@@ -100,6 +110,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Usage:   "Test recovering a node wallet without actually generating any of the node wallet or validator key files to ensure the process works as expected",
 				Flags: []cli.Flag{
 					mnemonicFlag,
+					sharesFlag,
 					skipValidatorRecoveryFlag,
 					derivationPathFlag,
 					walletIndexFlag,
@@ -117,6 +128,14 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 							return err
 						}
 					}
+					if c.Bool(sharesFlag.Name) {
+						if c.String(mnemonicFlag.Name) != "" {
+							return fmt.Errorf("the --%s and --%s flags can't be used together", mnemonicFlag.Name, sharesFlag.Name)
+						}
+						if c.String(addressFlag.Name) != "" {
+							return fmt.Errorf("searching for a wallet by address isn't supported when recovering from shares")
+						}
+					}
 
 					// Run
 					return testRecovery(c)
@@ -142,6 +161,29 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:    "change-password",
+				Aliases: []string{"cp"},
+				Usage:   "Change the node wallet password, re-encrypting the wallet keystore with it",
+				Flags: []cli.Flag{
+					changePasswordKdfFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					if c.String(changePasswordKdfFlag.Name) != "" {
+						if _, err := input.ValidateKdf("kdf", c.String(changePasswordKdfFlag.Name)); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return changePassword(c)
+				},
+			},
+
 			{
 				Name:    "delete-password",
 				Aliases: []string{"dp"},
@@ -190,6 +232,36 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:    "export-shares",
+				Aliases: []string{"es"},
+				Usage:   "Split the node wallet into SLIP-39 share mnemonics, a threshold of which can be combined to recover it, so its backup can be distributed across several people",
+				Flags: []cli.Flag{
+					exportSharesThresholdFlag,
+					exportSharesCountFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+					threshold := c.Uint64(exportSharesThresholdFlag.Name)
+					count := c.Uint64(exportSharesCountFlag.Name)
+					if count < 1 || count > uint64(slip39.MaxShareCount) {
+						return fmt.Errorf("the number of shares must be between 1 and %d", slip39.MaxShareCount)
+					}
+					if threshold < 1 || threshold > count {
+						return fmt.Errorf("the threshold must be between 1 and the number of shares (%d)", count)
+					}
+					if threshold == 1 && count > 1 {
+						return fmt.Errorf("a threshold of 1 would make every share a full copy of the wallet; use a threshold of at least 2, or create a single share")
+					}
+
+					// Run
+					return exportShares(c)
+				},
+			},
+
 			{
 				Name:      "set-ens-name",
 				Aliases:   []string{"ens"},
@@ -233,6 +305,24 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:  "sign-typed-data",
+				Usage: "Sign EIP-712 typed data with the node's private key",
+				Flags: []cli.Flag{
+					signTypedDataFileFlag,
+					utils.YesFlag,
+				},
+				Action: func(c *cli.Context) error {
+					// Validate args
+					if err := input.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return signTypedData(c)
+				},
+			},
+
 			{
 				Name:      "send-message",
 				Usage:     "Send a zero-ETH transaction to the target address (or ENS) with the provided hex-encoded message as the data payload",
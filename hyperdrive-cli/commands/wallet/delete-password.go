@@ -9,6 +9,8 @@ import (
 )
 
 func deletePassword(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -21,13 +23,19 @@ func deletePassword(c *cli.Context) error {
 
 	// Check if it's already set
 	if !status.Password.IsPasswordSaved {
-		fmt.Println("The node wallet password is not saved to disk.")
+		fmt.Fprintln(out, "The node wallet password is not saved to disk.")
 		return nil
 	}
 
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm("Are you sure you want to delete your password from disk? Your node will not be able to submit transactions after a restart until you manually enter the password")) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm("Are you sure you want to delete your password from disk? Your node will not be able to submit transactions after a restart until you manually enter the password")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Run it
@@ -37,6 +45,6 @@ func deletePassword(c *cli.Context) error {
 	}
 
 	// Log & return
-	fmt.Println("The password has been successfully removed from disk storage.")
+	fmt.Fprintln(out, "The password has been successfully removed from disk storage.")
 	return nil
 }
@@ -4,11 +4,14 @@ import (
 	"fmt"
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/shared/types"
 	"github.com/urfave/cli/v2"
 )
 
 func exportEthKey(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -18,11 +21,11 @@ func exportEthKey(c *cli.Context) error {
 		return err
 	}
 	if !status.Data.WalletStatus.Wallet.IsLoaded {
-		fmt.Println("The node wallet is not loaded and ready for usage. Please run `hyperdrive wallet status` for more details.")
+		fmt.Fprintln(out, "The node wallet is not loaded and ready for usage. Please run `hyperdrive wallet status` for more details.")
 		return nil
 	}
 	if status.Data.WalletStatus.Wallet.Type != types.WalletType_Local {
-		fmt.Println("This command can only be run on local wallets; hardware wallets cannot have their keys exported.")
+		fmt.Fprintln(out, "This command can only be run on local wallets; hardware wallets cannot have their keys exported.")
 		return nil
 	}
 
@@ -33,7 +36,7 @@ func exportEthKey(c *cli.Context) error {
 	}
 
 	// Print wallet & return
-	fmt.Println("Wallet in ETH Key Format:")
-	fmt.Println(string(ethKey.Data.EthKeyJson))
+	fmt.Fprintln(out, "Wallet in ETH Key Format:")
+	fmt.Fprintln(out, string(ethKey.Data.EthKeyJson))
 	return nil
 }
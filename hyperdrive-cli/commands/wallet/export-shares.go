@@ -0,0 +1,80 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	exportSharesThresholdFlag *cli.Uint64Flag = &cli.Uint64Flag{
+		Name:     "threshold",
+		Aliases:  []string{"t"},
+		Usage:    "The number of shares required to recover the wallet",
+		Required: true,
+	}
+	exportSharesCountFlag *cli.Uint64Flag = &cli.Uint64Flag{
+		Name:     "shares",
+		Aliases:  []string{"n"},
+		Usage:    "The total number of shares to create",
+		Required: true,
+	}
+)
+
+func exportShares(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+	threshold := c.Uint64(exportSharesThresholdFlag.Name)
+	count := c.Uint64(exportSharesCountFlag.Name)
+
+	// Get & check wallet status
+	status, err := hd.Api.Wallet.Status()
+	if err != nil {
+		return err
+	}
+	if !status.Data.WalletStatus.Wallet.IsLoaded {
+		fmt.Fprintln(out, "The node wallet is not loaded and ready for usage. Please run `hyperdrive wallet status` for more details.")
+		return nil
+	}
+	if status.Data.WalletStatus.Wallet.Type != types.WalletType_Local {
+		fmt.Fprintln(out, "This command can only be run on local wallets; hardware wallets cannot have their keys exported.")
+		return nil
+	}
+
+	// Explain what's about to happen
+	fmt.Fprintf(out, "This will split your node wallet into %d SLIP-39 shares. Any %d of them can be combined with `hyperdrive wallet recover --shares` to recover the wallet, but fewer than that reveal nothing about it.\n", count, threshold)
+	fmt.Fprintf(out, "%sAnyone who collects %d shares will have full control of your node wallet. Give each share to a different person, store them separately, and never keep them together on this machine.%s\n\n", terminal.ColorYellow, threshold, terminal.ColorReset)
+
+	// Get the password as confirmation
+	password, err := utils.PromptPassword(
+		"Please enter your wallet's password to confirm:",
+		fmt.Sprintf("^.{%d,}$", input.MinPasswordLength),
+		fmt.Sprintf("Your password must be at least %d characters long. Please try again:", input.MinPasswordLength),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Create the shares
+	response, err := hd.Api.Wallet.ExportShares(password, threshold, count)
+	if err != nil {
+		return fmt.Errorf("error exporting shares: %w", err)
+	}
+
+	// Print the shares
+	fmt.Fprintln(out)
+	for i, share := range response.Data.Shares {
+		fmt.Fprintf(out, "%sShare %d of %d:%s\n", terminal.ColorBold, i+1, len(response.Data.Shares), terminal.ColorReset)
+		fmt.Fprintln(out, share)
+		fmt.Fprintln(out)
+	}
+	fmt.Fprintf(out, "You can check that the shares work without touching your wallet by running `hyperdrive wallet test-recovery --shares` with any %d of them.\n", threshold)
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 )
 
 func exportWallet(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -19,7 +21,7 @@ func exportWallet(c *cli.Context) error {
 		return err
 	}
 	if !status.Data.WalletStatus.Wallet.IsLoaded {
-		fmt.Println("The node wallet is not loaded and ready for usage. Please run `hyperdrive wallet status` for more details.")
+		fmt.Fprintln(out, "The node wallet is not loaded and ready for usage. Please run `hyperdrive wallet status` for more details.")
 		return nil
 	}
 
@@ -32,9 +34,14 @@ func exportWallet(c *cli.Context) error {
 			os.Exit(1)
 		}
 
-		if (stat.Mode()&os.ModeCharDevice) == os.ModeCharDevice &&
-			!utils.ConfirmSecureSession("Exporting a wallet will print sensitive information to your screen.") {
-			return nil
+		if (stat.Mode() & os.ModeCharDevice) == os.ModeCharDevice {
+			confirmed, err := utils.ConfirmSecureSession("Exporting a wallet will print sensitive information to your screen.")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return nil
+			}
 		}
 	}
 
@@ -45,19 +52,19 @@ func exportWallet(c *cli.Context) error {
 	}
 
 	// Print wallet & return
-	fmt.Println("Node account private key:")
-	fmt.Println("")
-	fmt.Println(export.Data.AccountPrivateKey)
-	fmt.Println("")
-	fmt.Println("Wallet password:")
-	fmt.Println("")
-	fmt.Println(export.Data.Password)
-	fmt.Println("")
-	fmt.Println("Wallet file:")
-	fmt.Println("============")
-	fmt.Println("")
-	fmt.Println(export.Data.Wallet)
-	fmt.Println("")
-	fmt.Println("============")
+	fmt.Fprintln(out, "Node account private key:")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, export.Data.AccountPrivateKey)
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "Wallet password:")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, export.Data.Password)
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "Wallet file:")
+	fmt.Fprintln(out, "============")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, export.Data.Wallet)
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "============")
 	return nil
 }
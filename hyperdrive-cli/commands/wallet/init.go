@@ -20,6 +20,7 @@ var (
 
 // If hd is provided, this is assumed to be called from another function so the wallet check will be skipped.
 func InitWallet(c *cli.Context, hd *client.HyperdriveClient) error {
+	out := utils.GetOutput(c)
 	if hd == nil {
 		// Get Hyperdrive client
 		hd = client.NewHyperdriveClientFromCtx(c)
@@ -31,7 +32,7 @@ func InitWallet(c *cli.Context, hd *client.HyperdriveClient) error {
 		}
 		status := statusResponse.Data.WalletStatus
 		if status.Wallet.IsOnDisk {
-			fmt.Println("The node wallet is already initialized.")
+			fmt.Fprintln(out, "The node wallet is already initialized.")
 			return nil
 		}
 	}
@@ -43,9 +44,14 @@ func InitWallet(c *cli.Context, hd *client.HyperdriveClient) error {
 	}
 
 	// Prompt for user confirmation before printing sensitive information
-	if !(hd.Context.SecureSession ||
-		utils.ConfirmSecureSession("Creating a wallet will print sensitive information to your screen.")) {
-		return nil
+	if !hd.Context.SecureSession {
+		confirmed, err := utils.ConfirmSecureSession("Creating a wallet will print sensitive information to your screen.")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
 	}
 
 	// Set password if not set
@@ -53,17 +59,23 @@ func InitWallet(c *cli.Context, hd *client.HyperdriveClient) error {
 	if c.String(PasswordFlag.Name) != "" {
 		password = c.String(PasswordFlag.Name)
 	} else {
-		password = PromptNewPassword()
+		password, err = PromptNewPassword(out)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Ask about saving
-	savePassword := utils.Confirm("Would you like to save the password to disk? If you do, your node will be able to handle transactions automatically after a client restart; otherwise, you will have to manually enter the password after each restart with `hyperdrive wallet set-password`.")
+	savePassword, err := utils.Confirm("Would you like to save the password to disk? If you do, your node will be able to handle transactions automatically after a client restart; otherwise, you will have to manually enter the password after each restart with `hyperdrive wallet set-password`.")
+	if err != nil {
+		return err
+	}
 
 	// Get the derivation path
 	derivationPathString := c.String(derivationPathFlag.Name)
 	var derivationPath *string
 	if derivationPathString != "" {
-		fmt.Printf("Using a custom derivation path (%s).\n\n", derivationPathString)
+		fmt.Fprintf(out, "Using a custom derivation path (%s).\n\n", derivationPathString)
 		derivationPath = &derivationPathString
 	}
 
@@ -71,7 +83,7 @@ func InitWallet(c *cli.Context, hd *client.HyperdriveClient) error {
 	walletIndexVal := c.Uint64(walletIndexFlag.Name)
 	var walletIndex *uint64
 	if walletIndexVal != 0 {
-		fmt.Printf("Using a custom wallet index (%d).\n", walletIndex)
+		fmt.Fprintf(out, "Using a custom wallet index (%d).\n", walletIndex)
 		walletIndex = &walletIndexVal
 	}
 
@@ -82,18 +94,21 @@ func InitWallet(c *cli.Context, hd *client.HyperdriveClient) error {
 	}
 
 	// Print mnemonic
-	fmt.Println("Your mnemonic phrase to recover your wallet is printed below. It can be used to recover your node account and validator keys if they are lost.")
-	fmt.Println("Record this phrase somewhere secure and private. Do not share it with anyone as it will give them control of your node account and validators.")
-	fmt.Println("==============================================================================================================================================")
-	fmt.Println("")
-	fmt.Println(response.Data.Mnemonic)
-	fmt.Println("")
-	fmt.Println("==============================================================================================================================================")
-	fmt.Println("")
+	fmt.Fprintln(out, "Your mnemonic phrase to recover your wallet is printed below. It can be used to recover your node account and validator keys if they are lost.")
+	fmt.Fprintln(out, "Record this phrase somewhere secure and private. Do not share it with anyone as it will give them control of your node account and validators.")
+	fmt.Fprintln(out, "==============================================================================================================================================")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, response.Data.Mnemonic)
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "==============================================================================================================================================")
+	fmt.Fprintln(out, "")
 
 	// Confirm mnemonic
 	if !c.Bool(initConfirmMnemonicFlag.Name) {
-		confirmMnemonic(response.Data.Mnemonic)
+		err = confirmMnemonic(out, response.Data.Mnemonic)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Do a recover to verify and save the wallet
@@ -110,19 +125,19 @@ func InitWallet(c *cli.Context, hd *client.HyperdriveClient) error {
 	// Clear terminal output
 	_ = term.Clear()
 
-	fmt.Println("The node wallet was successfully initialized.")
-	fmt.Printf("Node account: %s%s%s\n", terminal.ColorBlue, response.Data.AccountAddress.Hex(), terminal.ColorReset)
+	fmt.Fprintln(out, "The node wallet was successfully initialized.")
+	fmt.Fprintf(out, "Node account: %s%s%s\n", terminal.ColorBlue, response.Data.AccountAddress.Hex(), terminal.ColorReset)
 
 	// Initialize the Stakewise wallet if it's enabled
 	if cfg.Stakewise.Enabled.Value {
-		fmt.Println()
-		fmt.Println("You have the Stakewise module enabled. Initializing it with your new wallet...")
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, "You have the Stakewise module enabled. Initializing it with your new wallet...")
 		sw := client.NewStakewiseClientFromCtx(c)
 		_, err = sw.Api.Wallet.Initialize()
 		if err != nil {
 			return fmt.Errorf("error initializing Stakewise wallet: %w", err)
 		}
-		fmt.Println("Stakewise wallet initialized.")
+		fmt.Fprintln(out, "Stakewise wallet initialized.")
 	}
 	return nil
 }
@@ -19,19 +19,24 @@ var (
 )
 
 func masquerade(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd, err := client.NewHyperdriveClientFromCtx(c).WithReady()
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Masquerading allows you to set your node address to any address you want. Your daemon will \"pretend\" to be that node, and all commands will act as though your node wallet is for that address. Since you don't have the private key for that address, you can't submit transactions or sign messages though; your node will be in %sread-only mode%s until you end the masquerade with `hyperdrive wallet restore-address`.\n\n", terminal.ColorYellow, terminal.ColorReset)
+	fmt.Fprintf(out, "Masquerading allows you to set your node address to any address you want. Your daemon will \"pretend\" to be that node, and all commands will act as though your node wallet is for that address. Since you don't have the private key for that address, you can't submit transactions or sign messages though; your node will be in %sread-only mode%s until you end the masquerade with `hyperdrive wallet restore-address`.\n\n", terminal.ColorYellow, terminal.ColorReset)
 
 	// Get the address
 	addressString := c.String(masqueradeAddressFlag.Name)
 	if addressString != "" {
 	} else {
-		addressString = utils.Prompt("Please enter the address you want to masquerade as:", "^0x[0-9a-fA-F]{40}$", "Invalid address")
+		addressString, err = utils.Prompt("Please enter the address you want to masquerade as:", "^0x[0-9a-fA-F]{40}$", "Invalid address")
+		if err != nil {
+			return err
+		}
 	}
 	address, err := input.ValidateAddress("address", addressString)
 	if err != nil {
@@ -39,9 +44,15 @@ func masquerade(c *cli.Context) error {
 	}
 
 	// Confirm
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm(fmt.Sprintf("Are you sure you want to masquerade as %s%s%s?", terminal.ColorBlue, addressString, terminal.ColorReset))) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(fmt.Sprintf("Are you sure you want to masquerade as %s%s%s?", terminal.ColorBlue, addressString, terminal.ColorReset))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Run it
@@ -50,6 +61,6 @@ func masquerade(c *cli.Context) error {
 		return fmt.Errorf("error running masquerade: %w", err)
 	}
 
-	fmt.Printf("Your node is now masquerading as address %s%s%s.\n\n", terminal.ColorBlue, addressString, terminal.ColorReset)
+	fmt.Fprintf(out, "Your node is now masquerading as address %s%s%s.\n\n", terminal.ColorBlue, addressString, terminal.ColorReset)
 	return nil
 }
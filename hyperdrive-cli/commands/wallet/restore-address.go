@@ -10,6 +10,8 @@ import (
 )
 
 func restoreAddress(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd, err := client.NewHyperdriveClientFromCtx(c).WithReady()
 	if err != nil {
@@ -24,20 +26,26 @@ func restoreAddress(c *cli.Context) error {
 	status := response.Data.WalletStatus
 
 	if !status.Wallet.IsLoaded {
-		fmt.Println("You do not currently have a node wallet loaded, so there is no address to restore. Please see `hyperdrive wallet status` for more details.")
+		fmt.Fprintln(out, "You do not currently have a node wallet loaded, so there is no address to restore. Please see `hyperdrive wallet status` for more details.")
 		return nil
 	}
 	if status.Wallet.WalletAddress == status.Address.NodeAddress {
-		fmt.Println("Your node address is set to your wallet address; you are not currently masquerading.")
+		fmt.Fprintln(out, "Your node address is set to your wallet address; you are not currently masquerading.")
 		return nil
 	}
 
-	fmt.Printf("Your node wallet is %s%s%s. If you restore it, you will no longer be masquerading as %s%s%s.\n\n", terminal.ColorBlue, status.Wallet.WalletAddress.Hex(), terminal.ColorReset, terminal.ColorBlue, status.Address.NodeAddress, terminal.ColorReset)
+	fmt.Fprintf(out, "Your node wallet is %s%s%s. If you restore it, you will no longer be masquerading as %s%s%s.\n\n", terminal.ColorBlue, status.Wallet.WalletAddress.Hex(), terminal.ColorReset, terminal.ColorBlue, status.Address.NodeAddress, terminal.ColorReset)
 
 	// Confirm
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm("Are you sure you want to end your masquerade and restore your node address to your wallet address?")) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm("Are you sure you want to end your masquerade and restore your node address to your wallet address?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Run it
@@ -46,6 +54,6 @@ func restoreAddress(c *cli.Context) error {
 		return fmt.Errorf("error restoring address: %w", err)
 	}
 
-	fmt.Printf("Your node address has been reset to your wallet address.")
+	fmt.Fprintf(out, "Your node address has been reset to your wallet address.")
 	return nil
 }
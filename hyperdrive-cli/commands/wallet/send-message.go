@@ -6,12 +6,15 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/tx"
 	"github.com/nodeset-org/hyperdrive/shared/utils/input"
 	"github.com/urfave/cli/v2"
 )
 
 func sendMessage(c *cli.Context, toAddressOrEns string, message []byte) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd, err := client.NewHyperdriveClientFromCtx(c).WithReady()
 	if err != nil {
@@ -53,6 +56,6 @@ func sendMessage(c *cli.Context, toAddressOrEns string, message []byte) error {
 	}
 
 	// Log & return
-	fmt.Printf("Successfully sent message to %s.\n", toAddressString)
+	fmt.Fprintf(out, "Successfully sent message to %s.\n", toAddressString)
 	return nil
 }
@@ -4,19 +4,22 @@ import (
 	"fmt"
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/tx"
 	"github.com/urfave/cli/v2"
 )
 
 func setEnsName(c *cli.Context, name string) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd, err := client.NewHyperdriveClientFromCtx(c).WithReady()
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("This will confirm the node's ENS name as '%s'.\n\n%sNOTE: to confirm your name, you must first register it with the ENS application at https://app.ens.domains.\nWe recommend using a hardware wallet as the base domain, and registering your node as a subdomain of it.%s\n\n", name, terminal.ColorYellow, terminal.ColorReset)
+	fmt.Fprintf(out, "This will confirm the node's ENS name as '%s'.\n\n%sNOTE: to confirm your name, you must first register it with the ENS application at https://app.ens.domains.\nWe recommend using a hardware wallet as the base domain, and registering your node as a subdomain of it.%s\n\n", name, terminal.ColorYellow, terminal.ColorReset)
 
 	// Build the TX
 	response, err := hd.Api.Wallet.SetEnsName(name)
@@ -34,6 +37,6 @@ func setEnsName(c *cli.Context, name string) error {
 		return err
 	}
 
-	fmt.Printf("The ENS name associated with your node account is now '%s'.\n\n", name)
+	fmt.Fprintf(out, "The ENS name associated with your node account is now '%s'.\n\n", name)
 	return nil
 }
@@ -10,6 +10,8 @@ import (
 )
 
 func setPassword(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -23,19 +25,22 @@ func setPassword(c *cli.Context) error {
 	// Check if it's already set properly and the wallet has been loaded
 	if status.Wallet.IsLoaded {
 		if status.Password.IsPasswordSaved {
-			fmt.Println("The node wallet password is already loaded and saved to disk.")
+			fmt.Fprintln(out, "The node wallet password is already loaded and saved to disk.")
 			return nil
 		}
-		fmt.Println("The node wallet is loaded, but the password is not saved to disk.")
+		fmt.Fprintln(out, "The node wallet is loaded, but the password is not saved to disk.")
 	}
 
 	// Get the password
 	passwordString := c.String(PasswordFlag.Name)
 	if passwordString == "" {
 		if status.Wallet.IsOnDisk {
-			passwordString = PromptExistingPassword()
+			passwordString, err = PromptExistingPassword()
 		} else {
-			passwordString = PromptNewPassword()
+			passwordString, err = PromptNewPassword(out)
+		}
+		if err != nil {
+			return err
 		}
 	}
 	password, err := input.ValidateNodePassword("password", passwordString)
@@ -44,10 +49,16 @@ func setPassword(c *cli.Context) error {
 	}
 
 	// Get the save flag
-	savePassword := c.Bool(SavePasswordFlag.Name) || utils.Confirm("Would you like to save the password to disk? If you do, your node will be able to handle transactions automatically after a client restart; otherwise, you will have to repeat this command to manually enter the password after each restart.")
+	savePassword := c.Bool(SavePasswordFlag.Name)
+	if !savePassword {
+		savePassword, err = utils.Confirm("Would you like to save the password to disk? If you do, your node will be able to handle transactions automatically after a client restart; otherwise, you will have to repeat this command to manually enter the password after each restart.")
+		if err != nil {
+			return err
+		}
+	}
 
 	if status.Wallet.IsLoaded && !status.Password.IsPasswordSaved && !savePassword {
-		fmt.Println("You've elected not to save the password but the node wallet is already loaded, so there's nothing to do.")
+		fmt.Fprintln(out, "You've elected not to save the password but the node wallet is already loaded, so there's nothing to do.")
 		return nil
 	}
 
@@ -59,9 +70,9 @@ func setPassword(c *cli.Context) error {
 
 	// Log & return
 	if status.Wallet.IsLoaded {
-		fmt.Println("The password has been successfully saved.")
+		fmt.Fprintln(out, "The password has been successfully saved.")
 	} else {
-		fmt.Println("The password has been successfully uploaded to the daemon and the node wallet has been loaded.")
+		fmt.Fprintln(out, "The password has been successfully uploaded to the daemon and the node wallet has been loaded.")
 	}
 	return nil
 }
@@ -32,6 +32,8 @@ var (
 )
 
 func signMessage(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -41,14 +43,17 @@ func signMessage(c *cli.Context) error {
 		return err
 	}
 	if !sharedutils.IsWalletReady(status.Data.WalletStatus) {
-		fmt.Println("The node wallet is not loaded or your node is in read-only mode. Please run `hyperdrive wallet status` for more details.")
+		fmt.Fprintln(out, "The node wallet is not loaded or your node is in read-only mode. Please run `hyperdrive wallet status` for more details.")
 		return nil
 	}
 
 	// Get the message
 	message := c.String(signMessageFlag.Name)
 	for message == "" {
-		message = utils.Prompt("Please enter the message you want to sign: (EIP-191 personal_sign)", "^.+$", "Please enter the message you want to sign: (EIP-191 personal_sign)")
+		message, err = utils.Prompt("Please enter the message you want to sign: (EIP-191 personal_sign)", "^.+$", "Please enter the message you want to sign: (EIP-191 personal_sign)")
+		if err != nil {
+			return err
+		}
 	}
 
 	// Build the TX
@@ -69,6 +74,6 @@ func signMessage(c *cli.Context) error {
 		return err
 	}
 
-	fmt.Printf("Signed Message:\n\n%s\n", string(bytes))
+	fmt.Fprintf(out, "Signed Message:\n\n%s\n", string(bytes))
 	return nil
 }
@@ -0,0 +1,126 @@
+package wallet
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/goccy/go-json"
+	commonutils "github.com/nodeset-org/eth-utils/common"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	sharedutils "github.com/nodeset-org/hyperdrive/shared/utils"
+	"github.com/urfave/cli/v2"
+)
+
+type TypedDataSignature struct {
+	Address   common.Address `json:"address"`
+	Hash      common.Hash    `json:"hash"`
+	Signature string         `json:"sig"`
+}
+
+var (
+	signTypedDataFileFlag *cli.StringFlag = &cli.StringFlag{
+		Name:    "file",
+		Aliases: []string{"f"},
+		Usage:   "The path of a JSON file containing the EIP-712 typed data to sign (in the eth_signTypedData_v4 format)",
+	}
+)
+
+func signTypedData(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
+	// Get Hyperdrive client
+	hd := client.NewHyperdriveClientFromCtx(c)
+
+	// Get & check wallet status
+	status, err := hd.Api.Wallet.Status()
+	if err != nil {
+		return err
+	}
+	if !sharedutils.IsWalletReady(status.Data.WalletStatus) {
+		fmt.Fprintln(out, "The node wallet is not loaded or your node is in read-only mode. Please run `hyperdrive wallet status` for more details.")
+		return nil
+	}
+
+	// Load the typed data
+	path := c.String(signTypedDataFileFlag.Name)
+	for path == "" {
+		path, err = utils.Prompt("Please enter the path of the JSON file with the typed data you want to sign: (EIP-712)", "^.+$", "Please enter the path of the JSON file with the typed data you want to sign: (EIP-712)")
+		if err != nil {
+			return err
+		}
+	}
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading typed data file: %w", err)
+	}
+	var typedData apitypes.TypedData
+	err = json.Unmarshal(bytes, &typedData)
+	if err != nil {
+		return fmt.Errorf("error parsing typed data: %w", err)
+	}
+
+	// Show what's being signed
+	preview, err := typedData.Format()
+	if err != nil {
+		return fmt.Errorf("error formatting typed data: %w", err)
+	}
+	fmt.Fprintln(out, "You are about to sign the following typed data:")
+	fmt.Fprintln(out)
+	for _, field := range preview {
+		printTypedDataField(out, field, 1)
+	}
+	fmt.Fprintln(out)
+	if typedData.Domain.ChainId != nil {
+		fmt.Fprintf(out, "Chain ID: %s\n\n", (*big.Int)(typedData.Domain.ChainId).String())
+	}
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm("Are you sure you want to sign this data with your node wallet?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
+	}
+
+	// Sign it
+	response, err := hd.Api.Wallet.SignTypedData(typedData)
+	if err != nil {
+		return err
+	}
+
+	// Print the signature
+	formattedSignature := TypedDataSignature{
+		Address:   status.Data.WalletStatus.Wallet.WalletAddress,
+		Hash:      response.Data.Hash,
+		Signature: commonutils.EncodeHexWithPrefix(response.Data.Signature),
+	}
+	bytes, err = json.MarshalIndent(formattedSignature, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Signed Typed Data:\n\n%s\n", string(bytes))
+	return nil
+}
+
+// Print a field of formatted typed data and its children, indented by depth
+func printTypedDataField(out io.Writer, field *apitypes.NameValueType, depth int) {
+	indent := strings.Repeat("  ", depth)
+	children, isStruct := field.Value.([]*apitypes.NameValueType)
+	if isStruct {
+		fmt.Fprintf(out, "%s%s (%s):\n", indent, field.Name, field.Typ)
+		for _, child := range children {
+			printTypedDataField(out, child, depth+1)
+		}
+		return
+	}
+	fmt.Fprintf(out, "%s%s (%s): %v\n", indent, field.Name, field.Typ, field.Value)
+}
@@ -10,6 +10,8 @@ import (
 )
 
 func getStatus(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
@@ -20,7 +22,7 @@ func getStatus(c *cli.Context) error {
 	}
 
 	// Print what network we're on
-	err = utils.PrintNetwork(cfg.Hyperdrive.Network.Value, isNew)
+	err = utils.PrintNetwork(out, cfg.Hyperdrive.Network.Value, isNew)
 	if err != nil {
 		return err
 	}
@@ -34,40 +36,40 @@ func getStatus(c *cli.Context) error {
 	// Print status & return
 	status := response.Data.WalletStatus
 	if !status.Address.HasAddress {
-		fmt.Println("The node wallet has not been initialized with an address yet.")
+		fmt.Fprintln(out, "The node wallet has not been initialized with an address yet.")
 		return nil
 	}
 	if !status.Wallet.IsLoaded {
 		if !status.Wallet.IsOnDisk {
-			fmt.Println("The node wallet has not been initialized yet.")
-			fmt.Printf("Your node is currently masquerading as %s%s%s.\n", terminal.ColorBlue, status.Address.NodeAddress.Hex(), terminal.ColorReset)
-			fmt.Printf("%sIt is running in 'read-only' mode and cannot transact, as does not have that node's private wallet key.%s\n", terminal.ColorYellow, terminal.ColorReset)
+			fmt.Fprintln(out, "The node wallet has not been initialized yet.")
+			fmt.Fprintf(out, "Your node is currently masquerading as %s%s%s.\n", terminal.ColorBlue, status.Address.NodeAddress.Hex(), terminal.ColorReset)
+			fmt.Fprintf(out, "%sIt is running in 'read-only' mode and cannot transact, as does not have that node's private wallet key.%s\n", terminal.ColorYellow, terminal.ColorReset)
 			return nil
 		}
 		if !status.Password.IsPasswordSaved {
-			fmt.Println("The node wallet has been initialized, but Hyperdrive doesn't have a password loaded for your node wallet so it cannot be used.")
-			fmt.Printf("Your node is currently running as %s%s%s in %s'read-only' mode%s.\n", terminal.ColorBlue, status.Address.NodeAddress.Hex(), terminal.ColorReset, terminal.ColorYellow, terminal.ColorReset)
+			fmt.Fprintln(out, "The node wallet has been initialized, but Hyperdrive doesn't have a password loaded for your node wallet so it cannot be used.")
+			fmt.Fprintf(out, "Your node is currently running as %s%s%s in %s'read-only' mode%s.\n", terminal.ColorBlue, status.Address.NodeAddress.Hex(), terminal.ColorReset, terminal.ColorYellow, terminal.ColorReset)
 			return nil
 		}
 	}
 
 	if status.Address.NodeAddress != status.Wallet.WalletAddress {
-		fmt.Printf("The node wallet is initialized, but you are currently masquerading as %s%s%s.\n", terminal.ColorBlue, status.Address.NodeAddress.Hex(), terminal.ColorReset)
-		fmt.Printf("Your node wallet is for %s%s%s.\n", terminal.ColorBlue, status.Wallet.WalletAddress.Hex(), terminal.ColorReset)
-		fmt.Printf("%sDue to this mismatch, your node is running in 'read-only' mode and cannot submit transactions.%s\n", terminal.ColorYellow, terminal.ColorReset)
+		fmt.Fprintf(out, "The node wallet is initialized, but you are currently masquerading as %s%s%s.\n", terminal.ColorBlue, status.Address.NodeAddress.Hex(), terminal.ColorReset)
+		fmt.Fprintf(out, "Your node wallet is for %s%s%s.\n", terminal.ColorBlue, status.Wallet.WalletAddress.Hex(), terminal.ColorReset)
+		fmt.Fprintf(out, "%sDue to this mismatch, your node is running in 'read-only' mode and cannot submit transactions.%s\n", terminal.ColorYellow, terminal.ColorReset)
 	} else {
-		fmt.Println("The node wallet is initialized and ready.")
-		fmt.Printf("Node account: %s%s%s\n", terminal.ColorGreen, status.Wallet.WalletAddress.Hex(), terminal.ColorReset)
-		fmt.Printf("%sThe node's wallet keystore matches this address; it will be able to submit transactions.%s", terminal.ColorGreen, terminal.ColorReset)
+		fmt.Fprintln(out, "The node wallet is initialized and ready.")
+		fmt.Fprintf(out, "Node account: %s%s%s\n", terminal.ColorGreen, status.Wallet.WalletAddress.Hex(), terminal.ColorReset)
+		fmt.Fprintf(out, "%sThe node's wallet keystore matches this address; it will be able to submit transactions.%s", terminal.ColorGreen, terminal.ColorReset)
 	}
 
-	fmt.Println()
+	fmt.Fprintln(out)
 	if status.Password.IsPasswordSaved {
-		fmt.Printf("The node wallet's password %sis saved to disk%s.\n", terminal.ColorGreen, terminal.ColorReset)
-		fmt.Println("The node will be able to submit transactions automatically after a restart.")
+		fmt.Fprintf(out, "The node wallet's password %sis saved to disk%s.\n", terminal.ColorGreen, terminal.ColorReset)
+		fmt.Fprintln(out, "The node will be able to submit transactions automatically after a restart.")
 	} else {
-		fmt.Printf("The node wallet's password %sis not saved to disk%s.\n", terminal.ColorYellow, terminal.ColorReset)
-		fmt.Println("You will have to manually re-enter it with `hyperdrive wallet set-password` after a restart to be able to submit transactions.")
+		fmt.Fprintf(out, "The node wallet's password %sis not saved to disk%s.\n", terminal.ColorYellow, terminal.ColorReset)
+		fmt.Fprintln(out, "You will have to manually re-enter it with `hyperdrive wallet set-password` after a restart to be able to submit transactions.")
 	}
 
 	return nil
@@ -6,32 +6,50 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
 	"github.com/urfave/cli/v2"
 )
 
 func testRecovery(c *cli.Context) error {
+	out := utils.GetOutput(c)
+
 	// Get Hyperdrive client
 	hd := client.NewHyperdriveClientFromCtx(c)
 
 	// Prompt a notice about test recovery
-	fmt.Printf("%sNOTE:\nThis command will test the recovery of your node wallet's private key, but will not actually write any files; it's simply a \"dry run\" of recovery.\nUse `hyperdrive wallet recover` to actually recover the wallet.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
+	fmt.Fprintf(out, "%sNOTE:\nThis command will test the recovery of your node wallet's private key, but will not actually write any files; it's simply a \"dry run\" of recovery.\nUse `hyperdrive wallet recover` to actually recover the wallet.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
 
-	// Prompt for mnemonic
+	// Prompt for the mnemonic or shares
 	var mnemonic string
-	if c.String(mnemonicFlag.Name) != "" {
-		mnemonic = c.String(mnemonicFlag.Name)
+	var shares []string
+	useShares := c.Bool(sharesFlag.Name)
+	if useShares {
+		var err error
+		shares, err = PromptShares(out)
+		if err != nil {
+			return err
+		}
 	} else {
-		mnemonic = PromptMnemonic()
+		if c.String(mnemonicFlag.Name) != "" {
+			mnemonic = c.String(mnemonicFlag.Name)
+		} else {
+			var err error
+			mnemonic, err = PromptMnemonic(out)
+			if err != nil {
+				return err
+			}
+		}
+		mnemonic = strings.TrimSpace(mnemonic)
 	}
-	mnemonic = strings.TrimSpace(mnemonic)
 
 	// Check for a search-by-address operation
 	addressString := c.String(addressFlag.Name)
 	if addressString != "" {
 		// Get the address to search for
 		address := common.HexToAddress(addressString)
-		fmt.Printf("Searching for the derivation path and index for wallet %s...\nNOTE: this may take several minutes depending on how large your wallet's index is.\n", address.Hex())
+		fmt.Fprintf(out, "Searching for the derivation path and index for wallet %s...\nNOTE: this may take several minutes depending on how large your wallet's index is.\n", address.Hex())
 
 		// Test recover wallet
 		response, err := hd.Api.Wallet.TestSearchAndRecover(mnemonic, address)
@@ -40,16 +58,16 @@ func testRecovery(c *cli.Context) error {
 		}
 
 		// Log & return
-		fmt.Println("The node wallet was successfully found - recovery is possible.")
-		fmt.Printf("Derivation path: %s\n", response.Data.DerivationPath)
-		fmt.Printf("Wallet index:    %d\n", response.Data.Index)
-		fmt.Printf("Node account:    %s\n", response.Data.AccountAddress.Hex())
+		fmt.Fprintln(out, "The node wallet was successfully found - recovery is possible.")
+		fmt.Fprintf(out, "Derivation path: %s\n", response.Data.DerivationPath)
+		fmt.Fprintf(out, "Wallet index:    %d\n", response.Data.Index)
+		fmt.Fprintf(out, "Node account:    %s\n", response.Data.AccountAddress.Hex())
 	} else {
 		// Get the derivation path
 		derivationPathString := c.String(derivationPathFlag.Name)
 		var derivationPath *string
 		if derivationPathString != "" {
-			fmt.Printf("Using a custom derivation path (%s).\n", derivationPath)
+			fmt.Fprintf(out, "Using a custom derivation path (%s).\n", derivationPathString)
 			derivationPath = &derivationPathString
 		}
 
@@ -57,22 +75,28 @@ func testRecovery(c *cli.Context) error {
 		walletIndexVal := c.Uint64(walletIndexFlag.Name)
 		var walletIndex *uint64
 		if walletIndexVal != 0 {
-			fmt.Printf("Using a custom wallet index (%d).\n", walletIndex)
+			fmt.Fprintf(out, "Using a custom wallet index (%d).\n", walletIndex)
 			walletIndex = &walletIndexVal
 		}
 
-		fmt.Println()
-		fmt.Println("Testing recovery of node wallet...")
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, "Testing recovery of node wallet...")
 
 		// Test recover wallet
-		response, err := hd.Api.Wallet.TestRecover(derivationPath, mnemonic, walletIndex)
+		var response *api.ApiResponse[api.WalletRecoverData]
+		var err error
+		if useShares {
+			response, err = hd.Api.Wallet.TestRecoverFromShares(derivationPath, shares, walletIndex)
+		} else {
+			response, err = hd.Api.Wallet.TestRecover(derivationPath, mnemonic, walletIndex)
+		}
 		if err != nil {
 			return err
 		}
 
 		// Log & return
-		fmt.Println("The node wallet was successfully found - recovery is possible.")
-		fmt.Printf("Node account: %s\n", response.Data.AccountAddress.Hex())
+		fmt.Fprintln(out, "The node wallet was successfully found - recovery is possible.")
+		fmt.Fprintf(out, "Node account: %s\n", response.Data.AccountAddress.Hex())
 	}
 
 	return nil
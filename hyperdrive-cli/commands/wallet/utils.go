@@ -2,8 +2,10 @@ package wallet
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/types"
 	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/nodeset-org/hyperdrive/shared/utils/slip39"
 	"github.com/urfave/cli/v2"
 	"gopkg.in/yaml.v2"
 )
@@ -53,6 +56,10 @@ var (
 		Aliases: []string{"k"},
 		Usage:   "Recover the node wallet, but do not regenerate its validator keys",
 	}
+	sharesFlag *cli.BoolFlag = &cli.BoolFlag{
+		Name:  "shares",
+		Usage: "Recover the wallet from SLIP-39 share mnemonics created with `hyperdrive wallet export-shares` instead of from its mnemonic phrase",
+	}
 	addressFlag *cli.StringFlag = &cli.StringFlag{
 		Name:    "address",
 		Aliases: []string{"a"},
@@ -61,61 +68,73 @@ var (
 )
 
 // Prompt for a new wallet password
-func PromptNewPassword() string {
+func PromptNewPassword(out io.Writer) (string, error) {
 	for {
-		password := utils.PromptPassword(
+		password, err := utils.PromptPassword(
 			"Please enter a password to secure your wallet with:",
 			fmt.Sprintf("^.{%d,}$", input.MinPasswordLength),
 			fmt.Sprintf("Your password must be at least %d characters long. Please try again:", input.MinPasswordLength),
 		)
-		confirmation := utils.PromptPassword("Please confirm your password:", "^.*$", "")
+		if err != nil {
+			return "", err
+		}
+		confirmation, err := utils.PromptPassword("Please confirm your password:", "^.*$", "")
+		if err != nil {
+			return "", err
+		}
 		if password == confirmation {
-			return password
+			return password, nil
 		}
-		fmt.Println("Password confirmation does not match.")
-		fmt.Println("")
+		fmt.Fprintln(out, "Password confirmation does not match.")
+		fmt.Fprintln(out, "")
 	}
 }
 
 // Prompt for the password to a wallet that already exists
-func PromptExistingPassword() string {
+func PromptExistingPassword() (string, error) {
 	for {
-		password := utils.PromptPassword(
+		password, err := utils.PromptPassword(
 			"Please enter the password your wallet was originally secured with:",
 			fmt.Sprintf("^.{%d,}$", input.MinPasswordLength),
 			fmt.Sprintf("Your password must be at least %d characters long. Please try again:", input.MinPasswordLength),
 		)
-		return password
+		return password, err
 	}
 }
 
 // Prompt for a recovery mnemonic phrase
-func PromptMnemonic() string {
+func PromptMnemonic(out io.Writer) (string, error) {
 	for {
-		lengthInput := utils.Prompt(
+		lengthInput, err := utils.Prompt(
 			"Please enter the "+terminal.ColorBold+"number"+terminal.ColorReset+" of words in your mnemonic phrase (24 by default):",
 			"^[1-9][0-9]*$",
 			"Please enter a valid number.")
+		if err != nil {
+			return "", err
+		}
 
 		length, err := strconv.Atoi(lengthInput)
 		if err != nil {
-			fmt.Println("Please enter a valid number.")
+			fmt.Fprintln(out, "Please enter a valid number.")
 			continue
 		}
 
 		mv := bip39.Create(length)
 		if mv == nil {
-			fmt.Println("Please enter a valid mnemonic length.")
+			fmt.Fprintln(out, "Please enter a valid mnemonic length.")
 			continue
 		}
 
 		i := 0
 		for mv.Filled() == false {
 			prompt := fmt.Sprintf("Enter %sWord Number %d%s of your mnemonic:", terminal.ColorBold, i+1, terminal.ColorReset)
-			word := utils.PromptPassword(prompt, "^[a-zA-Z]+$", "Please enter a single word only.")
+			word, err := utils.PromptPassword(prompt, "^[a-zA-Z]+$", "Please enter a single word only.")
+			if err != nil {
+				return "", err
+			}
 
 			if err := mv.AddWord(strings.ToLower(word)); err != nil {
-				fmt.Println("Inputted word not valid, please retry.")
+				fmt.Fprintln(out, "Inputted word not valid, please retry.")
 				continue
 			}
 
@@ -124,31 +143,85 @@ func PromptMnemonic() string {
 
 		mnemonic, err := mv.Finalize()
 		if err != nil {
-			fmt.Printf("Error validating mnemonic: %s\n", err)
-			fmt.Println("Please try again.")
-			fmt.Println("")
+			fmt.Fprintf(out, "Error validating mnemonic: %s\n", err)
+			fmt.Fprintln(out, "Please try again.")
+			fmt.Fprintln(out, "")
+			continue
+		}
+
+		return mnemonic, nil
+	}
+}
+
+// Prompt for SLIP-39 share mnemonics until there are enough to recover the wallet
+func PromptShares(out io.Writer) ([]string, error) {
+	fmt.Fprintln(out, "Enter your shares one at a time, with the words of each share separated by spaces.")
+	shares := []string{}
+	required := 0
+	for {
+		var prompt string
+		if required > 0 {
+			prompt = fmt.Sprintf("Enter %sShare %d of %d%s:", terminal.ColorBold, len(shares)+1, required, terminal.ColorReset)
+		} else {
+			prompt = fmt.Sprintf("Enter %sShare %d%s (leave it blank if you've entered all of your shares):", terminal.ColorBold, len(shares)+1, terminal.ColorReset)
+		}
+		share, err := utils.PromptPassword(prompt, "^[a-zA-Z ]*$", "Shares can only contain words separated by spaces. Please try again:")
+		if err != nil {
+			return nil, err
+		}
+		share = strings.Join(strings.Fields(strings.ToLower(share)), " ")
+		if share == "" {
+			if required == 0 && len(shares) > 0 {
+				return shares, nil
+			}
+			fmt.Fprintln(out, "Please enter a share.")
 			continue
 		}
 
-		return mnemonic
+		// Make sure the share is valid
+		count, isSingleGroup, err := slip39.GetRequiredShareCount(share)
+		if err != nil {
+			fmt.Fprintf(out, "Error validating share: %s\n", err)
+			fmt.Fprintln(out, "Please try again.")
+			fmt.Fprintln(out, "")
+			continue
+		}
+		if slices.Contains(shares, share) {
+			fmt.Fprintln(out, "You've already entered that share.")
+			continue
+		}
+		shares = append(shares, share)
+
+		// Stop once the threshold has been reached
+		if isSingleGroup {
+			required = count
+		}
+		if required > 0 && len(shares) >= required {
+			return shares, nil
+		}
 	}
 }
 
 // Confirm a recovery mnemonic phrase
-func confirmMnemonic(mnemonic string) {
+func confirmMnemonic(out io.Writer, mnemonic string) error {
 	for {
-		fmt.Println("Please enter your mnemonic phrase to confirm.")
-		confirmation := PromptMnemonic()
+		fmt.Fprintln(out, "Please enter your mnemonic phrase to confirm.")
+		confirmation, err := PromptMnemonic(out)
+		if err != nil {
+			return err
+		}
 		if mnemonic == confirmation {
-			return
+			return nil
 		}
-		fmt.Println("The mnemonic phrase you entered does not match your recovery phrase. Please try again.")
-		fmt.Println("")
+		fmt.Fprintln(out, "The mnemonic phrase you entered does not match your recovery phrase. Please try again.")
+		fmt.Fprintln(out, "")
 	}
 }
 
 // Check for custom keys, prompt for their passwords, and store them in the custom keys file
 func promptForCustomKeyPasswords(hd *client.HyperdriveClient, cfg *config.HyperdriveConfig, testOnly bool) (string, error) {
+	out := hd.Context.Output
+
 	// Check for the custom key directory
 	datapath, err := homedir.Expand(cfg.UserDataPath.Value)
 	if err != nil {
@@ -171,10 +244,14 @@ func promptForCustomKeyPasswords(hd *client.HyperdriveClient, cfg *config.Hyperd
 
 	// Prompt the user with a warning message
 	if !testOnly {
-		fmt.Printf("%sWARNING:\nHyperdrive has detected that you have custom (externally-derived) validator keys for your minipools.\nIf these keys were actively used for validation by a service such as Allnodes, you MUST CONFIRM WITH THAT SERVICE that they have stopped validating and disabled those keys, and will NEVER validate with them again.\nOtherwise, you may both run the same keys at the same time which WILL RESULT IN YOUR VALIDATORS BEING SLASHED.%s\n\n", terminal.ColorRed, terminal.ColorReset)
+		fmt.Fprintf(out, "%sWARNING:\nHyperdrive has detected that you have custom (externally-derived) validator keys for your minipools.\nIf these keys were actively used for validation by a service such as Allnodes, you MUST CONFIRM WITH THAT SERVICE that they have stopped validating and disabled those keys, and will NEVER validate with them again.\nOtherwise, you may both run the same keys at the same time which WILL RESULT IN YOUR VALIDATORS BEING SLASHED.%s\n\n", terminal.ColorRed, terminal.ColorReset)
 
-		if !utils.Confirm("Please confirm that you have coordinated with the service that was running your minipool validators previously to ensure they have STOPPED validation for your minipools, will NEVER start them again, and you have manually confirmed on a Blockchain explorer such as https://beaconcha.in that your minipools are no longer attesting.") {
-			fmt.Println("Cancelled.")
+		confirmed, err := utils.Confirm("Please confirm that you have coordinated with the service that was running your minipool validators previously to ensure they have STOPPED validation for your minipools, will NEVER start them again, and you have manually confirmed on a Blockchain explorer such as https://beaconcha.in that your minipools are no longer attesting.")
+		if err != nil {
+			return "", err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
 			os.Exit(0)
 		}
 	}
@@ -199,19 +276,23 @@ func promptForCustomKeyPasswords(hd *client.HyperdriveClient, cfg *config.Hyperd
 	}
 
 	// Notify the user
-	fmt.Println("It looks like you have some custom keystores for your minipool's validators.\nYou will be prompted for the passwords each one was encrypted with, so they can be loaded into the Validator Client that Hyperdrive manages for you.\n")
+	fmt.Fprintln(out, "It looks like you have some custom keystores for your minipool's validators.\nYou will be prompted for the passwords each one was encrypted with, so they can be loaded into the Validator Client that Hyperdrive manages for you.")
+	fmt.Fprintln(out)
 
 	// Get the passwords for each one
 	pubkeyPasswords := map[string]string{}
 	for _, pubkey := range customPubkeys {
-		password := utils.PromptPassword(
+		password, err := utils.PromptPassword(
 			fmt.Sprintf("Please enter the password that the keystore for %s was encrypted with:", pubkey.HexWithPrefix()), "^.*$", "",
 		)
+		if err != nil {
+			return "", err
+		}
 
 		formattedPubkey := strings.ToUpper(pubkey.HexWithPrefix())
 		pubkeyPasswords[formattedPubkey] = password
 
-		fmt.Println()
+		fmt.Fprintln(out)
 	}
 
 	// Store them in the file
@@ -10,9 +10,11 @@ import (
 	"github.com/mitchellh/go-homedir"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/service"
 	swcmd "github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/stakewise"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/validator"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/commands/wallet"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/context"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/output"
 	"github.com/nodeset-org/hyperdrive/shared"
 	"github.com/urfave/cli/v2"
 )
@@ -59,8 +61,16 @@ var (
 		Aliases: []string{"s"},
 		Usage:   "Some commands may print sensitive information to your terminal. Use this flag when nobody can see your screen to allow sensitive data to be printed without prompting",
 	}
+	outputFlag *cli.StringFlag = &cli.StringFlag{
+		Name:  "output",
+		Usage: fmt.Sprintf("The format to print command results in. Use '%s' or '%s' to print the API responses and errors in a machine-readable format; prompts are disabled in these modes, so commands that need input will fail with exit code %d instead. Options: %s, %s, %s", context.OutputFormat_Json, context.OutputFormat_Yaml, output.ExitCode_InputRequired, context.OutputFormat_Text, context.OutputFormat_Json, context.OutputFormat_Yaml),
+		Value: string(context.OutputFormat_Text),
+	}
 )
 
+// True if the user requested machine-readable output
+var machineOutput bool
+
 // Run
 func main() {
 	// Add logo and attribution to application help template
@@ -97,6 +107,7 @@ func main() {
 		nonceFlag,
 		debugFlag,
 		secureSessionFlag,
+		outputFlag,
 	}
 
 	// Set default paths for flags before parsing the provided values
@@ -105,7 +116,9 @@ func main() {
 	// Register commands
 	service.RegisterCommands(app, "service", []string{"s"})
 	swcmd.RegisterCommands(app, "stakewise", []string{"sw"})
+	validator.RegisterCommands(app, "validator", []string{"v"})
 	wallet.RegisterCommands(app, "wallet", []string{"w"})
+	output.WrapCommands(app.Commands)
 
 	app.Before = func(c *cli.Context) error {
 		// Check user ID
@@ -120,15 +133,21 @@ func main() {
 			fmt.Fprintf(os.Stderr, err.Error())
 			os.Exit(1)
 		}
+
+		// Only pad human-readable output
+		if !machineOutput {
+			fmt.Println()
+		}
 		return nil
 	}
 
 	// Run application
-	fmt.Println()
 	if err := app.Run(os.Args); err != nil {
 		fmt.Println(err.Error())
 	}
-	fmt.Println()
+	if !machineOutput {
+		fmt.Println()
+	}
 }
 
 // Set the default paths for various flags
@@ -152,6 +171,7 @@ func validateFlags(c *cli.Context) error {
 		MaxPriorityFee: c.Float64(maxPriorityFeeFlag.Name),
 		DebugEnabled:   c.Bool(debugFlag.Name),
 		SecureSession:  c.Bool(secureSessionFlag.Name),
+		Output:         os.Stdout,
 	}
 
 	// If set, validate custom nonce
@@ -169,6 +189,18 @@ func validateFlags(c *cli.Context) error {
 	}
 	hdCtx.ConfigPath = path
 
+	// Set the output format
+	outputFormat := context.OutputFormat(strings.ToLower(strings.TrimSpace(c.String(outputFlag.Name))))
+	switch outputFormat {
+	case context.OutputFormat_Text:
+	case context.OutputFormat_Json, context.OutputFormat_Yaml:
+		machineOutput = true
+		utils.DisablePrompts()
+	default:
+		return fmt.Errorf("invalid output format [%s]; options are %s, %s, and %s", outputFormat, context.OutputFormat_Text, context.OutputFormat_Json, context.OutputFormat_Yaml)
+	}
+	hdCtx.OutputFormat = outputFormat
+
 	// TODO: more here
 	context.SetHyperdriveContext(c, hdCtx)
 	return nil
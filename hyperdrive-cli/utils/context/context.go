@@ -1,6 +1,7 @@
 package context
 
 import (
+	"io"
 	"math/big"
 
 	"github.com/urfave/cli/v2"
@@ -10,6 +11,20 @@ const (
 	contextMetadataName string = "hd-context"
 )
 
+// The format to print command output in
+type OutputFormat string
+
+const (
+	// Human-readable text, with prompts
+	OutputFormat_Text OutputFormat = "text"
+
+	// JSON, without prompts
+	OutputFormat_Json OutputFormat = "json"
+
+	// YAML, without prompts
+	OutputFormat_Yaml OutputFormat = "yaml"
+)
+
 // Context for global settings
 type HyperdriveContext struct {
 	// The path to the configuration file
@@ -29,6 +44,18 @@ type HyperdriveContext struct {
 
 	// True if this is a secure session
 	SecureSession bool
+
+	// The format to print command output in
+	OutputFormat OutputFormat
+
+	// Where commands print their human-readable output; this is captured instead of printed when machine-readable output is enabled
+	Output io.Writer
+
+	// Called with the data of each API response when machine-readable output is enabled
+	ResponseHook func(route string, data any)
+
+	// Called with a command's own result when machine-readable output is enabled
+	ResultHook func(data any)
 }
 
 // Add the Hyperdrive context into a CLI context
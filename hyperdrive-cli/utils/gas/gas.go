@@ -2,6 +2,7 @@ package gas
 
 import (
 	"fmt"
+	"io"
 	"math"
 	"math/big"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 )
 
 func GetMaxFees(c *cli.Context, hd *client.HyperdriveClient, simResult eth.SimulationResult) (*big.Int, *big.Int, error) {
+	out := utils.GetOutput(c)
 	cfg, isNew, err := hd.LoadConfig()
 	if err != nil {
 		return nil, nil, fmt.Errorf("Error getting Hyperdrive configuration: %w", err)
@@ -33,7 +35,7 @@ func GetMaxFees(c *cli.Context, hd *client.HyperdriveClient, simResult eth.Simul
 		maxPriorityFee := eth.GweiToWei(cfg.Hyperdrive.MaxPriorityFee.Value)
 		if maxPriorityFee == nil || maxPriorityFee.Uint64() == 0 {
 			defaultFee := cfg.Hyperdrive.MaxPriorityFee.Default[cfg.Hyperdrive.Network.Value]
-			fmt.Printf("%sNOTE: max priority fee not set or set to 0, defaulting to %d gwei%s\n", terminal.ColorYellow, defaultFee, terminal.ColorReset)
+			fmt.Fprintf(out, "%sNOTE: max priority fee not set or set to 0, defaulting to %.2f gwei%s\n", terminal.ColorYellow, defaultFee, terminal.ColorReset)
 			maxPriorityFeeGwei = defaultFee
 		} else {
 			maxPriorityFeeGwei = eth.WeiToGwei(maxPriorityFee)
@@ -42,13 +44,13 @@ func GetMaxFees(c *cli.Context, hd *client.HyperdriveClient, simResult eth.Simul
 
 	// Use the requested max fee and priority fee if provided
 	if maxFeeGwei != 0 {
-		fmt.Printf("%sUsing the requested max fee of %.2f gwei (including a max priority fee of %.2f gwei).\n", terminal.ColorYellow, maxFeeGwei, maxPriorityFeeGwei)
+		fmt.Fprintf(out, "%sUsing the requested max fee of %.2f gwei (including a max priority fee of %.2f gwei).\n", terminal.ColorYellow, maxFeeGwei, maxPriorityFeeGwei)
 		lowLimit := maxFeeGwei / eth.WeiPerGwei * float64(simResult.EstimatedGasLimit)
 		highLimit := maxFeeGwei / eth.WeiPerGwei * float64(simResult.SafeGasLimit)
-		fmt.Printf("Total cost: %.4f to %.4f ETH%s\n", lowLimit, highLimit, terminal.ColorReset)
+		fmt.Fprintf(out, "Total cost: %.4f to %.4f ETH%s\n", lowLimit, highLimit, terminal.ColorReset)
 	} else {
 		if c.Bool(utils.YesFlag.Name) {
-			maxFeeWei, err := GetHeadlessMaxFeeWei()
+			maxFeeWei, err := GetHeadlessMaxFeeWei(out)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -58,21 +60,27 @@ func GetMaxFees(c *cli.Context, hd *client.HyperdriveClient, simResult eth.Simul
 			etherchainData, err := etherchain.GetGasPrices()
 			if err == nil {
 				// Print the Etherchain data and ask for an amount
-				maxFeeGwei = handleEtherchainGasPrices(etherchainData, simResult, maxPriorityFeeGwei, simResult.SafeGasLimit)
+				maxFeeGwei, err = handleEtherchainGasPrices(out, etherchainData, simResult, maxPriorityFeeGwei, simResult.SafeGasLimit)
+				if err != nil {
+					return nil, nil, err
+				}
 
 			} else {
 				// Fallback to Etherscan
-				fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+				fmt.Fprintf(out, "%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
 				etherscanData, err := etherscan.GetGasPrices()
 				if err == nil {
 					// Print the Etherscan data and ask for an amount
-					maxFeeGwei = handleEtherscanGasPrices(etherscanData, simResult, maxPriorityFeeGwei, simResult.SafeGasLimit)
+					maxFeeGwei, err = handleEtherscanGasPrices(out, etherscanData, simResult, maxPriorityFeeGwei, simResult.SafeGasLimit)
+					if err != nil {
+						return nil, nil, err
+					}
 				} else {
 					return nil, nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
 				}
 			}
 		}
-		fmt.Printf("%sUsing a max fee of %.2f gwei and a priority fee of %.2f gwei.\n%s", terminal.ColorBlue, maxFeeGwei, maxPriorityFeeGwei, terminal.ColorReset)
+		fmt.Fprintf(out, "%sUsing a max fee of %.2f gwei and a priority fee of %.2f gwei.\n%s", terminal.ColorBlue, maxFeeGwei, maxPriorityFeeGwei, terminal.ColorReset)
 	}
 
 	if maxPriorityFeeGwei > maxFeeGwei {
@@ -84,7 +92,7 @@ func GetMaxFees(c *cli.Context, hd *client.HyperdriveClient, simResult eth.Simul
 	ethRequired := big.NewInt(0).Mul(maxFee, big.NewInt(int64(simResult.SafeGasLimit)))
 	response, err := hd.Api.Utils.Balance()
 	if err != nil {
-		fmt.Printf("%sWARNING: couldn't check the ETH balance of the node (%s)\nPlease ensure your node wallet has enough ETH to pay for this transaction.%s\n\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+		fmt.Fprintf(out, "%sWARNING: couldn't check the ETH balance of the node (%s)\nPlease ensure your node wallet has enough ETH to pay for this transaction.%s\n\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
 	} else if response.Data.Balance.Cmp(ethRequired) < 0 {
 		return nil, nil, fmt.Errorf("Your node has %.6f ETH in its wallet, which is not enough to pay for this transaction with a max fee of %.4f gwei; you require at least %.6f more ETH.", eth.WeiToEth(response.Data.Balance), maxFeeGwei, eth.WeiToEth(big.NewInt(0).Sub(ethRequired, response.Data.Balance)))
 	}
@@ -94,13 +102,13 @@ func GetMaxFees(c *cli.Context, hd *client.HyperdriveClient, simResult eth.Simul
 }
 
 // Get the suggested max fee for service operations
-func GetHeadlessMaxFeeWei() (*big.Int, error) {
+func GetHeadlessMaxFeeWei(out io.Writer) (*big.Int, error) {
 	etherchainData, err := etherchain.GetGasPrices()
 	if err == nil {
 		return etherchainData.RapidWei, nil
 	}
 
-	fmt.Printf("%sWARNING: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
+	fmt.Fprintf(out, "%sWARNING: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", terminal.ColorYellow, err.Error(), terminal.ColorReset)
 	etherscanData, err := etherscan.GetGasPrices()
 	if err == nil {
 		return eth.GweiToWei(etherscanData.FastGwei), nil
@@ -109,7 +117,7 @@ func GetHeadlessMaxFeeWei() (*big.Int, error) {
 	return nil, fmt.Errorf("error getting gas price suggestions: %w", err)
 }
 
-func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, simResult eth.SimulationResult, priorityFee float64, gasLimit uint64) float64 {
+func handleEtherchainGasPrices(out io.Writer, gasSuggestion etherchain.GasFeeSuggestion, simResult eth.SimulationResult, priorityFee float64, gasLimit uint64) (float64, error) {
 
 	rapidGwei := math.Ceil(eth.WeiToGwei(gasSuggestion.RapidWei) + priorityFee)
 	rapidEth := eth.WeiToEth(gasSuggestion.RapidWei)
@@ -163,46 +171,49 @@ func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, simRes
 		slowHighLimit = slowLowLimit
 	}
 
-	fmt.Printf("%s+============== Suggested Gas Prices ==============+\n", terminal.ColorBlue)
-	fmt.Println("| Avg Wait Time |  Max Fee  |    Total Gas Cost    |")
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
+	fmt.Fprintf(out, "%s+============== Suggested Gas Prices ==============+\n", terminal.ColorBlue)
+	fmt.Fprintln(out, "| Avg Wait Time |  Max Fee  |    Total Gas Cost    |")
+	fmt.Fprintf(out, "| %-13s | %-9s | %.4f to %.4f ETH |\n",
 		gasSuggestion.RapidTime, fmt.Sprintf("%d gwei", int(rapidGwei)), rapidLowLimit, rapidHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
+	fmt.Fprintf(out, "| %-13s | %-9s | %.4f to %.4f ETH |\n",
 		gasSuggestion.FastTime, fmt.Sprintf("%d gwei", int(fastGwei)), fastLowLimit, fastHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
+	fmt.Fprintf(out, "| %-13s | %-9s | %.4f to %.4f ETH |\n",
 		gasSuggestion.StandardTime, fmt.Sprintf("%d gwei", int(standardGwei)), standardLowLimit, standardHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
+	fmt.Fprintf(out, "| %-13s | %-9s | %.4f to %.4f ETH |\n",
 		gasSuggestion.SlowTime, fmt.Sprintf("%d gwei", int(slowGwei)), slowLowLimit, slowHighLimit)
-	fmt.Printf("+==================================================+\n\n%s", terminal.ColorReset)
+	fmt.Fprintf(out, "+==================================================+\n\n%s", terminal.ColorReset)
 
-	fmt.Printf("These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
+	fmt.Fprintf(out, "These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
 
 	for {
-		desiredPrice := utils.Prompt(
+		desiredPrice, err := utils.Prompt(
 			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(fastGwei)),
 			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
 			"Not a valid gas price, try again:")
+		if err != nil {
+			return 0, err
+		}
 
 		if desiredPrice == "" {
-			return fastGwei
+			return fastGwei, nil
 		}
 
 		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
 		if err != nil {
-			fmt.Println("Not a valid gas price (%s), try again.", err.Error())
+			fmt.Fprintf(out, "Not a valid gas price (%s), try again.\n", err.Error())
 			continue
 		}
 		if desiredPriceFloat <= 0 {
-			fmt.Println("Max fee must be greater than zero.")
+			fmt.Fprintln(out, "Max fee must be greater than zero.")
 			continue
 		}
 
-		return desiredPriceFloat
+		return desiredPriceFloat, nil
 	}
 
 }
 
-func handleEtherscanGasPrices(gasSuggestion etherscan.GasFeeSuggestion, simResult eth.SimulationResult, priorityFee float64, gasLimit uint64) float64 {
+func handleEtherscanGasPrices(out io.Writer, gasSuggestion etherscan.GasFeeSuggestion, simResult eth.SimulationResult, priorityFee float64, gasLimit uint64) (float64, error) {
 	fastGwei := math.Ceil(gasSuggestion.FastGwei + priorityFee)
 	fastEth := gasSuggestion.FastGwei / eth.WeiPerGwei
 
@@ -242,38 +253,41 @@ func handleEtherscanGasPrices(gasSuggestion etherscan.GasFeeSuggestion, simResul
 		slowHighLimit = slowLowLimit
 	}
 
-	fmt.Printf("%s+============ Suggested Gas Prices ============+\n", terminal.ColorBlue)
-	fmt.Println("|   Speed   |  Max Fee  |    Total Gas Cost    |")
-	fmt.Printf("| Fast      | %-9s | %.4f to %.4f ETH |\n",
+	fmt.Fprintf(out, "%s+============ Suggested Gas Prices ============+\n", terminal.ColorBlue)
+	fmt.Fprintln(out, "|   Speed   |  Max Fee  |    Total Gas Cost    |")
+	fmt.Fprintf(out, "| Fast      | %-9s | %.4f to %.4f ETH |\n",
 		fmt.Sprintf("%d gwei", int(fastGwei)), fastLowLimit, fastHighLimit)
-	fmt.Printf("| Standard  | %-9s | %.4f to %.4f ETH |\n",
+	fmt.Fprintf(out, "| Standard  | %-9s | %.4f to %.4f ETH |\n",
 		fmt.Sprintf("%d gwei", int(standardGwei)), standardLowLimit, standardHighLimit)
-	fmt.Printf("| Slow      | %-9s | %.4f to %.4f ETH |\n",
+	fmt.Fprintf(out, "| Slow      | %-9s | %.4f to %.4f ETH |\n",
 		fmt.Sprintf("%d gwei", int(slowGwei)), slowLowLimit, slowHighLimit)
-	fmt.Printf("+==============================================+\n\n%s", terminal.ColorReset)
+	fmt.Fprintf(out, "+==============================================+\n\n%s", terminal.ColorReset)
 
-	fmt.Printf("These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
+	fmt.Fprintf(out, "These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
 
 	for {
-		desiredPrice := utils.Prompt(
+		desiredPrice, err := utils.Prompt(
 			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(fastGwei)),
 			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
 			"Not a valid gas price, try again:")
+		if err != nil {
+			return 0, err
+		}
 
 		if desiredPrice == "" {
-			return fastGwei
+			return fastGwei, nil
 		}
 
 		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
 		if err != nil {
-			fmt.Println("Not a valid gas price (%s), try again.", err.Error())
+			fmt.Fprintf(out, "Not a valid gas price (%s), try again.\n", err.Error())
 			continue
 		}
 		if desiredPriceFloat <= 0 {
-			fmt.Println("Max fee must be greater than zero.")
+			fmt.Fprintln(out, "Max fee must be greater than zero.")
 			continue
 		}
 
-		return desiredPriceFloat
+		return desiredPriceFloat, nil
 	}
 }
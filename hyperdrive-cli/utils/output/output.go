@@ -0,0 +1,219 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/context"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Exit codes used with machine-readable output
+const (
+	ExitCode_Success       int = 0
+	ExitCode_Error         int = 1
+	ExitCode_InputRequired int = 2
+)
+
+var (
+	// Matches the terminal escape codes used to color text
+	colorCodeRegex = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+	// Commands that print their own machine-readable output
+	streamingCommands = map[*cli.Command]bool{}
+)
+
+// The machine-readable result of a command
+type CommandResult struct {
+	// The full name of the command that was run
+	Command string `json:"command"`
+
+	// True if the command completed without an error
+	Success bool `json:"success"`
+
+	// The error that stopped the command, if it failed
+	Error *CommandError `json:"error,omitempty"`
+
+	// The data of each API response the command received, keyed by route; later responses from the same route replace earlier ones
+	Responses map[string]any `json:"responses"`
+
+	// The result a command built itself instead of getting from the API, for commands that set one with SetResult
+	Result any `json:"result,omitempty"`
+
+	// The command's human-readable output, for commands that don't use the API or set a result
+	Output string `json:"output,omitempty"`
+}
+
+type CommandError struct {
+	Message  string `json:"message"`
+	ExitCode int    `json:"exitCode"`
+}
+
+// Mark a command as streaming, so WrapCommands leaves it alone. Use this for commands that print each item with PrintStreamItem as it arrives instead of finishing with a single result.
+func Streaming(cmd *cli.Command) *cli.Command {
+	streamingCommands[cmd] = true
+	return cmd
+}
+
+// Set the result of a command that builds it locally, so it's reported in the CommandResult when machine-readable output is enabled
+func SetResult(c *cli.Context, data any) {
+	hdCtx := context.GetHyperdriveContext(c)
+	if hdCtx.ResultHook != nil {
+		hdCtx.ResultHook(data)
+	}
+}
+
+// Wrap the action of each command and its subcommands so they print a CommandResult instead of human-readable text when machine-readable output is enabled
+func WrapCommands(commands []*cli.Command) {
+	for _, cmd := range commands {
+		if cmd.Action != nil && !streamingCommands[cmd] {
+			cmd.Action = wrapAction(cmd.Action)
+		}
+		WrapCommands(cmd.Subcommands)
+	}
+}
+
+// Wrap a single command action
+func wrapAction(action cli.ActionFunc) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		hdCtx := context.GetHyperdriveContext(c)
+		if hdCtx.OutputFormat == context.OutputFormat_Text {
+			return action(c)
+		}
+
+		// Record the API responses
+		result := &CommandResult{
+			Command:   strings.TrimPrefix(c.Command.HelpName, c.App.Name+" "),
+			Responses: map[string]any{},
+		}
+		responseLock := &sync.Mutex{}
+		hdCtx.ResponseHook = func(route string, data any) {
+			responseLock.Lock()
+			defer responseLock.Unlock()
+			result.Responses[route] = data
+		}
+		hdCtx.ResultHook = func(data any) {
+			result.Result = data
+		}
+
+		// Run the command with its human-readable output captured
+		buffer := &bytes.Buffer{}
+		stdout := hdCtx.Output
+		hdCtx.Output = buffer
+		err := action(c)
+		hdCtx.Output = stdout
+		if len(result.Responses) == 0 && result.Result == nil {
+			result.Output = colorCodeRegex.ReplaceAllString(buffer.String(), "")
+		}
+
+		// Build the result
+		exitCode := ExitCode_Success
+		if err != nil {
+			exitCode = ExitCode_Error
+			var inputErr *utils.InputRequiredError
+			if errors.As(err, &inputErr) {
+				exitCode = ExitCode_InputRequired
+			}
+			result.Error = &CommandError{
+				Message:  err.Error(),
+				ExitCode: exitCode,
+			}
+		} else {
+			result.Success = true
+		}
+
+		// Print it
+		err = printResult(hdCtx.Output, hdCtx.OutputFormat, result)
+		if err != nil {
+			return cli.Exit(err.Error(), ExitCode_Error)
+		}
+		if exitCode != ExitCode_Success {
+			return cli.Exit("", exitCode)
+		}
+		return nil
+	}
+}
+
+// Print the command result in the requested format
+func printResult(out io.Writer, format context.OutputFormat, result *CommandResult) error {
+	// Convert to JSON first so both formats use the API types' JSON field names
+	bytes, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing command result: %w", err)
+	}
+
+	switch format {
+	case context.OutputFormat_Json:
+		fmt.Fprintln(out, string(bytes))
+
+	case context.OutputFormat_Yaml:
+		yamlBytes, err := jsonToYaml(bytes)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(yamlBytes))
+
+	default:
+		return fmt.Errorf("unknown output format [%s]", format)
+	}
+	return nil
+}
+
+// Print a single item from a streaming command in the requested format: one line per item for JSON, or one document per item for YAML
+func PrintStreamItem(format context.OutputFormat, item any) error {
+	bytes, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("error serializing output: %w", err)
+	}
+
+	switch format {
+	case context.OutputFormat_Json:
+		fmt.Println(string(bytes))
+
+	case context.OutputFormat_Yaml:
+		yamlBytes, err := jsonToYaml(bytes)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("---\n%s", string(yamlBytes))
+
+	default:
+		return fmt.Errorf("unknown output format [%s]", format)
+	}
+	return nil
+}
+
+// Convert serialized JSON to YAML
+func jsonToYaml(jsonBytes []byte) ([]byte, error) {
+	// JSON is valid YAML, so parse it as a node tree; unlike decoding into a map, this keeps the field order and big numbers intact
+	var node yaml.Node
+	err := yaml.Unmarshal(jsonBytes, &node)
+	if err != nil {
+		return nil, fmt.Errorf("error converting output to YAML: %w", err)
+	}
+	setBlockStyle(&node)
+	buffer := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buffer)
+	encoder.SetIndent(2)
+	err = encoder.Encode(&node)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing output to YAML: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// Clear the flow style the JSON parser gives each node so the YAML is printed in the normal block style
+func setBlockStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle | yaml.DoubleQuotedStyle
+	for _, child := range node.Content {
+		setBlockStyle(child)
+	}
+}
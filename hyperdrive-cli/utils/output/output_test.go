@@ -0,0 +1,79 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/context"
+	"github.com/urfave/cli/v2"
+)
+
+// Run a wrapped action with JSON output, returning the printed result and the exit code
+func runTestAction(t *testing.T, action cli.ActionFunc) (*CommandResult, int) {
+	stdout := &bytes.Buffer{}
+	app := cli.NewApp()
+	app.Name = "hyperdrive"
+	app.Metadata = map[string]any{}
+	app.ExitErrHandler = func(c *cli.Context, err error) {}
+	app.Before = func(c *cli.Context) error {
+		context.SetHyperdriveContext(c, &context.HyperdriveContext{
+			OutputFormat: context.OutputFormat_Json,
+			Output:       stdout,
+		})
+		return nil
+	}
+	app.Commands = []*cli.Command{
+		{
+			Name:   "test",
+			Action: action,
+		},
+	}
+	WrapCommands(app.Commands)
+
+	exitCode := ExitCode_Success
+	err := app.Run([]string{"hyperdrive", "test"})
+	var exitErr cli.ExitCoder
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	} else if err != nil {
+		t.Fatalf("error running command: %s", err.Error())
+	}
+
+	var result CommandResult
+	err = json.Unmarshal(stdout.Bytes(), &result)
+	if err != nil {
+		t.Fatalf("error parsing command result [%s]: %s", stdout.String(), err.Error())
+	}
+	return &result, exitCode
+}
+
+func TestWrapActionCapturesOutput(t *testing.T) {
+	result, exitCode := runTestAction(t, func(c *cli.Context) error {
+		fmt.Fprintln(utils.GetOutput(c), "Hello")
+		return nil
+	})
+	if exitCode != ExitCode_Success || !result.Success || result.Output != "Hello\n" {
+		t.Fatalf("unexpected result with exit code %d: %+v", exitCode, result)
+	}
+}
+
+func TestWrapActionInputRequired(t *testing.T) {
+	utils.DisablePrompts()
+	result, exitCode := runTestAction(t, func(c *cli.Context) error {
+		confirmed, err := utils.Confirm("Are you sure?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(utils.GetOutput(c), "Cancelled.")
+		}
+		return nil
+	})
+	if exitCode != ExitCode_InputRequired || result.Success || result.Error == nil || result.Error.ExitCode != ExitCode_InputRequired {
+		t.Fatalf("unexpected result with exit code %d: %+v", exitCode, result)
+	}
+}
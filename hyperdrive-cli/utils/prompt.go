@@ -11,8 +11,39 @@ import (
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 )
 
+// True if prompts should fail instead of asking the user for input
+var promptsDisabled bool
+
+// Returned by commands that need input from the user when prompts are disabled
+type InputRequiredError struct {
+	Prompt string
+}
+
+func (e *InputRequiredError) Error() string {
+	return fmt.Sprintf("input is required but prompts are disabled with machine-readable output; provide it with the command's flags instead (prompt: %s)", strings.TrimSpace(e.Prompt))
+}
+
+// Make all prompts fail instead of asking the user for input, so commands can run unattended
+func DisablePrompts() {
+	promptsDisabled = true
+}
+
+// Get an InputRequiredError if prompts are disabled
+func checkPromptsEnabled(prompt string) error {
+	if promptsDisabled {
+		return &InputRequiredError{
+			Prompt: prompt,
+		}
+	}
+	return nil
+}
+
 // Prompt for user input
-func Prompt(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) string {
+func Prompt(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) (string, error) {
+	err := checkPromptsEnabled(initialPrompt)
+	if err != nil {
+		return "", err
+	}
 
 	// Print initial prompt
 	fmt.Println(initialPrompt)
@@ -26,24 +57,30 @@ func Prompt(initialPrompt string, expectedFormat string, incorrectFormatPrompt s
 	fmt.Println("")
 
 	// Return user input
-	return scanner.Text()
+	return scanner.Text(), nil
 
 }
 
 // Prompt for confirmation
-func Confirm(initialPrompt string) bool {
-	response := Prompt(fmt.Sprintf("%s [y/n]", initialPrompt), "(?i)^(y|yes|n|no)$", "Please answer 'y' or 'n'")
-	return (strings.ToLower(response[:1]) == "y")
+func Confirm(initialPrompt string) (bool, error) {
+	response, err := Prompt(fmt.Sprintf("%s [y/n]", initialPrompt), "(?i)^(y|yes|n|no)$", "Please answer 'y' or 'n'")
+	if err != nil {
+		return false, err
+	}
+	return (strings.ToLower(response[:1]) == "y"), nil
 }
 
 // Prompt for 'I agree' confirmation (used on important questions to avoid a quick 'y' response from the user)
-func ConfirmWithIAgree(initialPrompt string) bool {
-	response := Prompt(fmt.Sprintf("%s [Type 'I agree' or 'n']", initialPrompt), "(?i)^(i agree|n|no)$", "Please answer 'I agree' or 'n'")
-	return (len(response) == 7 && strings.ToLower(response[:7]) == "i agree")
+func ConfirmWithIAgree(initialPrompt string) (bool, error) {
+	response, err := Prompt(fmt.Sprintf("%s [Type 'I agree' or 'n']", initialPrompt), "(?i)^(i agree|n|no)$", "Please answer 'I agree' or 'n'")
+	if err != nil {
+		return false, err
+	}
+	return (len(response) == 7 && strings.ToLower(response[:7]) == "i agree"), nil
 }
 
 // Prompt for user selection
-func Select(initialPrompt string, options []string) (int, string) {
+func Select(initialPrompt string, options []string) (int, string, error) {
 
 	// Get prompt
 	prompt := initialPrompt
@@ -59,7 +96,10 @@ func Select(initialPrompt string, options []string) (int, string) {
 	expectedFormat := fmt.Sprintf("^(%s)$", strings.Join(optionNumbers, "|"))
 
 	// Prompt user
-	response := Prompt(prompt, expectedFormat, "Please enter a number corresponding to an option")
+	response, err := Prompt(prompt, expectedFormat, "Please enter a number corresponding to an option")
+	if err != nil {
+		return 0, "", err
+	}
 
 	// Get selected option
 	index, _ := strconv.Atoi(response)
@@ -67,16 +107,20 @@ func Select(initialPrompt string, options []string) (int, string) {
 	selectedOption := options[selectedIndex]
 
 	// Return
-	return selectedIndex, selectedOption
+	return selectedIndex, selectedOption, nil
 
 }
 
 // Prompts the user to verify that there is nobody looking over their shoulder before printing sensitive information.
-func ConfirmSecureSession(warning string) bool {
-	if !Confirm(fmt.Sprintf("%s%s%s\nAre you sure you want to continue?", terminal.ColorYellow, warning, terminal.ColorReset)) {
+func ConfirmSecureSession(warning string) (bool, error) {
+	confirmed, err := Confirm(fmt.Sprintf("%s%s%s\nAre you sure you want to continue?", terminal.ColorYellow, warning, terminal.ColorReset))
+	if err != nil {
+		return false, err
+	}
+	if !confirmed {
 		fmt.Println("Cancelled.")
-		return false
+		return false, nil
 	}
 
-	return true
+	return true, nil
 }
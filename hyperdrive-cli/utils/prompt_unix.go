@@ -12,7 +12,11 @@ import (
 )
 
 // Prompt for password input
-func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) string {
+func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) (string, error) {
+	err := checkPromptsEnabled(initialPrompt)
+	if err != nil {
+		return "", err
+	}
 
 	// Print initial prompt
 	fmt.Println(initialPrompt)
@@ -41,6 +45,6 @@ func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormat
 	fmt.Println("")
 
 	// Return user input
-	return input
+	return input, nil
 
 }
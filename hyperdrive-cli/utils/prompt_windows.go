@@ -4,6 +4,6 @@
 package cli
 
 // Prompt for password input
-func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) string {
+func PromptPassword(initialPrompt string, expectedFormat string, incorrectFormatPrompt string) (string, error) {
 	return Prompt(initialPrompt, expectedFormat, incorrectFormatPrompt)
 }
@@ -42,7 +42,10 @@ func GetMultiselectIndices[DataType any](c *cli.Context, flagName string, option
 	if c.Bool(YesFlag.Name) {
 		return nil, fmt.Errorf("the '%s' flag (non-interactive mode) is specified but the '%s' flag (selection) is missing", YesFlag.Name, flagName)
 	}
-	indexSelection := Prompt("%s\nUse a comma separated list (such as '1,2,3') or leave it blank to select all options.", "^$|^\\d+(,\\d+)*$", "Invalid index selection")
+	indexSelection, err := Prompt("%s\nUse a comma separated list (such as '1,2,3') or leave it blank to select all options.", "^$|^\\d+(,\\d+)*$", "Invalid index selection")
+	if err != nil {
+		return nil, err
+	}
 	return parseIndexSelection(indexSelection, options)
 }
 
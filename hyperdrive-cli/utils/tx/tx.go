@@ -17,6 +17,8 @@ import (
 
 // Handle a transaction, either printing its details, signing it, or submitting it and waiting for it to be included
 func HandleTx(c *cli.Context, hd *client.HyperdriveClient, txInfo *eth.TransactionInfo, confirmMessage string, identifier string, submissionMessage string) error {
+	out := utils.GetOutput(c)
+
 	// Make sure the TX was successful
 	/*if txInfo.SimulationResult.SimulationError != "" {
 		return fmt.Errorf("simulating %s failed: %s", identifier, txInfo.SimulationResult.SimulationError)
@@ -24,12 +26,12 @@ func HandleTx(c *cli.Context, hd *client.HyperdriveClient, txInfo *eth.Transacti
 
 	// Print the TX data if requested
 	if c.Bool(utils.PrintTxDataFlag.Name) {
-		fmt.Printf("TX Data for %s:\n", identifier)
-		fmt.Printf("\tTo:       %s\n", txInfo.To.Hex())
-		fmt.Printf("\tData:     %s\n", hexutil.Encode(txInfo.Data))
-		fmt.Printf("\tValue:    %s\n", txInfo.Value.String())
-		fmt.Printf("\tEst. Gas: %d\n", txInfo.SimulationResult.EstimatedGasLimit)
-		fmt.Printf("\tSafe Gas: %d\n", txInfo.SimulationResult.SafeGasLimit)
+		fmt.Fprintf(out, "TX Data for %s:\n", identifier)
+		fmt.Fprintf(out, "\tTo:       %s\n", txInfo.To.Hex())
+		fmt.Fprintf(out, "\tData:     %s\n", hexutil.Encode(txInfo.Data))
+		fmt.Fprintf(out, "\tValue:    %s\n", txInfo.Value.String())
+		fmt.Fprintf(out, "\tEst. Gas: %d\n", txInfo.SimulationResult.EstimatedGasLimit)
+		fmt.Fprintf(out, "\tSafe Gas: %d\n", txInfo.SimulationResult.SafeGasLimit)
 		return nil
 	}
 
@@ -54,21 +56,27 @@ func HandleTx(c *cli.Context, hd *client.HyperdriveClient, txInfo *eth.Transacti
 		if err != nil {
 			return fmt.Errorf("error signing transaction: %w", err)
 		}
-		fmt.Printf("Signed transaction (%s):\n", identifier)
-		fmt.Println(response.Data.SignedTx)
-		fmt.Println()
+		fmt.Fprintf(out, "Signed transaction (%s):\n", identifier)
+		fmt.Fprintln(out, response.Data.SignedTx)
+		fmt.Fprintln(out)
 		updateCustomNonce(hd)
 		return nil
 	}
 
 	// Confirm submission
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm(confirmMessage)) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(confirmMessage)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Submit it
-	fmt.Println(submissionMessage)
+	fmt.Fprintln(out, submissionMessage)
 	response, err := hd.Api.Tx.SubmitTx(submission, nonce, maxFee, maxPrioFee)
 	if err != nil {
 		return fmt.Errorf("error submitting transaction: %w", err)
@@ -86,6 +94,8 @@ func HandleTx(c *cli.Context, hd *client.HyperdriveClient, txInfo *eth.Transacti
 
 // Handle a batch of transactions, either printing their details, signing them, or submitting them and waiting for them to be included
 func HandleTxBatch(c *cli.Context, hd *client.HyperdriveClient, txInfos []*eth.TransactionInfo, confirmMessage string, identifierFunc func(int) string, submissionMessage string) error {
+	out := utils.GetOutput(c)
+
 	// Make sure the TXs were successful
 	for i, txInfo := range txInfos {
 		if txInfo.SimulationResult.SimulationError != "" {
@@ -96,13 +106,13 @@ func HandleTxBatch(c *cli.Context, hd *client.HyperdriveClient, txInfos []*eth.T
 	// Print the TX data if requested
 	if c.Bool(utils.PrintTxDataFlag.Name) {
 		for i, info := range txInfos {
-			fmt.Printf("Data for TX %d (%s):\n", i, identifierFunc(i))
-			fmt.Printf("\tTo:       %s\n", info.To.Hex())
-			fmt.Printf("\tData:     %s\n", hexutil.Encode(info.Data))
-			fmt.Printf("\tValue:    %s\n", info.Value.String())
-			fmt.Printf("\tEst. Gas: %d\n", info.SimulationResult.EstimatedGasLimit)
-			fmt.Printf("\tSafe Gas: %d\n", info.SimulationResult.SafeGasLimit)
-			fmt.Println()
+			fmt.Fprintf(out, "Data for TX %d (%s):\n", i, identifierFunc(i))
+			fmt.Fprintf(out, "\tTo:       %s\n", info.To.Hex())
+			fmt.Fprintf(out, "\tData:     %s\n", hexutil.Encode(info.Data))
+			fmt.Fprintf(out, "\tValue:    %s\n", info.Value.String())
+			fmt.Fprintf(out, "\tEst. Gas: %d\n", info.SimulationResult.EstimatedGasLimit)
+			fmt.Fprintf(out, "\tSafe Gas: %d\n", info.SimulationResult.SafeGasLimit)
+			fmt.Fprintln(out)
 		}
 		return nil
 	}
@@ -139,21 +149,27 @@ func HandleTxBatch(c *cli.Context, hd *client.HyperdriveClient, txInfos []*eth.T
 		}
 
 		for i, tx := range response.Data.SignedTxs {
-			fmt.Printf("Signed transaction (%s):\n", identifierFunc(i))
-			fmt.Println(tx)
-			fmt.Println()
+			fmt.Fprintf(out, "Signed transaction (%s):\n", identifierFunc(i))
+			fmt.Fprintln(out, tx)
+			fmt.Fprintln(out)
 		}
 		return nil
 	}
 
 	// Confirm submission
-	if !(c.Bool(utils.YesFlag.Name) || utils.Confirm(confirmMessage)) {
-		fmt.Println("Cancelled.")
-		return nil
+	if !c.Bool(utils.YesFlag.Name) {
+		confirmed, err := utils.Confirm(confirmMessage)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(out, "Cancelled.")
+			return nil
+		}
 	}
 
 	// Submit them
-	fmt.Println(submissionMessage)
+	fmt.Fprintln(out, submissionMessage)
 	response, err := hd.Api.Tx.SubmitTxBatch(submissions, nonce, maxFee, maxPrioFee)
 	if err != nil {
 		return fmt.Errorf("error submitting transactions: %w", err)
@@ -166,6 +182,7 @@ func HandleTxBatch(c *cli.Context, hd *client.HyperdriveClient, txInfos []*eth.T
 
 // Wait for a batch of transactions to get included in blocks
 func waitForTransactions(hd *client.HyperdriveClient, hashes []common.Hash, identifierFunc func(int) string) error {
+	out := hd.Context.Output
 	var wg errgroup.Group
 	var lock sync.Mutex
 	total := len(hashes)
@@ -181,7 +198,7 @@ func waitForTransactions(hd *client.HyperdriveClient, hashes []common.Hash, iden
 			}
 			lock.Lock()
 			successCount++
-			fmt.Printf("TX %s (%s) complete (%d/%d)\n", hash.Hex(), identifierFunc(i), successCount, total)
+			fmt.Fprintf(out, "TX %s (%s) complete (%d/%d)\n", hash.Hex(), identifierFunc(i), successCount, total)
 			lock.Unlock()
 			return nil
 		})
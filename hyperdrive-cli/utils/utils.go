@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"io"
 	"math/big"
 	"strconv"
 	"time"
@@ -9,13 +10,20 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/nodeset-org/eth-utils/eth"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/client"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/context"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-cli/utils/terminal"
 	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
 	"github.com/nodeset-org/hyperdrive/shared/utils"
 	"github.com/nodeset-org/hyperdrive/shared/utils/input"
 	"github.com/urfave/cli/v2"
 )
 
+// Get the writer that a command's human-readable output goes to
+func GetOutput(c *cli.Context) io.Writer {
+	return context.GetHyperdriveContext(c).Output
+}
+
 // Print a TX's details to the console.
 func PrintTransactionHash(hd *client.HyperdriveClient, hash common.Hash) {
 	finalMessage := "Waiting for the transaction to be included in a block... you may wait here for it, or press CTRL+C to exit and return to the terminal.\n\n"
@@ -30,33 +38,34 @@ func PrintTransactionHashNoCancel(hd *client.HyperdriveClient, hash common.Hash)
 
 // Print a batch of transaction hashes to the console.
 func PrintTransactionBatchHashes(hd *client.HyperdriveClient, hashes []common.Hash) {
+	out := hd.Context.Output
 	finalMessage := "Waiting for the transactions to be included in one or more blocks... you may wait here for them, or press CTRL+C to exit and return to the terminal.\n\n"
 
 	// Print the hashes
-	fmt.Println("Transactions have been submitted with the following hashes:")
+	fmt.Fprintln(out, "Transactions have been submitted with the following hashes:")
 	hashStrings := make([]string, len(hashes))
 	for i, hash := range hashes {
 		hashString := hash.String()
 		hashStrings[i] = hashString
-		fmt.Println(hashString)
+		fmt.Fprintln(out, hashString)
 	}
-	fmt.Println()
+	fmt.Fprintln(out)
 
 	txWatchUrl := getTxWatchUrl(hd)
 	if txWatchUrl != "" {
-		fmt.Println("You may follow their progress by visiting the following URLs in sequence:")
+		fmt.Fprintln(out, "You may follow their progress by visiting the following URLs in sequence:")
 		for _, hash := range hashStrings {
-			fmt.Printf("%s/%s\n", txWatchUrl, hash)
+			fmt.Fprintf(out, "%s/%s\n", txWatchUrl, hash)
 		}
 	}
-	fmt.Println()
+	fmt.Fprintln(out)
 
-	fmt.Print(finalMessage)
+	fmt.Fprint(out, finalMessage)
 }
 
 // Print a warning to the console if the user set a custom nonce, but this operation involves multiple transactions
-func PrintMultiTransactionNonceWarning() {
-	fmt.Printf("%sNOTE: You have specified the `nonce` flag to indicate a custom nonce for this transaction.\n"+
+func PrintMultiTransactionNonceWarning(out io.Writer) {
+	fmt.Fprintf(out, "%sNOTE: You have specified the `nonce` flag to indicate a custom nonce for this transaction.\n"+
 		"However, this operation requires multiple transactions.\n"+
 		"Hyperdrive will use your custom value as a basis, and increment it for each additional transaction.\n"+
 		"If you have multiple pending transactions, this MAY OVERRIDE more than the one that you specified.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
@@ -64,26 +73,28 @@ func PrintMultiTransactionNonceWarning() {
 
 // Implementation of PrintTransactionHash and PrintTransactionHashNoCancel
 func printTransactionHashImpl(hd *client.HyperdriveClient, hash common.Hash, finalMessage string) {
+	out := hd.Context.Output
 	txWatchUrl := getTxWatchUrl(hd)
 	hashString := hash.String()
-	fmt.Printf("Transaction has been submitted with hash %s.\n", hashString)
+	fmt.Fprintf(out, "Transaction has been submitted with hash %s.\n", hashString)
 	if txWatchUrl != "" {
-		fmt.Printf("You may follow its progress by visiting:\n")
-		fmt.Printf("%s/%s\n\n", txWatchUrl, hashString)
+		fmt.Fprintf(out, "You may follow its progress by visiting:\n")
+		fmt.Fprintf(out, "%s/%s\n\n", txWatchUrl, hashString)
 	}
-	fmt.Print(finalMessage)
+	fmt.Fprint(out, finalMessage)
 }
 
 // Get the URL for watching the transaction in a block explorer
 func getTxWatchUrl(hd *client.HyperdriveClient) string {
+	out := hd.Context.Output
 	cfg, isNew, err := hd.LoadConfig()
 	if err != nil {
-		fmt.Printf("Warning: couldn't read config file so the transaction URL will be unavailable (%s).\n", err)
+		fmt.Fprintf(out, "Warning: couldn't read config file so the transaction URL will be unavailable (%s).\n", err)
 		return ""
 	}
 
 	if isNew {
-		fmt.Print("Settings file not found. Please run `hyperdrive service config` to set up Hyperdrive.")
+		fmt.Fprint(out, "Settings file not found. Please run `hyperdrive service config` to set up Hyperdrive.")
 		return ""
 	}
 	resources := utils.NewResources(cfg.Hyperdrive.Network.Value)
@@ -114,37 +125,37 @@ func GetPrettyAddress(address common.Address) string {
 }
 
 // Prints an error message when the Beacon client is not using the deposit contract address that Hyperdrive expects
-func PrintDepositMismatchError(rpNetwork, beaconNetwork uint64, rpDepositAddress, beaconDepositAddress common.Address) {
-	fmt.Printf("%s***ALERT***\n", terminal.ColorRed)
-	fmt.Println("YOUR ETH2 CLIENT IS NOT CONNECTED TO THE SAME NETWORK THAT HYPERDRIVE IS USING!")
-	fmt.Println("This is likely because your ETH2 client is using the wrong configuration.")
-	fmt.Println("For the safety of your funds, Hyperdrive will not let you deposit your ETH until this is resolved.")
-	fmt.Println()
-	fmt.Println("To fix it if you are in Docker mode:")
-	fmt.Println("\t1. Run 'hyperdrive service install -d' to get the latest configuration")
-	fmt.Println("\t2. Run 'hyperdrive service stop' and 'hyperdrive service start' to apply the configuration.")
-	fmt.Println("If you are using Hybrid or Native mode, please correct the network flags in your ETH2 launch script.")
-	fmt.Println()
-	fmt.Println("Details:")
-	fmt.Printf("\tHyperdrive expects deposit contract %s on chain %d.\n", rpDepositAddress.Hex(), rpNetwork)
-	fmt.Printf("\tYour Beacon client is using deposit contract %s on chain %d.%s\n", beaconDepositAddress.Hex(), beaconNetwork, terminal.ColorReset)
+func PrintDepositMismatchError(out io.Writer, rpNetwork, beaconNetwork uint64, rpDepositAddress, beaconDepositAddress common.Address) {
+	fmt.Fprintf(out, "%s***ALERT***\n", terminal.ColorRed)
+	fmt.Fprintln(out, "YOUR ETH2 CLIENT IS NOT CONNECTED TO THE SAME NETWORK THAT HYPERDRIVE IS USING!")
+	fmt.Fprintln(out, "This is likely because your ETH2 client is using the wrong configuration.")
+	fmt.Fprintln(out, "For the safety of your funds, Hyperdrive will not let you deposit your ETH until this is resolved.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "To fix it if you are in Docker mode:")
+	fmt.Fprintln(out, "\t1. Run 'hyperdrive service install -d' to get the latest configuration")
+	fmt.Fprintln(out, "\t2. Run 'hyperdrive service stop' and 'hyperdrive service start' to apply the configuration.")
+	fmt.Fprintln(out, "If you are using Hybrid or Native mode, please correct the network flags in your ETH2 launch script.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Details:")
+	fmt.Fprintf(out, "\tHyperdrive expects deposit contract %s on chain %d.\n", rpDepositAddress.Hex(), rpNetwork)
+	fmt.Fprintf(out, "\tYour Beacon client is using deposit contract %s on chain %d.%s\n", beaconDepositAddress.Hex(), beaconNetwork, terminal.ColorReset)
 }
 
 // Prints what network you're currently on
-func PrintNetwork(currentNetwork config.Network, isNew bool) error {
+func PrintNetwork(out io.Writer, currentNetwork config.Network, isNew bool) error {
 	if isNew {
 		return fmt.Errorf("Settings file not found. Please run `hyperdrive service config` to set up Hyperdrive.")
 	}
 
 	switch currentNetwork {
 	case config.Network_Mainnet:
-		fmt.Printf("Hyperdrive is currently using the %sEthereum Mainnet.%s\n\n", terminal.ColorGreen, terminal.ColorReset)
+		fmt.Fprintf(out, "Hyperdrive is currently using the %sEthereum Mainnet.%s\n\n", terminal.ColorGreen, terminal.ColorReset)
 	case config.Network_HoleskyDev:
-		fmt.Printf("Hyperdrive is currently using the %sHolesky Development Network.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
+		fmt.Fprintf(out, "Hyperdrive is currently using the %sHolesky Development Network.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
 	case config.Network_Holesky:
-		fmt.Printf("Hyperdrive is currently using the %sHolesky Test Network.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
+		fmt.Fprintf(out, "Hyperdrive is currently using the %sHolesky Test Network.%s\n\n", terminal.ColorYellow, terminal.ColorReset)
 	default:
-		fmt.Printf("%sYou are on an unexpected network [%v].%s\n\n", terminal.ColorYellow, currentNetwork, terminal.ColorReset)
+		fmt.Fprintf(out, "%sYou are on an unexpected network [%v].%s\n\n", terminal.ColorYellow, currentNetwork, terminal.ColorReset)
 	}
 
 	return nil
@@ -152,6 +163,7 @@ func PrintNetwork(currentNetwork config.Network, isNew bool) error {
 
 // Parses a string representing either a floating point value or a raw wei amount into a *big.Int
 func ParseFloat(c *cli.Context, name string, value string, isFraction bool) (*big.Int, error) {
+	out := GetOutput(c)
 	var floatValue float64
 	if c.Bool(RawFlag.Name) {
 		val, err := input.ValidateBigInt(name, value)
@@ -174,10 +186,48 @@ func ParseFloat(c *cli.Context, name string, value string, isFraction bool) (*bi
 	}
 
 	trueVal := eth.EthToWei(floatValue)
-	fmt.Printf("Your value will be multiplied by 10^18 to be used in the contracts, which results in:\n\n\t[%s]\n\n", trueVal.String())
-	if !(c.Bool("yes") || Confirm("Please make sure this is what you want and does not have any floating-point errors.\n\nIs this result correct?")) {
-		fmt.Printf("Cancelled. Please try again with the '--%s' flag and provide an explicit value instead.\n", RawFlag.Name)
-		return nil, nil
+	fmt.Fprintf(out, "Your value will be multiplied by 10^18 to be used in the contracts, which results in:\n\n\t[%s]\n\n", trueVal.String())
+	if !c.Bool("yes") {
+		confirmed, err := Confirm("Please make sure this is what you want and does not have any floating-point errors.\n\nIs this result correct?")
+		if err != nil {
+			return nil, err
+		}
+		if !confirmed {
+			fmt.Fprintf(out, "Cancelled. Please try again with the '--%s' flag and provide an explicit value instead.\n", RawFlag.Name)
+			return nil, nil
+		}
 	}
 	return trueVal, nil
 }
+
+// Print the status of a daemon's tasks to the console
+func PrintTaskStatuses(out io.Writer, tasks []api.TaskStatus) {
+	if len(tasks) == 0 {
+		fmt.Fprintln(out, "The daemon doesn't have any tasks registered.")
+		return
+	}
+	for _, task := range tasks {
+		fmt.Fprintf(out, "%s%s%s: %s\n", terminal.ColorBold, task.Name, terminal.ColorReset, task.Description)
+		fmt.Fprintf(out, "\tInterval:  %s\n", task.Interval)
+		switch {
+		case task.LastRunTime.IsZero():
+			fmt.Fprintln(out, "\tLast run:  never")
+		case task.IsRunning:
+			fmt.Fprintf(out, "\tLast run:  %sstarted %s, still running%s\n", terminal.ColorYellow, task.LastRunTime.Format(time.RFC822), terminal.ColorReset)
+		default:
+			fmt.Fprintf(out, "\tLast run:  %s (took %s)\n", task.LastRunTime.Format(time.RFC822), task.LastRunDuration.Round(time.Millisecond))
+		}
+		switch task.LastResult {
+		case api.TaskResult_Succeeded:
+			fmt.Fprintf(out, "\tResult:    %s%s%s\n", terminal.ColorGreen, task.LastResult, terminal.ColorReset)
+		case api.TaskResult_Skipped:
+			fmt.Fprintf(out, "\tResult:    %s%s%s (%s)\n", terminal.ColorYellow, task.LastResult, terminal.ColorReset, task.LastError)
+		case api.TaskResult_Failed, api.TaskResult_TimedOut:
+			fmt.Fprintf(out, "\tResult:    %s%s: %s%s\n", terminal.ColorRed, task.LastResult, task.LastError, terminal.ColorReset)
+		}
+		if !task.IsRunning {
+			fmt.Fprintf(out, "\tNext run:  %s\n", task.NextRunTime.Format(time.RFC822))
+		}
+		fmt.Fprintln(out)
+	}
+}
@@ -0,0 +1,205 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+// An independent Beacon Node API used for checkpoint verification
+type checkpointSource struct {
+	// The URL of the source with any credentials removed, so it's safe to display
+	displayUrl string
+	client     types.IBeaconClient
+}
+
+// Verifies the local Beacon Node's finalized checkpoint against independent Beacon Node APIs, so a BN that
+// checkpoint synced from a faulty or malicious provider can be caught before its validators follow it
+type CheckpointVerifier struct {
+	localBc        types.IBeaconClient
+	sources        []checkpointSource
+	ignoreMismatch bool
+
+	// The result of the latest verification
+	status api.CheckpointVerificationStatus
+	lock   *sync.Mutex
+}
+
+// Creates a new checkpoint verifier from the Hyperdrive config. Verification is only enabled in local mode when
+// at least one verification URL has been provided.
+func NewCheckpointVerifier(cfg *config.HyperdriveConfig) *CheckpointVerifier {
+	v := &CheckpointVerifier{
+		sources: []checkpointSource{},
+		lock:    &sync.Mutex{},
+	}
+	if !cfg.IsLocalMode() {
+		return v
+	}
+
+	// Create the sources
+	bnCfg := cfg.LocalBeaconConfig
+	for _, sourceUrl := range strings.Split(bnCfg.CheckpointVerificationUrls.Value, ",") {
+		sourceUrl = strings.TrimSuffix(strings.TrimSpace(sourceUrl), "/")
+		if sourceUrl == "" {
+			continue
+		}
+		v.sources = append(v.sources, checkpointSource{
+			displayUrl: getDisplayUrl(sourceUrl),
+			client:     beacon.NewStandardHttpClient(sourceUrl, config.ClientTimeout),
+		})
+	}
+	if len(v.sources) == 0 {
+		return v
+	}
+
+	// Talk to the local BN directly so the fallback client is never the one being verified
+	v.localBc = beacon.NewStandardHttpClient(cfg.GetBnHttpEndpoint(), config.ClientTimeout)
+	v.ignoreMismatch = bnCfg.IgnoreCheckpointMismatch.Value
+	v.status = api.CheckpointVerificationStatus{
+		Enabled:      true,
+		IsOverridden: v.ignoreMismatch,
+		Sources:      []api.CheckpointSourceStatus{},
+	}
+	return v
+}
+
+// True if there is at least one independent source to verify against
+func (v *CheckpointVerifier) IsEnabled() bool {
+	return len(v.sources) > 0
+}
+
+// Get the result of the latest verification
+func (v *CheckpointVerifier) GetStatus() api.CheckpointVerificationStatus {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.status
+}
+
+// True if the latest verification found a mismatch and the user hasn't overridden it, meaning the Validator Clients
+// must not be allowed to run
+func (v *CheckpointVerifier) IsBlockingValidators() bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.status.IsMismatched && !v.ignoreMismatch
+}
+
+// Compare the local BN's finalized checkpoint against each of the independent sources and store the result
+func (v *CheckpointVerifier) Verify(ctx context.Context) api.CheckpointVerificationStatus {
+	status := api.CheckpointVerificationStatus{
+		Enabled:      v.IsEnabled(),
+		HasChecked:   true,
+		CheckTime:    time.Now(),
+		IsOverridden: v.ignoreMismatch,
+		Sources:      []api.CheckpointSourceStatus{},
+	}
+	if !status.Enabled {
+		return status
+	}
+
+	// Get the local finalized checkpoint
+	local, err := v.localBc.GetFinalityCheckpoints(ctx, "head")
+	if err != nil {
+		status.Error = fmt.Sprintf("error getting the finalized checkpoint from your Beacon Node: %s", err.Error())
+		v.setStatus(status)
+		return status
+	}
+	status.LocalEpoch = local.Finalized.Epoch
+	status.LocalRoot = local.Finalized.Root
+
+	// Compare against each source; sources that can't be reached don't count towards either result
+	matchCount := 0
+	for _, source := range v.sources {
+		sourceStatus := v.checkSource(ctx, source, local.Finalized)
+		if sourceStatus.Error == "" {
+			if sourceStatus.IsMatched {
+				matchCount++
+			} else {
+				status.IsMismatched = true
+			}
+		}
+		status.Sources = append(status.Sources, sourceStatus)
+	}
+	status.IsVerified = matchCount > 0 && !status.IsMismatched
+
+	v.setStatus(status)
+	return status
+}
+
+// Compare the local finalized checkpoint against a single source
+func (v *CheckpointVerifier) checkSource(ctx context.Context, source checkpointSource, local types.Checkpoint) api.CheckpointSourceStatus {
+	sourceStatus := api.CheckpointSourceStatus{
+		Url: source.displayUrl,
+	}
+	remoteCheckpoints, err := source.client.GetFinalityCheckpoints(ctx, "head")
+	if err != nil {
+		sourceStatus.Error = err.Error()
+		return sourceStatus
+	}
+	remote := remoteCheckpoints.Finalized
+	sourceStatus.Epoch = remote.Epoch
+	sourceStatus.Root = remote.Root
+
+	switch {
+	case remote.Epoch == local.Epoch:
+		sourceStatus.IsMatched = (remote.Root == local.Root)
+
+	case remote.Epoch > local.Epoch:
+		// The source has finalized further, so the local checkpoint must be on its canonical chain
+		header, exists, err := source.client.GetBeaconBlockHeader(ctx, local.Root.Hex())
+		if err != nil {
+			sourceStatus.Error = err.Error()
+			return sourceStatus
+		}
+		sourceStatus.IsMatched = exists && header.Canonical
+
+	default:
+		// The local BN has finalized further, so the source's checkpoint must be on its canonical chain
+		matched, err := isCanonicalBlock(ctx, v.localBc, remote.Root)
+		if err != nil {
+			sourceStatus.Error = err.Error()
+			return sourceStatus
+		}
+		sourceStatus.IsMatched = matched
+	}
+	return sourceStatus
+}
+
+// Store the latest verification result
+func (v *CheckpointVerifier) setStatus(status api.CheckpointVerificationStatus) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.status = status
+}
+
+// Check if a block is on the local BN's canonical chain. A BN that just checkpoint synced won't have any blocks
+// from before its anchor, so a missing block is treated as inconclusive rather than a mismatch.
+func isCanonicalBlock(ctx context.Context, bc types.IBeaconClient, root ethcommon.Hash) (bool, error) {
+	header, exists, err := bc.GetBeaconBlockHeader(ctx, root.Hex())
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, fmt.Errorf("block %s is not known to your Beacon Node yet", root.Hex())
+	}
+	return header.Canonical, nil
+}
+
+// Remove any credentials from a URL so it can be displayed
+func getDisplayUrl(rawUrl string) string {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return "<invalid URL>"
+	}
+	parsedUrl.User = nil
+	parsedUrl.RawQuery = ""
+	return parsedUrl.String()
+}
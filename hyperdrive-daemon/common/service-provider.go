@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,6 +11,10 @@ import (
 	"github.com/fatih/color"
 	"github.com/mitchellh/go-homedir"
 	"github.com/nodeset-org/eth-utils/eth"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/audit"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/scheduler"
 	"github.com/nodeset-org/hyperdrive/daemon-utils/services"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common/wallet"
 	"github.com/nodeset-org/hyperdrive/shared/config"
@@ -33,6 +38,11 @@ type ServiceProvider struct {
 	txMgr      *eth.TransactionManager
 	queryMgr   *eth.QueryManager
 	resources  *utils.Resources
+	notifier   *notifications.Notifier
+	cpVerifier *CheckpointVerifier
+	scheduler  *scheduler.Scheduler
+	events     *events.EventBus
+	auditLog   *audit.AuditLog
 
 	// TODO: find a better place for this than the common service provider
 	apiLogger    *log.ColorLogger
@@ -40,6 +50,10 @@ type ServiceProvider struct {
 
 	// Path info
 	userDir string
+
+	// Context for background work that should stop when the daemon shuts down
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // Creates a new ServiceProvider instance
@@ -58,9 +72,21 @@ func NewServiceProvider(userDir string) (*ServiceProvider, error) {
 	apiLogger := log.NewColorLogger(apiLogColor)
 	walletLogger := log.NewColorLogger(walletLogColor)
 
+	// Settings saved by older versions are only warned about, so nodes with settings those versions accepted keep running after upgrading
+	err = config.JoinValidationErrors(cfg.Validate(nil))
+	if err != nil {
+		if !cfg.IsFromOlderVersion() {
+			return nil, fmt.Errorf("hyperdrive config settings file [%s] is invalid: %w", cfgPath, err)
+		}
+		apiLogger.Printlnf("WARNING: hyperdrive config settings file [%s] is invalid, please correct it with `hyperdrive service config`: %s", cfgPath, err.Error())
+	}
+
 	// Resources
 	resources := utils.NewResources(cfg.Network.Value)
 
+	// Event bus
+	bus := events.NewEventBus(config.HyperdriveDaemonRoute)
+
 	// Wallet
 	userDataPath, err := homedir.Expand(cfg.UserDataPath.Value)
 	if err != nil {
@@ -69,19 +95,25 @@ func NewServiceProvider(userDir string) (*ServiceProvider, error) {
 	nodeAddressPath := filepath.Join(userDataPath, config.UserAddressFilename)
 	walletDataPath := filepath.Join(userDataPath, config.UserWalletDataFilename)
 	passwordPath := filepath.Join(userDataPath, config.UserPasswordFilename)
-	nodeWallet, err := wallet.NewWallet(&walletLogger, walletDataPath, nodeAddressPath, passwordPath, resources.ChainID)
+	nodeWallet, err := wallet.NewWallet(&walletLogger, bus, walletDataPath, nodeAddressPath, passwordPath, resources.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("error creating node wallet: %w", err)
 	}
 
+	// Audit log
+	auditLog, err := audit.NewAuditLog(filepath.Join(userDataPath, config.UserAuditLogFilename))
+	if err != nil {
+		return nil, fmt.Errorf("error loading audit log: %w", err)
+	}
+
 	// EC Manager
-	ecManager, err := services.NewExecutionClientManager(cfg)
+	ecManager, err := services.NewExecutionClientManager(cfg, bus)
 	if err != nil {
 		return nil, fmt.Errorf("error creating executon client manager: %w", err)
 	}
 
 	// Beacon manager
-	bcManager, err := services.NewBeaconClientManager(cfg)
+	bcManager, err := services.NewBeaconClientManager(cfg, bus)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Beacon client manager: %w", err)
 	}
@@ -105,8 +137,17 @@ func NewServiceProvider(userDir string) (*ServiceProvider, error) {
 	}
 	queryMgr := eth.NewQueryManager(ecManager, resources.MulticallAddress, concurrentCallLimit)
 
+	// Notifier
+	notifier := notifications.NewNotifier(cfg, config.HyperdriveDaemonRoute)
+
+	// Checkpoint verifier
+	cpVerifier := NewCheckpointVerifier(cfg)
+
 	// Create the provider
+	ctx, cancel := context.WithCancel(context.Background())
 	provider := &ServiceProvider{
+		ctx:        ctx,
+		cancel:     cancel,
 		userDir:    userDir,
 		cfg:        cfg,
 		nodeWallet: nodeWallet,
@@ -116,8 +157,15 @@ func NewServiceProvider(userDir string) (*ServiceProvider, error) {
 		resources:  resources,
 		txMgr:      txMgr,
 		queryMgr:   queryMgr,
+		notifier:   notifier,
+		cpVerifier: cpVerifier,
+		events:     bus,
+		auditLog:   auditLog,
 		apiLogger:  &apiLogger,
 	}
+
+	// Task scheduler
+	provider.scheduler = scheduler.NewScheduler(provider, bus)
 	return provider, nil
 }
 
@@ -125,6 +173,16 @@ func NewServiceProvider(userDir string) (*ServiceProvider, error) {
 // === Getters ===
 // ===============
 
+// Get the context that's cancelled when the daemon shuts down
+func (p *ServiceProvider) GetBaseContext() context.Context {
+	return p.ctx
+}
+
+// Cancel the base context, stopping any background work tied to it
+func (p *ServiceProvider) CancelContextOnShutdown() {
+	p.cancel()
+}
+
 func (p *ServiceProvider) GetUserDir() string {
 	return p.userDir
 }
@@ -161,6 +219,26 @@ func (p *ServiceProvider) GetQueryManager() *eth.QueryManager {
 	return p.queryMgr
 }
 
+func (p *ServiceProvider) GetNotifier() *notifications.Notifier {
+	return p.notifier
+}
+
+func (p *ServiceProvider) GetCheckpointVerifier() *CheckpointVerifier {
+	return p.cpVerifier
+}
+
+func (p *ServiceProvider) GetEventBus() *events.EventBus {
+	return p.events
+}
+
+func (p *ServiceProvider) GetAuditLog() *audit.AuditLog {
+	return p.auditLog
+}
+
+func (p *ServiceProvider) GetTaskScheduler() *scheduler.Scheduler {
+	return p.scheduler
+}
+
 func (p *ServiceProvider) GetApiLogger() *log.ColorLogger {
 	return p.apiLogger
 }
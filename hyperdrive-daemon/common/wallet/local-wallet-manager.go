@@ -6,6 +6,7 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/goccy/go-json"
 
@@ -17,9 +18,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/google/uuid"
 	"github.com/nodeset-org/hyperdrive/daemon-utils/validator/utils"
 	sharedtypes "github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/utils/slip39"
 	"github.com/tyler-smith/go-bip39"
 	eth2util "github.com/wealdtech/go-eth2-util"
 	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
@@ -96,6 +99,57 @@ func (m *LocalWalletManager) GetEthKeystore(password string) ([]byte, error) {
 	return ethkey, nil
 }
 
+// Get a secondary account derived from the wallet's seed at the provided path in Geth keystore format, along with its address.
+// The path must be under the secondary account path prefix, and the account can never be the node account.
+func (m *LocalWalletManager) GetSecondaryEthKeystore(path string, password string) ([]byte, common.Address, error) {
+	if len(m.seed) == 0 || m.nodePrivateKey == nil {
+		return nil, common.Address{}, fmt.Errorf("wallet is not initialized")
+	}
+	if !strings.HasPrefix(path, sharedtypes.SecondaryAccountPathPrefix) {
+		return nil, common.Address{}, fmt.Errorf("secondary account path [%s] must start with [%s]", path, sharedtypes.SecondaryAccountPathPrefix)
+	}
+
+	// Derive the key
+	masterKey, err := hdkeychain.NewMaster(m.seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("error creating wallet master key: %w", err)
+	}
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("invalid secondary account path [%s]: %w", path, err)
+	}
+	key := masterKey
+	for i, n := range derivationPath {
+		key, err = key.Derive(n)
+		if err != nil {
+			return nil, common.Address{}, fmt.Errorf("invalid child key at depth %d of secondary account path [%s]: %w", i, path, err)
+		}
+	}
+	privateKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("error getting secondary account private key: %w", err)
+	}
+	privateKeyECDSA := privateKey.ToECDSA()
+
+	// Never hand out the node key, no matter what path was used to derive the node wallet
+	address := crypto.PubkeyToAddress(privateKeyECDSA.PublicKey)
+	if address == crypto.PubkeyToAddress(m.nodePrivateKey.PublicKey) {
+		return nil, common.Address{}, fmt.Errorf("secondary account path [%s] resolves to the node account", path)
+	}
+
+	// Serialize it
+	gethKey := &gethkeystore.Key{
+		Address:    address,
+		PrivateKey: privateKeyECDSA,
+		Id:         uuid.New(),
+	}
+	ethkey, err := gethkeystore.EncryptKey(gethKey, password, gethkeystore.StandardScryptN, gethkeystore.StandardScryptP)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("error serializing secondary account keystore: %w", err)
+	}
+	return ethkey, address, nil
+}
+
 // Get the transactor for the wallet
 func (m *LocalWalletManager) GetTransactor() (*bind.TransactOpts, error) {
 	if m.transactor == nil {
@@ -108,7 +162,11 @@ func (m *LocalWalletManager) GetTransactor() (*bind.TransactOpts, error) {
 func (m *LocalWalletManager) InitializeKeystore(derivationPath string, walletIndex uint, mnemonic string, password string) (*sharedtypes.LocalWalletData, error) {
 	// Generate the seed from the mnemonic
 	seed := bip39.NewSeed(mnemonic, "")
+	return m.InitializeKeystoreFromSeed(derivationPath, walletIndex, seed, password)
+}
 
+// Initialize a new keystore from a raw seed and derivation info, derive the corresponding key, and load it all up
+func (m *LocalWalletManager) InitializeKeystoreFromSeed(derivationPath string, walletIndex uint, seed []byte, password string) (*sharedtypes.LocalWalletData, error) {
 	// Encrypt the seed with the password
 	encryptedSeed, err := m.encryptor.Encrypt(seed, password)
 	if err != nil {
@@ -134,6 +192,18 @@ func (m *LocalWalletManager) InitializeKeystore(derivationPath string, walletInd
 	return data, nil
 }
 
+// Split the wallet's seed into SLIP-39 share mnemonics, any threshold of which can be combined to recover it
+func (m *LocalWalletManager) GetSeedShares(threshold int, count int) ([]string, error) {
+	if len(m.seed) == 0 {
+		return nil, fmt.Errorf("wallet is not initialized")
+	}
+	shares, err := slip39.GenerateShares(m.seed, "", threshold, count)
+	if err != nil {
+		return nil, fmt.Errorf("error splitting wallet seed into shares: %w", err)
+	}
+	return shares, nil
+}
+
 // Verifies that the provided password is correct for this wallet's keystore
 func (m *LocalWalletManager) VerifyPassword(password string) (bool, error) {
 	if m.data == nil {
@@ -152,6 +222,60 @@ func (m *LocalWalletManager) VerifyPassword(password string) (bool, error) {
 	return bytes.Equal(trueBytes, candidateBytes), nil
 }
 
+// Re-encrypt the wallet's seed with a new password, optionally switching to a different KDF (leave it blank to keep the current one).
+// The seed is always encrypted with the keystore library's default KDF parameters, so this doesn't change the cost of the KDF.
+// The new keystore data is passed to save, and the manager only switches to it once it's been saved successfully.
+func (m *LocalWalletManager) ChangePassword(oldPassword string, newPassword string, kdf string, save func(*sharedtypes.LocalWalletData) error) error {
+	isValid, err := m.VerifyPassword(oldPassword)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return fmt.Errorf("provided password is not correct for the loaded wallet")
+	}
+
+	// Get the encryptor to use
+	encryptor := m.encryptor
+	if kdf == "" {
+		kdf, _ = getKdf(m.data.Crypto)
+	}
+	switch kdf {
+	case sharedtypes.KeystoreKdf_Pbkdf2, sharedtypes.KeystoreKdf_Scrypt:
+		encryptor = eth2ks.New(eth2ks.WithCipher(kdf))
+	case "":
+	default:
+		return fmt.Errorf("unsupported KDF [%s]", kdf)
+	}
+
+	// Encrypt the seed with the new password
+	encryptedSeed, err := encryptor.Encrypt(m.seed, newPassword)
+	if err != nil {
+		return fmt.Errorf("error encrypting wallet seed: %w", err)
+	}
+	data := *m.data
+	data.Crypto = encryptedSeed
+	data.Name = encryptor.Name()
+	data.Version = encryptor.Version()
+
+	// Make sure the new keystore decrypts to the same wallet before handing it back
+	candidateMgr := NewLocalWalletManager(0)
+	err = candidateMgr.LoadWallet(&data, newPassword)
+	if err != nil {
+		return fmt.Errorf("error verifying re-encrypted wallet: %w", err)
+	}
+	if !bytes.Equal(crypto.FromECDSA(m.nodePrivateKey), crypto.FromECDSA(candidateMgr.nodePrivateKey)) {
+		return fmt.Errorf("re-encrypted wallet doesn't match the loaded wallet")
+	}
+
+	err = save(&data)
+	if err != nil {
+		return err
+	}
+	m.encryptor = encryptor
+	m.data = &data
+	return nil
+}
+
 // Load the node wallet's private key from the keystore
 func (m *LocalWalletManager) LoadWallet(data *sharedtypes.LocalWalletData, password string) error {
 	// Decrypt the seed
@@ -214,6 +338,22 @@ func (m *LocalWalletManager) SignMessage(message []byte) ([]byte, error) {
 	return signedMessage, nil
 }
 
+// Signs EIP-712 typed data with the node wallet's private key
+func (m *LocalWalletManager) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing typed data: %w", err)
+	}
+	signature, err := crypto.Sign(hash, m.nodePrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error signing typed data: %w", err)
+	}
+
+	// fix the ECDSA 'v' the same way as personal_sign
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
 // Signs a transaction with the node wallet's private key
 func (m *LocalWalletManager) SignTransaction(serializedTx []byte) ([]byte, error) {
 	tx := types.Transaction{}
@@ -270,6 +410,16 @@ func (m *LocalWalletManager) GenerateValidatorKey(path string) ([]byte, error) {
 	return privateKey.Marshal(), nil
 }
 
+// Get the name of the KDF used by an encrypted seed
+func getKdf(encryptedSeed map[string]interface{}) (string, bool) {
+	kdf, ok := encryptedSeed["kdf"].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	function, ok := kdf["function"].(string)
+	return function, ok
+}
+
 // Get the derived key & derivation path for the account at the index
 func getDerivedKey(masterKey *hdkeychain.ExtendedKey, derivationPath string, index uint) (*hdkeychain.ExtendedKey, uint, error) {
 	formattedDerivationPath := fmt.Sprintf(derivationPath, index)
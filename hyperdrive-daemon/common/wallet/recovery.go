@@ -4,14 +4,15 @@ import (
 	"fmt"
 
 	"github.com/fatih/color"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
 	"github.com/nodeset-org/hyperdrive/shared/utils/log"
 )
 
 // Recover a wallet keystore from a mnemonic - only used for testing mnemonics
 func TestRecovery(derivationPath string, walletIndex uint, mnemonic string, chainID uint) (*Wallet, error) {
-	// Create a new dummy wallet with a fake password
+	// Create a new dummy wallet with a fake password, and a bus nothing listens to so it doesn't publish wallet events
 	log := log.NewColorLogger(color.FgHiWhite)
-	w, err := NewWallet(&log, "", "", "", chainID)
+	w, err := NewWallet(&log, events.NewEventBus(""), "", "", "", chainID)
 	if err != nil {
 		return nil, fmt.Errorf("error creating new test node wallet: %w", err)
 	}
@@ -22,3 +23,19 @@ func TestRecovery(derivationPath string, walletIndex uint, mnemonic string, chai
 	}
 	return w, nil
 }
+
+// Recover a wallet keystore from SLIP-39 share mnemonics - only used for testing shares
+func TestRecoveryFromShares(derivationPath string, walletIndex uint, shares []string, chainID uint) (*Wallet, error) {
+	// Create a new dummy wallet with a fake password, and a bus nothing listens to so it doesn't publish wallet events
+	log := log.NewColorLogger(color.FgHiWhite)
+	w, err := NewWallet(&log, events.NewEventBus(""), "", "", "", chainID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new test node wallet: %w", err)
+	}
+
+	err = w.RecoverFromShares(derivationPath, walletIndex, shares, "test password", false, true)
+	if err != nil {
+		return nil, fmt.Errorf("error test recovering shares: %w", err)
+	}
+	return w, nil
+}
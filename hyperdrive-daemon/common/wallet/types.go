@@ -3,6 +3,7 @@ package wallet
 import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/nodeset-org/hyperdrive/shared/types"
 )
 
@@ -20,6 +21,9 @@ type IWalletManager interface {
 	// Sign a message with the wallet's private key
 	SignMessage(message []byte) ([]byte, error)
 
+	// Sign EIP-712 typed data with the wallet's private key
+	SignTypedData(typedData apitypes.TypedData) ([]byte, error)
+
 	// Sign a transaction with the wallet's private key
 	SignTransaction(serializedTx []byte) ([]byte, error)
 
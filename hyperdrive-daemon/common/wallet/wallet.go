@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -11,8 +12,12 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
 	sharedtypes "github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
 	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+	"github.com/nodeset-org/hyperdrive/shared/utils/slip39"
 	"github.com/tyler-smith/go-bip39"
 )
 
@@ -23,6 +28,7 @@ const (
 	DefaultNodeKeyPath                   = "m/44'/60'/0'/0/%d"
 	LedgerLiveNodeKeyPath                = "m/44'/60'/%d/0/0"
 	MyEtherWalletNodeKeyPath             = "m/44'/60'/0'/%d"
+	WalletBackupSuffix                   = ".bak"
 	walletFileMode           fs.FileMode = 0600
 )
 
@@ -32,6 +38,7 @@ type Wallet struct {
 	walletManager   IWalletManager
 	addressManager  *AddressManager
 	passwordManager *PasswordManager
+	events          *events.EventBus
 
 	// Misc cache
 	chainID        uint
@@ -39,12 +46,13 @@ type Wallet struct {
 }
 
 // Create new wallet
-func NewWallet(log *log.ColorLogger, walletDataPath string, walletAddressPath string, passwordFilePath string, chainID uint) (*Wallet, error) {
+func NewWallet(log *log.ColorLogger, bus *events.EventBus, walletDataPath string, walletAddressPath string, passwordFilePath string, chainID uint) (*Wallet, error) {
 	// Create the wallet
 	w := &Wallet{
 		// Create managers
 		addressManager:  NewAddressManager(walletAddressPath),
 		passwordManager: NewPasswordManager(passwordFilePath),
+		events:          bus,
 
 		// Initialize other fields
 		chainID:        chainID,
@@ -63,7 +71,7 @@ func NewWallet(log *log.ColorLogger, walletDataPath string, walletAddressPath st
 		if err != nil {
 			log.Printlnf("[WALLET] Loading wallet with stored node password failed: %s", err.Error())
 		} else if walletMgr != nil {
-			w.walletManager = walletMgr
+			w.setWalletManager(walletMgr)
 		}
 	}
 
@@ -129,6 +137,14 @@ func (w *Wallet) SignMessage(message []byte) ([]byte, error) {
 	return w.walletManager.SignMessage(message)
 }
 
+// Sign EIP-712 typed data with the wallet's private key
+func (w *Wallet) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	if w.walletManager == nil {
+		return nil, fmt.Errorf("wallet is not loaded")
+	}
+	return w.walletManager.SignTypedData(typedData)
+}
+
 // Sign a transaction with the wallet's private key
 func (w *Wallet) SignTransaction(serializedTx []byte) ([]byte, error) {
 	if w.walletManager == nil {
@@ -169,7 +185,8 @@ func (w *Wallet) CreateNewLocalWallet(derivationPath string, walletIndex uint, p
 	}
 
 	// Initialize the wallet with it
-	err = w.buildLocalWallet(derivationPath, walletIndex, mnemonic, password, savePassword, false)
+	seed := bip39.NewSeed(mnemonic, "")
+	err = w.buildLocalWallet(derivationPath, walletIndex, seed, password, savePassword, false)
 	if err != nil {
 		return "", fmt.Errorf("error initializing new wallet keystore: %w", err)
 	}
@@ -187,7 +204,23 @@ func (w *Wallet) Recover(derivationPath string, walletIndex uint, mnemonic strin
 		return fmt.Errorf("invalid mnemonic '%s'", mnemonic)
 	}
 
-	return w.buildLocalWallet(derivationPath, walletIndex, mnemonic, password, savePassword, testMode)
+	seed := bip39.NewSeed(mnemonic, "")
+	return w.buildLocalWallet(derivationPath, walletIndex, seed, password, savePassword, testMode)
+}
+
+// Recover a local wallet from SLIP-39 share mnemonics that were exported from it
+func (w *Wallet) RecoverFromShares(derivationPath string, walletIndex uint, shares []string, password string, savePassword bool, testMode bool) error {
+	if w.walletManager != nil {
+		return fmt.Errorf("wallet keystore is already present - please delete it before recovering an existing wallet")
+	}
+
+	// Combine the shares back into the seed
+	seed, err := slip39.CombineShares(shares, "")
+	if err != nil {
+		return fmt.Errorf("error combining shares: %w", err)
+	}
+
+	return w.buildLocalWallet(derivationPath, walletIndex, seed, password, savePassword, testMode)
 }
 
 // Attempts to load the wallet keystore with the provided password if not set
@@ -238,10 +271,97 @@ func (w *Wallet) SetPassword(password string, save bool) error {
 	}
 
 	// Set the wallet manager
-	w.walletManager = mgr
+	w.setWalletManager(mgr)
+	return nil
+}
+
+// Re-encrypt the loaded wallet with a new password, optionally switching to a different KDF.
+// The old wallet file is backed up until the new one has been written and loaded back successfully, and the saved password is updated if there is one.
+func (w *Wallet) ChangePassword(oldPassword string, newPassword string, kdf string) error {
+	if w.walletManager == nil {
+		return fmt.Errorf("wallet is not loaded")
+	}
+	if w.walletManager.GetType() != sharedtypes.WalletType_Local {
+		return fmt.Errorf("loaded wallet is not local and does not use a password")
+	}
+	localMgr := w.walletManager.(*LocalWalletManager)
+
+	// Re-encrypt the keystore, backing up the old file while it's replaced
+	err := localMgr.ChangePassword(oldPassword, newPassword, kdf, func(localData *sharedtypes.LocalWalletData) error {
+		oldBytes, err := os.ReadFile(w.walletDataPath)
+		if err != nil {
+			return fmt.Errorf("error reading wallet data at [%s]: %w", w.walletDataPath, err)
+		}
+		backupPath := w.walletDataPath + WalletBackupSuffix
+		err = os.WriteFile(backupPath, oldBytes, walletFileMode)
+		if err != nil {
+			return fmt.Errorf("error backing up wallet data to [%s]: %w", backupPath, err)
+		}
+		err = w.saveWalletDataAtomically(&sharedtypes.WalletData{
+			Type:      sharedtypes.WalletType_Local,
+			LocalData: *localData,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Make sure the new file loads with the new password before getting rid of the old one
+		err = w.verifySavedWallet(localMgr, newPassword)
+		if err != nil {
+			restoreErr := os.Rename(backupPath, w.walletDataPath)
+			if restoreErr != nil {
+				return fmt.Errorf("%w; restoring the old wallet file from [%s] also failed: %w", err, backupPath, restoreErr)
+			}
+			return err
+		}
+
+		// The backup is still encrypted with the old password, so it can't be left behind
+		err = os.Remove(backupPath)
+		if err != nil {
+			return fmt.Errorf("wallet was re-encrypted but the backup of the old wallet file at [%s] couldn't be deleted: %w", backupPath, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error changing wallet password: %w", err)
+	}
+
+	// Update the saved password
+	_, isPasswordSaved, err := w.passwordManager.GetPasswordFromDisk()
+	if err != nil {
+		return fmt.Errorf("error checking for saved password: %w", err)
+	}
+	if isPasswordSaved {
+		err = w.passwordManager.SavePassword(newPassword)
+		if err != nil {
+			return fmt.Errorf("wallet was re-encrypted but the saved password couldn't be updated, so it won't load after a restart until you set the new password: %w", err)
+		}
+	}
 	return nil
 }
 
+// Split the loaded wallet's seed into SLIP-39 share mnemonics, any threshold of which can be combined to recover the wallet.
+// The wallet's password is required as confirmation.
+func (w *Wallet) ExportShares(password string, threshold int, count int) ([]string, error) {
+	if w.walletManager == nil {
+		return nil, fmt.Errorf("wallet is not loaded")
+	}
+	if w.walletManager.GetType() != sharedtypes.WalletType_Local {
+		return nil, fmt.Errorf("loaded wallet is not local")
+	}
+	localMgr := w.walletManager.(*LocalWalletManager)
+
+	// Make sure the password is correct
+	isValid, err := localMgr.VerifyPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying password: %w", err)
+	}
+	if !isValid {
+		return nil, fmt.Errorf("provided password is not correct for the loaded wallet")
+	}
+	return localMgr.GetSeedShares(threshold, count)
+}
+
 // Retrieves the wallet's password
 func (w *Wallet) GetPassword() (string, bool, error) {
 	return w.passwordManager.GetPasswordFromDisk()
@@ -256,6 +376,28 @@ func (w *Wallet) DeletePassword() error {
 	return nil
 }
 
+// Unload the wallet and delete its keystore and password from disk, so the node has no wallet until it's recovered or initialized again
+func (w *Wallet) Forget() error {
+	err := os.Remove(w.walletDataPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting wallet data at [%s]: %w", w.walletDataPath, err)
+	}
+	_, isPasswordSaved, err := w.passwordManager.GetPasswordFromDisk()
+	if err != nil {
+		return fmt.Errorf("error checking for saved password: %w", err)
+	}
+	if isPasswordSaved {
+		err = w.passwordManager.DeletePassword()
+		if err != nil {
+			return fmt.Errorf("error deleting wallet password: %w", err)
+		}
+	}
+	if w.walletManager != nil {
+		w.setWalletManager(nil)
+	}
+	return nil
+}
+
 // Get the node account private key bytes
 func (w *Wallet) GetNodePrivateKeyBytes() ([]byte, error) {
 	if w.walletManager == nil {
@@ -286,6 +428,21 @@ func (w *Wallet) GetEthKeystore(password string) ([]byte, error) {
 	}
 }
 
+// Get a secondary account derived from the node wallet's seed at the provided path in Geth keystore format, along with its address
+func (w *Wallet) GetSecondaryEthKeystore(path string, password string) ([]byte, common.Address, error) {
+	if w.walletManager == nil {
+		return nil, common.Address{}, fmt.Errorf("wallet is not loaded")
+	}
+
+	switch w.walletManager.GetType() {
+	case sharedtypes.WalletType_Local:
+		localMgr := w.walletManager.(*LocalWalletManager)
+		return localMgr.GetSecondaryEthKeystore(path, password)
+	default:
+		return nil, common.Address{}, fmt.Errorf("loaded wallet is not local")
+	}
+}
+
 // Serialize the wallet data as JSON
 func (w *Wallet) SerializeData() (string, error) {
 	if w.walletManager == nil {
@@ -310,10 +467,10 @@ func (w *Wallet) GenerateValidatorKey(path string) ([]byte, error) {
 }
 
 // Builds a local wallet keystore and saves its artifacts to disk
-func (w *Wallet) buildLocalWallet(derivationPath string, walletIndex uint, mnemonic string, password string, savePassword bool, testMode bool) error {
+func (w *Wallet) buildLocalWallet(derivationPath string, walletIndex uint, seed []byte, password string, savePassword bool, testMode bool) error {
 	// Initialize the wallet with it
 	localMgr := NewLocalWalletManager(w.chainID)
-	localData, err := localMgr.InitializeKeystore(derivationPath, walletIndex, mnemonic, password)
+	localData, err := localMgr.InitializeKeystoreFromSeed(derivationPath, walletIndex, seed, password)
 	if err != nil {
 		return fmt.Errorf("error initializing wallet keystore with recovered data: %w", err)
 	}
@@ -349,10 +506,26 @@ func (w *Wallet) buildLocalWallet(derivationPath string, walletIndex uint, mnemo
 		w.addressManager.SetAddress(walletAddress)
 	}
 
-	w.walletManager = localMgr
+	w.setWalletManager(localMgr)
 	return nil
 }
 
+// Set the wallet manager, publishing an event if the wallet was loaded or unloaded
+func (w *Wallet) setWalletManager(mgr IWalletManager) {
+	w.walletManager = mgr
+	if mgr == nil {
+		address, _ := w.addressManager.GetAddress()
+		w.events.Publish(api.EventType_WalletUnloaded, api.WalletEvent{
+			Address: address,
+		})
+		return
+	}
+	address, _ := mgr.GetAddress()
+	w.events.Publish(api.EventType_WalletLoaded, api.WalletEvent{
+		Address: address,
+	})
+}
+
 // Check if the wallet file is saved to disk
 func (w *Wallet) isWalletDataOnDisk() (bool, error) {
 	// Read the file
@@ -398,6 +571,39 @@ func (w *Wallet) loadWalletData(password string) (IWalletManager, error) {
 	return manager, nil
 }
 
+// Save the wallet data to disk by writing it to a temporary file and moving it over the old one, so a failure can't leave a partial file behind
+func (w *Wallet) saveWalletDataAtomically(data *sharedtypes.WalletData) error {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("error serializing wallet data: %w", err)
+	}
+
+	tempPath := w.walletDataPath + ".tmp"
+	err = os.WriteFile(tempPath, bytes, walletFileMode)
+	if err != nil {
+		return fmt.Errorf("error writing wallet data to [%s]: %w", tempPath, err)
+	}
+	err = os.Rename(tempPath, w.walletDataPath)
+	if err != nil {
+		_ = os.Remove(tempPath)
+		return fmt.Errorf("error replacing wallet data at [%s]: %w", w.walletDataPath, err)
+	}
+	return nil
+}
+
+// Check that the wallet file on disk loads with the provided password and holds the same wallet as the provided manager
+func (w *Wallet) verifySavedWallet(expected *LocalWalletManager, password string) error {
+	mgr, err := w.loadWalletData(password)
+	if err != nil {
+		return fmt.Errorf("error loading the saved wallet file: %w", err)
+	}
+	localMgr, ok := mgr.(*LocalWalletManager)
+	if !ok || !bytes.Equal(crypto.FromECDSA(localMgr.nodePrivateKey), crypto.FromECDSA(expected.nodePrivateKey)) {
+		return fmt.Errorf("the saved wallet file doesn't match the loaded wallet")
+	}
+	return nil
+}
+
 // Save the wallet data to disk
 func (w *Wallet) saveWalletData(data *sharedtypes.WalletData) error {
 	// Serialize it
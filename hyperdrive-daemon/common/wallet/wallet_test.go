@@ -0,0 +1,72 @@
+package wallet
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/events"
+	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+)
+
+const (
+	testOldPassword string = "old-password"
+	testNewPassword string = "new-password"
+)
+
+// Create a wallet in a temporary directory
+func newTestWallet(t *testing.T, dir string) *Wallet {
+	logger := log.NewColorLogger(color.FgWhite)
+	w, err := NewWallet(&logger, events.NewEventBus("test"), filepath.Join(dir, "wallet"), filepath.Join(dir, "address"), filepath.Join(dir, "password"), 1)
+	if err != nil {
+		t.Fatalf("error creating wallet: %s", err.Error())
+	}
+	return w
+}
+
+func TestChangePassword(t *testing.T) {
+	dir := t.TempDir()
+	w := newTestWallet(t, dir)
+	_, err := w.CreateNewLocalWallet(DefaultNodeKeyPath, 0, testOldPassword, true)
+	if err != nil {
+		t.Fatalf("error creating local wallet: %s", err.Error())
+	}
+	address, _ := w.GetAddress()
+
+	// A wrong old password is rejected
+	err = w.ChangePassword("wrong-password", testNewPassword, "")
+	if err == nil {
+		t.Fatal("changing the password with the wrong old password succeeded")
+	}
+
+	err = w.ChangePassword(testOldPassword, testNewPassword, "scrypt")
+	if err != nil {
+		t.Fatalf("error changing password: %s", err.Error())
+	}
+
+	// The backup is removed once the new file has been verified
+	_, err = os.Stat(w.walletDataPath + WalletBackupSuffix)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected the wallet backup to be deleted but got %v", err)
+	}
+
+	// The saved password was updated, so the wallet loads with it after a restart
+	reloaded := newTestWallet(t, dir)
+	reloadedAddress, _ := reloaded.GetAddress()
+	if reloaded.walletManager == nil || reloadedAddress != address {
+		t.Fatalf("wallet didn't reload as %s after changing the password", address.Hex())
+	}
+
+	// The file only opens with the new password
+	_, err = reloaded.loadWalletData(testOldPassword)
+	if err == nil {
+		t.Fatal("wallet file still opens with the old password")
+	}
+	_, err = reloaded.loadWalletData(testNewPassword)
+	if err != nil {
+		t.Fatalf("error loading wallet file with the new password: %s", err.Error())
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type serviceAuditLogContextFactory struct {
+	handler *ServiceHandler
+}
+
+func (f *serviceAuditLogContextFactory) Create(args url.Values) (*serviceAuditLogContext, error) {
+	c := &serviceAuditLogContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{
+		server.ValidateOptionalArg("limit", args, input.ValidateUint, &c.limit, nil),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *serviceAuditLogContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*serviceAuditLogContext, api.ServiceAuditLogData](
+		router, "audit-log", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[uint64]("limit"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type serviceAuditLogContext struct {
+	handler *ServiceHandler
+	limit   uint64
+}
+
+func (c *serviceAuditLogContext) PrepareData(data *api.ServiceAuditLogData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	auditLog := sp.GetAuditLog()
+
+	// Read and verify the whole log
+	entries, badLines, err := auditLog.Read()
+	if err != nil {
+		return err
+	}
+	if len(badLines) > 0 {
+		data.VerificationError = fmt.Sprintf("line %d of the log is not a valid entry", badLines[0])
+	} else if err := auditLog.Verify(entries); err != nil {
+		data.VerificationError = err.Error()
+	}
+	data.Verified = (data.VerificationError == "")
+	data.TotalEntries = len(entries)
+
+	// Only return the most recent entries if there's a limit
+	if c.limit > 0 && uint64(len(entries)) > c.limit {
+		entries = entries[uint64(len(entries))-c.limit:]
+	}
+	data.Entries = entries
+	return nil
+}
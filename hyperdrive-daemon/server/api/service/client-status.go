@@ -29,6 +29,7 @@ func (f *serviceClientStatusContextFactory) Create(args url.Values) (*serviceCli
 func (f *serviceClientStatusContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*serviceClientStatusContext, api.ServiceClientStatusData](
 		router, "client-status", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
@@ -63,5 +64,12 @@ func (c *serviceClientStatusContext) PrepareData(data *api.ServiceClientStatusDa
 	}()
 
 	wg.Wait()
+
+	// Get the checkpoint verification status, running it now if the task loop hasn't yet
+	cpVerifier := sp.GetCheckpointVerifier()
+	data.CheckpointVerification = cpVerifier.GetStatus()
+	if cpVerifier.IsEnabled() && !data.CheckpointVerification.HasChecked && data.BcManagerStatus.PrimaryClientStatus.IsWorking {
+		data.CheckpointVerification = cpVerifier.Verify(context.Background())
+	}
 	return nil
 }
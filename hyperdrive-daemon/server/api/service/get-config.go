@@ -27,6 +27,7 @@ func (f *serviceGetConfigContextFactory) Create(args url.Values) (*serviceGetCon
 func (f *serviceGetConfigContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*serviceGetConfigContext, api.ServiceGetConfigData](
 		router, "get-config", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/eth-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+)
+
+const (
+	dutiesPubkeyLimit int = 100000 // Basically no limit
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type serviceGetUpcomingDutiesContextFactory struct {
+	handler *ServiceHandler
+}
+
+func (f *serviceGetUpcomingDutiesContextFactory) Create(args url.Values) (*serviceGetUpcomingDutiesContext, error) {
+	c := &serviceGetUpcomingDutiesContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{
+		server.ValidateArgBatch("pubkeys", args, dutiesPubkeyLimit, input.ValidatePubkey, &c.pubkeys),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *serviceGetUpcomingDutiesContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*serviceGetUpcomingDutiesContext, api.ServiceGetUpcomingDutiesData](
+		router, "get-upcoming-duties", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.BatchArg[beacon.ValidatorPubkey]("pubkeys"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type serviceGetUpcomingDutiesContext struct {
+	handler *ServiceHandler
+	pubkeys []beacon.ValidatorPubkey
+}
+
+func (c *serviceGetUpcomingDutiesContext) PrepareData(data *api.ServiceGetUpcomingDutiesData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	bc := sp.GetBeaconClient()
+	ctx := context.Background()
+	data.Epochs = []api.DutyEpoch{}
+	data.Duties = []api.ValidatorDuty{}
+	data.InactiveValidators = []beacon.ValidatorPubkey{}
+
+	// Requirements
+	err := sp.RequireBeaconClientSynced(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Get the chain timing
+	eth2Config, err := bc.GetEth2Config(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	head, err := bc.GetBeaconHead(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting beacon head: %w", err)
+	}
+	data.CurrentEpoch = head.Epoch
+	data.SecondsPerEpoch = eth2Config.SecondsPerEpoch
+
+	// Get the indices of the validators that can be assigned duties
+	statuses, err := bc.GetValidatorStatuses(ctx, c.pubkeys, nil)
+	if err != nil {
+		return fmt.Errorf("error getting validator statuses: %w", err)
+	}
+	indices := []string{}
+	pubkeysByIndex := map[string]beacon.ValidatorPubkey{}
+	for _, pubkey := range c.pubkeys {
+		status, exists := statuses[pubkey]
+		if !exists || !status.Exists || !isDutyEligible(status.Status) {
+			data.InactiveValidators = append(data.InactiveValidators, pubkey)
+			continue
+		}
+		indices = append(indices, status.Index)
+		pubkeysByIndex[status.Index] = pubkey
+	}
+
+	// Duties are only known for the current and next epochs
+	for epoch := head.Epoch; epoch <= head.Epoch+1; epoch++ {
+		startTime := time.Unix(int64(eth2Config.GenesisTime+epoch*eth2Config.SecondsPerEpoch), 0)
+		endTime := startTime.Add(time.Duration(eth2Config.SecondsPerEpoch) * time.Second)
+		dutyEpoch := api.DutyEpoch{
+			Epoch:     epoch,
+			StartTime: startTime,
+			EndTime:   endTime,
+		}
+
+		if len(indices) > 0 {
+			// Get the proposals
+			proposals, err := bc.GetValidatorProposerDuties(ctx, indices, epoch)
+			if err != nil {
+				return fmt.Errorf("error getting proposer duties for epoch %d: %w", epoch, err)
+			}
+			for _, index := range indices {
+				count := proposals[index]
+				if count == 0 {
+					continue
+				}
+				data.Duties = append(data.Duties, api.ValidatorDuty{
+					Pubkey:    pubkeysByIndex[index],
+					Index:     index,
+					Type:      api.ValidatorDutyType_Proposal,
+					Epoch:     epoch,
+					Count:     count,
+					StartTime: startTime,
+					EndTime:   endTime,
+				})
+				dutyEpoch.HasDuties = true
+			}
+
+			// Get the sync committee assignments
+			syncDuties, err := bc.GetValidatorSyncDuties(ctx, indices, epoch)
+			if err != nil {
+				return fmt.Errorf("error getting sync committee duties for epoch %d: %w", epoch, err)
+			}
+			for _, index := range indices {
+				if !syncDuties[index] {
+					continue
+				}
+				data.Duties = append(data.Duties, api.ValidatorDuty{
+					Pubkey:    pubkeysByIndex[index],
+					Index:     index,
+					Type:      api.ValidatorDutyType_SyncCommittee,
+					Epoch:     epoch,
+					Count:     eth2Config.SlotsPerEpoch,
+					StartTime: startTime,
+					EndTime:   endTime,
+				})
+				dutyEpoch.HasDuties = true
+			}
+		}
+		data.Epochs = append(data.Epochs, dutyEpoch)
+	}
+	return nil
+}
+
+// Check if a validator in the provided state can be assigned proposals or sync committee duties
+func isDutyEligible(state types.ValidatorState) bool {
+	switch state {
+	case types.ValidatorState_ActiveOngoing, types.ValidatorState_ActiveExiting, types.ValidatorState_ActiveSlashed:
+		return true
+	default:
+		return false
+	}
+}
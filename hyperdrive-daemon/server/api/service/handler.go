@@ -4,21 +4,28 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
+	"github.com/nodeset-org/hyperdrive/shared/config"
 )
 
 type ServiceHandler struct {
 	serviceProvider *common.ServiceProvider
+	module          config.IModuleConfig
 	factories       []server.IContextFactory
 }
 
-func NewServiceHandler(serviceProvider *common.ServiceProvider) *ServiceHandler {
+// Create a new service handler; if module is set, callers can only manage that module's containers
+func NewServiceHandler(serviceProvider *common.ServiceProvider, module config.IModuleConfig) *ServiceHandler {
 	h := &ServiceHandler{
 		serviceProvider: serviceProvider,
+		module:          module,
 	}
 	h.factories = []server.IContextFactory{
+		&serviceAuditLogContextFactory{h},
 		&serviceClientStatusContextFactory{h},
 		&serviceGetConfigContextFactory{h},
+		&serviceGetUpcomingDutiesContextFactory{h},
 		&serviceRestartContainerContextFactory{h},
+		&serviceSendTestNotificationContextFactory{h},
 		&serviceVersionContextFactory{h},
 	}
 	return h
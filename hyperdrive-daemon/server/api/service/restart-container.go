@@ -3,14 +3,18 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
+	"slices"
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/gorilla/mux"
 	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/sys"
 )
 
 // ===============
@@ -34,6 +38,9 @@ func (f *serviceRestartContainerContextFactory) Create(args url.Values) (*servic
 func (f *serviceRestartContainerContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*serviceRestartContainerContext, api.SuccessData](
 		router, "restart-container", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("container"),
+		},
 	)
 }
 
@@ -49,6 +56,16 @@ type serviceRestartContainerContext struct {
 func (c *serviceRestartContainerContext) PrepareData(data *api.SuccessData, opts *bind.TransactOpts) error {
 	sp := c.handler.serviceProvider
 	cfg := sp.GetConfig()
+
+	// Modules can only restart their own containers
+	module := c.handler.module
+	if module != nil && !slices.Contains(module.GetContainersToDeploy(), config.ContainerID(c.container)) {
+		return fmt.Errorf("module [%s] is not allowed to restart container [%s]", module.GetModuleName(), c.container)
+	}
+
+	if cfg.IsNativeMode() {
+		return sys.RunSystemctl("restart", cfg.GetNativeUnitName(c.container))
+	}
 	d := sp.GetDocker()
 
 	id := cfg.GetDockerArtifactName(c.container)
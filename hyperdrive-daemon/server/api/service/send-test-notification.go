@@ -0,0 +1,63 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type serviceSendTestNotificationContextFactory struct {
+	handler *ServiceHandler
+}
+
+func (f *serviceSendTestNotificationContextFactory) Create(args url.Values) (*serviceSendTestNotificationContext, error) {
+	c := &serviceSendTestNotificationContext{
+		handler: f.handler,
+	}
+	return c, nil
+}
+
+func (f *serviceSendTestNotificationContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*serviceSendTestNotificationContext, api.ServiceSendTestNotificationData](
+		router, "send-test-notification", f, f.handler.serviceProvider,
+		nil,
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type serviceSendTestNotificationContext struct {
+	handler *ServiceHandler
+}
+
+func (c *serviceSendTestNotificationContext) PrepareData(data *api.ServiceSendTestNotificationData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	notifier := sp.GetNotifier()
+
+	data.NotificationsEnabled = notifier.IsEnabled()
+	if !data.NotificationsEnabled {
+		return nil
+	}
+
+	err := notifier.Send(notifications.Alert{
+		ID:      "test",
+		Level:   notifications.AlertLevel_Info,
+		Title:   "Test notification",
+		Message: "This is a test notification from Hyperdrive. If you can see it, your notification settings are working.",
+	})
+	if err != nil {
+		return fmt.Errorf("error sending test notification: %w", err)
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ func (f *serviceVersionContextFactory) Create(args url.Values) (*serviceVersionC
 func (f *serviceVersionContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*serviceVersionContext, api.ServiceVersionData](
 		router, "version", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
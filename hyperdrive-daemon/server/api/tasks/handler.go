@@ -0,0 +1,30 @@
+package tasks
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
+)
+
+type TasksHandler struct {
+	serviceProvider *common.ServiceProvider
+	factories       []server.IContextFactory
+}
+
+func NewTasksHandler(serviceProvider *common.ServiceProvider) *TasksHandler {
+	h := &TasksHandler{
+		serviceProvider: serviceProvider,
+	}
+	h.factories = []server.IContextFactory{
+		&tasksListContextFactory{h},
+		&tasksRunContextFactory{h},
+	}
+	return h
+}
+
+func (h *TasksHandler) RegisterRoutes(router *mux.Router) {
+	subrouter := router.PathPrefix("/tasks").Subrouter()
+	for _, factory := range h.factories {
+		factory.RegisterRoute(subrouter)
+	}
+}
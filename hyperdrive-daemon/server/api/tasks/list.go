@@ -0,0 +1,47 @@
+package tasks
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type tasksListContextFactory struct {
+	handler *TasksHandler
+}
+
+func (f *tasksListContextFactory) Create(args url.Values) (*tasksListContext, error) {
+	c := &tasksListContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *tasksListContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*tasksListContext, api.TaskListData](
+		router, "list", f, f.handler.serviceProvider,
+		nil,
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type tasksListContext struct {
+	handler *TasksHandler
+}
+
+func (c *tasksListContext) PrepareData(data *api.TaskListData, opts *bind.TransactOpts) error {
+	data.Tasks = c.handler.serviceProvider.GetTaskScheduler().GetStatus()
+	return nil
+}
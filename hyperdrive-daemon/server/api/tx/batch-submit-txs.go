@@ -97,6 +97,7 @@ func (c *txBatchSubmitTxsContext) PrepareData(data *api.BatchTxData, opts *bind.
 			return fmt.Errorf("error submitting transaction %d: %w", i, err)
 		}
 		txHashes[i] = tx.Hash()
+		c.handler.publishSubmittedTx(txHashes[i])
 
 		// Update the nonce to the next one
 		currentNonce.Add(currentNonce, common.Big1)
@@ -1,9 +1,25 @@
 package tx
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/gorilla/mux"
 	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/services"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+const (
+	// How many times to look up a submitted transaction before giving up on watching it
+	txLookupAttempts int = 30
 )
 
 type TxHandler struct {
@@ -31,3 +47,60 @@ func (h *TxHandler) RegisterRoutes(router *mux.Router) {
 		factory.RegisterRoute(subrouter)
 	}
 }
+
+// Publish an event for a submitted transaction, then watch it in the background and publish another once it's mined or fails.
+// The watch stops without publishing anything if the daemon shuts down first.
+func (h *TxHandler) publishSubmittedTx(hash ethcommon.Hash) {
+	sp := h.serviceProvider
+	bus := sp.GetEventBus()
+	bus.Publish(api.EventType_TxSubmitted, api.TxEvent{
+		TxHash: hash,
+	})
+
+	ctx := sp.GetBaseContext()
+	go func() {
+		err := waitForTransaction(ctx, sp.GetEthClient(), hash)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			bus.Publish(api.EventType_TxFailed, api.TxEvent{
+				TxHash: hash,
+				Error:  err.Error(),
+			})
+			return
+		}
+		bus.Publish(api.EventType_TxMined, api.TxEvent{
+			TxHash: hash,
+		})
+	}()
+}
+
+// Wait for a transaction to be mined, returning an error if it reverted
+func waitForTransaction(ctx context.Context, ec *services.ExecutionClientManager, hash ethcommon.Hash) error {
+	// The EC may not have seen the transaction yet if it was just submitted through a different one
+	var tx *types.Transaction
+	for i := 0; tx == nil; i++ {
+		var err error
+		tx, _, err = ec.TransactionByHash(ctx, hash)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, ethereum.NotFound) || i >= txLookupAttempts {
+			return fmt.Errorf("error getting transaction %s: %w", hash.Hex(), err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+	receipt, err := bind.WaitMined(ctx, ec, tx)
+	if err != nil {
+		return fmt.Errorf("error waiting for transaction %s: %w", hash.Hex(), err)
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		return fmt.Errorf("transaction %s failed with status 0", hash.Hex())
+	}
+	return nil
+}
@@ -78,5 +78,6 @@ func (c *txSubmitTxContext) PrepareData(data *api.TxData, opts *bind.TransactOpt
 		return fmt.Errorf("error submitting transaction: %w", err)
 	}
 	data.TxHash = tx.Hash()
+	c.handler.publishSubmittedTx(data.TxHash)
 	return nil
 }
@@ -36,6 +36,9 @@ func (f *txWaitContextFactory) Create(args url.Values) (*txWaitContext, error) {
 func (f *txWaitContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*txWaitContext, api.SuccessData](
 		router, "wait", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[common.Hash]("hash"),
+		},
 	)
 }
 
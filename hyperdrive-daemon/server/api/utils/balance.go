@@ -29,6 +29,7 @@ func (f *utilsBalanceContextFactory) Create(args url.Values) (*utilsBalanceConte
 func (f *utilsBalanceContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*utilsBalanceContext, api.UtilsBalanceData](
 		router, "balance", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
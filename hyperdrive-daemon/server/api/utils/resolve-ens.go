@@ -37,6 +37,10 @@ func (f *utilsResolveEnsContextFactory) Create(args url.Values) (*utilsResolveEn
 func (f *utilsResolveEnsContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*utilsResolveEnsContext, api.UtilsResolveEnsData](
 		router, "resolve-ens", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[common.Address]("address"),
+			server.Arg[string]("name"),
+		},
 	)
 }
 
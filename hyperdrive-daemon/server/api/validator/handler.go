@@ -0,0 +1,29 @@
+package validator
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
+)
+
+type ValidatorHandler struct {
+	serviceProvider *common.ServiceProvider
+	factories       []server.IContextFactory
+}
+
+func NewValidatorHandler(serviceProvider *common.ServiceProvider) *ValidatorHandler {
+	h := &ValidatorHandler{
+		serviceProvider: serviceProvider,
+	}
+	h.factories = []server.IContextFactory{
+		&validatorSetWithdrawalAddressContextFactory{h},
+	}
+	return h
+}
+
+func (h *ValidatorHandler) RegisterRoutes(router *mux.Router) {
+	subrouter := router.PathPrefix("/validator").Subrouter()
+	for _, factory := range h.factories {
+		factory.RegisterRoute(subrouter)
+	}
+}
@@ -0,0 +1,294 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/eth-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	vutils "github.com/nodeset-org/hyperdrive/daemon-utils/validator/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+const (
+	withdrawalChangePubkeyLimit     int    = 100000 // Basically no limit
+	defaultWithdrawalKeySearchLimit uint64 = 500
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type validatorSetWithdrawalAddressContextFactory struct {
+	handler *ValidatorHandler
+}
+
+func (f *validatorSetWithdrawalAddressContextFactory) Create(args url.Values) (*validatorSetWithdrawalAddressContext, error) {
+	c := &validatorSetWithdrawalAddressContext{
+		handler:       f.handler,
+		validatorPath: types.DepositCliValidatorPath,
+		searchLimit:   defaultWithdrawalKeySearchLimit,
+	}
+	server.GetOptionalStringFromVars("validator-path", args, &c.validatorPath)
+	inputErrs := []error{
+		server.ValidateArg("mnemonic", args, input.ValidateWalletMnemonic, &c.mnemonic),
+		server.ValidateArg("address", args, input.ValidateAddress, &c.address),
+		server.ValidateArgBatch("pubkeys", args, withdrawalChangePubkeyLimit, input.ValidatePubkey, &c.pubkeys),
+		server.ValidateOptionalArg("start-index", args, input.ValidateUint, &c.startIndex, nil),
+		server.ValidateOptionalArg("search-limit", args, input.ValidatePositiveUint, &c.searchLimit, nil),
+		server.ValidateArg("submit", args, input.ValidateBool, &c.submit),
+		server.ValidateOptionalArg("indices", args, validateIndices, &c.indices, nil),
+		server.ValidateOptionalArg("genesis-fork-version", args, input.ValidateByteArray, &c.genesisForkVersion, nil),
+		server.ValidateOptionalArg("genesis-validators-root", args, input.ValidateHash, &c.genesisValidatorsRoot, &c.hasGenesisValidatorsRoot),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *validatorSetWithdrawalAddressContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*validatorSetWithdrawalAddressContext, api.ValidatorSetWithdrawalAddressData](
+		router, "set-withdrawal-address", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[string]("validator-path"),
+			server.Arg[string]("mnemonic"),
+			server.Arg[ethcommon.Address]("address"),
+			server.BatchArg[beacon.ValidatorPubkey]("pubkeys"),
+			server.OptionalArg[uint64]("start-index"),
+			server.OptionalArg[uint64]("search-limit"),
+			server.Arg[bool]("submit"),
+			server.OptionalArg[[]uint64]("indices"),
+			server.OptionalArg[[]byte]("genesis-fork-version"),
+			server.OptionalArg[ethcommon.Hash]("genesis-validators-root"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type validatorSetWithdrawalAddressContext struct {
+	handler       *ValidatorHandler
+	mnemonic      string
+	address       ethcommon.Address
+	pubkeys       []beacon.ValidatorPubkey
+	validatorPath string
+	startIndex    uint64
+	searchLimit   uint64
+	submit        bool
+
+	// Offline mode, which doesn't use the Beacon Node
+	indices                  []uint64
+	genesisForkVersion       []byte
+	genesisValidatorsRoot    ethcommon.Hash
+	hasGenesisValidatorsRoot bool
+}
+
+func (c *validatorSetWithdrawalAddressContext) PrepareData(data *api.ValidatorSetWithdrawalAddressData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	bc := sp.GetBeaconClient()
+	cfg := sp.GetConfig()
+	ctx := context.Background()
+	data.Validators = make([]api.ValidatorWithdrawalChangeInfo, len(c.pubkeys))
+
+	// Requirements
+	if !strings.HasPrefix(c.validatorPath, "m/") || strings.Count(c.validatorPath, "%d") != 1 {
+		return fmt.Errorf("invalid validator path [%s]: it must start with 'm/' and contain exactly one '%%d' for the key index", c.validatorPath)
+	}
+	if len(c.indices) > 0 {
+		return c.prepareOffline(data)
+	}
+	err := sp.RequireBeaconClientSynced(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Get the current withdrawal credentials
+	statuses, err := bc.GetValidatorStatuses(ctx, c.pubkeys, nil)
+	if err != nil {
+		return fmt.Errorf("error getting validator statuses: %w", err)
+	}
+	pending := map[ethcommon.Hash][]int{}
+	pendingCount := 0
+	for i, pubkey := range c.pubkeys {
+		info := &data.Validators[i]
+		info.Pubkey = pubkey
+		status, exists := statuses[pubkey]
+		if !exists || !status.Exists {
+			info.Status = api.WithdrawalChangeStatus_NotFound
+			continue
+		}
+		info.Index = status.Index
+		info.WithdrawalCredentials = status.WithdrawalCredentials
+		if status.WithdrawalCredentials[0] != 0x00 {
+			info.Status = api.WithdrawalChangeStatus_AlreadySet
+			continue
+		}
+		info.Status = api.WithdrawalChangeStatus_KeyNotFound
+		pending[status.WithdrawalCredentials] = append(pending[status.WithdrawalCredentials], i)
+		pendingCount++
+	}
+	if pendingCount == 0 {
+		return nil
+	}
+
+	// BLS-to-execution changes are always signed with the genesis fork version so they stay valid across forks
+	signatureDomain, err := bc.GetDomainData(ctx, eth2types.DomainBlsToExecutionChange[:], 0, true)
+	if err != nil {
+		return fmt.Errorf("error getting signature domain: %w", err)
+	}
+	eth2Config, err := bc.GetEth2Config(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	fromPubkeys := make([]beacon.ValidatorPubkey, len(c.pubkeys))
+	signatures := make([]beacon.ValidatorSignature, len(c.pubkeys))
+	metadata := &types.BlsToExecutionChangeMetadata{
+		NetworkName:           string(cfg.Network.Value),
+		GenesisValidatorsRoot: eth2Config.GenesisValidatorsRoot,
+		HyperdriveVersion:     shared.HyperdriveVersion,
+	}
+
+	// Search the mnemonic for the withdrawal keys of the validators that still have BLS credentials
+	for keyIndex := c.startIndex; keyIndex < c.startIndex+c.searchLimit && pendingCount > 0; keyIndex++ {
+		withdrawalKey, err := vutils.GetWithdrawalKey(c.mnemonic, fmt.Sprintf(c.validatorPath, keyIndex))
+		if err != nil {
+			return fmt.Errorf("error getting withdrawal key %d: %w", keyIndex, err)
+		}
+		withdrawalPubkey := withdrawalKey.PublicKey().Marshal()
+		creds := vutils.GetBlsWithdrawalCredentials(withdrawalPubkey)
+		matches, exists := pending[creds]
+		if !exists {
+			continue
+		}
+
+		for _, i := range matches {
+			fromPubkeys[i], signatures[i], err = c.signChange(&data.Validators[i], withdrawalKey, keyIndex, signatureDomain, metadata)
+			if err != nil {
+				return err
+			}
+		}
+		delete(pending, creds)
+		pendingCount -= len(matches)
+	}
+
+	// Submit the changes
+	if !c.submit {
+		return nil
+	}
+	for i := range data.Validators {
+		info := &data.Validators[i]
+		if info.Status != api.WithdrawalChangeStatus_Signed {
+			continue
+		}
+		err = bc.ChangeWithdrawalCredentials(ctx, info.Index, fromPubkeys[i], c.address, signatures[i])
+		if err != nil {
+			info.Status = api.WithdrawalChangeStatus_SubmitFailed
+			info.Error = err.Error()
+			continue
+		}
+		info.Status = api.WithdrawalChangeStatus_Submitted
+	}
+	return nil
+}
+
+// Sign the changes without the Beacon Node, using the provided validator indices and genesis values.
+// Since the validators' current withdrawal credentials aren't known, their withdrawal keys are found by matching the provided pubkeys against the validator keys derived from the mnemonic.
+func (c *validatorSetWithdrawalAddressContext) prepareOffline(data *api.ValidatorSetWithdrawalAddressData) error {
+	// Requirements
+	if c.submit {
+		return fmt.Errorf("changes can't be submitted when validator indices are provided, since that's only for signing them offline")
+	}
+	if len(c.indices) != len(c.pubkeys) {
+		return fmt.Errorf("%d validator indices were provided for %d pubkeys; there must be one index per pubkey", len(c.indices), len(c.pubkeys))
+	}
+	if len(c.genesisForkVersion) != 4 {
+		return fmt.Errorf("signing offline requires the network's 4-byte genesis fork version")
+	}
+	if !c.hasGenesisValidatorsRoot {
+		return fmt.Errorf("signing offline requires the network's genesis validators root")
+	}
+
+	// BLS-to-execution changes are always signed with the genesis fork version so they stay valid across forks
+	signatureDomain, err := eth2types.ComputeDomain(eth2types.DomainBlsToExecutionChange, c.genesisForkVersion, c.genesisValidatorsRoot[:])
+	if err != nil {
+		return fmt.Errorf("error computing signature domain: %w", err)
+	}
+	metadata := &types.BlsToExecutionChangeMetadata{
+		NetworkName:           string(c.handler.serviceProvider.GetConfig().Network.Value),
+		GenesisValidatorsRoot: c.genesisValidatorsRoot[:],
+		HyperdriveVersion:     shared.HyperdriveVersion,
+	}
+
+	pending := map[beacon.ValidatorPubkey]int{}
+	for i, pubkey := range c.pubkeys {
+		info := &data.Validators[i]
+		info.Pubkey = pubkey
+		info.Index = fmt.Sprint(c.indices[i])
+		info.Status = api.WithdrawalChangeStatus_KeyNotFound
+		pending[pubkey] = i
+	}
+
+	// Search the mnemonic for the validator keys, and sign with the withdrawal key that goes with each one
+	for keyIndex := c.startIndex; keyIndex < c.startIndex+c.searchLimit && len(pending) > 0; keyIndex++ {
+		path := fmt.Sprintf(c.validatorPath, keyIndex)
+		validatorKey, err := vutils.GetPrivateKey(c.mnemonic, path)
+		if err != nil {
+			return fmt.Errorf("error getting validator key %d: %w", keyIndex, err)
+		}
+		pubkey := beacon.ValidatorPubkey(validatorKey.PublicKey().Marshal())
+		i, exists := pending[pubkey]
+		if !exists {
+			continue
+		}
+
+		withdrawalKey, err := vutils.GetWithdrawalKey(c.mnemonic, path)
+		if err != nil {
+			return fmt.Errorf("error getting withdrawal key %d: %w", keyIndex, err)
+		}
+		info := &data.Validators[i]
+		info.WithdrawalCredentials = vutils.GetBlsWithdrawalCredentials(withdrawalKey.PublicKey().Marshal())
+		_, _, err = c.signChange(info, withdrawalKey, keyIndex, signatureDomain, metadata)
+		if err != nil {
+			return err
+		}
+		delete(pending, pubkey)
+	}
+	return nil
+}
+
+// Sign the change for a validator with its withdrawal key, returning the withdrawal pubkey and signature
+func (c *validatorSetWithdrawalAddressContext) signChange(info *api.ValidatorWithdrawalChangeInfo, withdrawalKey *eth2types.BLSPrivateKey, keyIndex uint64, signatureDomain []byte, metadata *types.BlsToExecutionChangeMetadata) (beacon.ValidatorPubkey, beacon.ValidatorSignature, error) {
+	signature, err := vutils.GetSignedWithdrawalCredsChangeMessage(withdrawalKey, info.Index, c.address, signatureDomain)
+	if err != nil {
+		return beacon.ValidatorPubkey{}, beacon.ValidatorSignature{}, fmt.Errorf("error signing withdrawal credentials change for validator %s: %w", info.Pubkey.HexWithPrefix(), err)
+	}
+	fromPubkey := beacon.ValidatorPubkey(withdrawalKey.PublicKey().Marshal())
+	info.KeyIndex = keyIndex
+	info.Status = api.WithdrawalChangeStatus_Signed
+	info.Change = &types.SignedBlsToExecutionChange{
+		Message: types.BlsToExecutionChange{
+			ValidatorIndex:     info.Index,
+			FromBlsPubkey:      fromPubkey.HexWithPrefix(),
+			ToExecutionAddress: c.address.Hex(),
+		},
+		Signature: signature.HexWithPrefix(),
+		Metadata:  metadata,
+	}
+	return fromPubkey, signature, nil
+}
+
+// Validate a comma-separated list of validator indices
+func validateIndices(name string, value string) ([]uint64, error) {
+	return input.ValidateBatch(name, value, input.ValidateUint)
+}
@@ -0,0 +1,62 @@
+package wallet
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletChangePasswordContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletChangePasswordContextFactory) Create(args url.Values) (*walletChangePasswordContext, error) {
+	c := &walletChangePasswordContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{
+		server.ValidateArg("old-password", args, input.ValidateNodePassword, &c.oldPassword),
+		server.ValidateArg("new-password", args, input.ValidateNodePassword, &c.newPassword),
+		server.ValidateOptionalArg("kdf", args, input.ValidateKdf, &c.kdf, nil),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *walletChangePasswordContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*walletChangePasswordContext, api.SuccessData](
+		router, "change-password", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("old-password"),
+			server.Arg[string]("new-password"),
+			server.OptionalArg[string]("kdf"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletChangePasswordContext struct {
+	handler     *WalletHandler
+	oldPassword string
+	newPassword string
+	kdf         string
+}
+
+func (c *walletChangePasswordContext) PrepareData(data *api.SuccessData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	w := sp.GetWallet()
+
+	return w.ChangePassword(c.oldPassword, c.newPassword, c.kdf)
+}
@@ -28,6 +28,7 @@ func (f *walletDeletePasswordContextFactory) Create(args url.Values) (*walletDel
 func (f *walletDeletePasswordContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletDeletePasswordContext, api.SuccessData](
 		router, "delete-password", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
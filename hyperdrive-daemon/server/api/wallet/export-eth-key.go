@@ -29,6 +29,7 @@ func (f *walletExportEthKeyContextFactory) Create(args url.Values) (*walletExpor
 func (f *walletExportEthKeyContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletExportEthKeyContext, api.WalletExportEthKeyData](
 		router, "export-eth-key", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
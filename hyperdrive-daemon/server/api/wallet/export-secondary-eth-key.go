@@ -0,0 +1,76 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	sharedutils "github.com/nodeset-org/hyperdrive/shared/utils"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletExportSecondaryEthKeyContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletExportSecondaryEthKeyContextFactory) Create(args url.Values) (*walletExportSecondaryEthKeyContext, error) {
+	c := &walletExportSecondaryEthKeyContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{
+		server.GetStringFromVars("path", args, &c.path),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *walletExportSecondaryEthKeyContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*walletExportSecondaryEthKeyContext, api.WalletExportSecondaryEthKeyData](
+		router, "export-secondary-eth-key", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("path"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletExportSecondaryEthKeyContext struct {
+	handler *WalletHandler
+	path    string
+}
+
+func (c *walletExportSecondaryEthKeyContext) PrepareData(data *api.WalletExportSecondaryEthKeyData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	w := sp.GetWallet()
+
+	// Requirements
+	err := sp.RequireWalletReady()
+	if err != nil {
+		return err
+	}
+
+	// Make a new password
+	password, err := sharedutils.GenerateRandomPassword()
+	if err != nil {
+		return fmt.Errorf("error generating random password: %w", err)
+	}
+
+	ethkey, address, err := w.GetSecondaryEthKeystore(c.path, password)
+	if err != nil {
+		return fmt.Errorf("error getting secondary account keystore: %w", err)
+	}
+	data.AccountAddress = address
+	data.EthKeyJson = ethkey
+	data.Password = password
+	return nil
+}
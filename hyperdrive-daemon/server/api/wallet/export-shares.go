@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletExportSharesContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletExportSharesContextFactory) Create(args url.Values) (*walletExportSharesContext, error) {
+	c := &walletExportSharesContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{
+		server.ValidateArg("password", args, input.ValidateNodePassword, &c.password),
+		server.ValidateArg("threshold", args, input.ValidatePositiveUint, &c.threshold),
+		server.ValidateArg("shares", args, input.ValidatePositiveUint, &c.shares),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *walletExportSharesContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*walletExportSharesContext, api.WalletExportSharesData](
+		router, "export-shares", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("password"),
+			server.Arg[uint64]("threshold"),
+			server.Arg[uint64]("shares"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletExportSharesContext struct {
+	handler   *WalletHandler
+	password  string
+	threshold uint64
+	shares    uint64
+}
+
+func (c *walletExportSharesContext) PrepareData(data *api.WalletExportSharesData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	w := sp.GetWallet()
+
+	shares, err := w.ExportShares(c.password, int(c.threshold), int(c.shares))
+	if err != nil {
+		return fmt.Errorf("error exporting wallet shares: %w", err)
+	}
+	data.Shares = shares
+	return nil
+}
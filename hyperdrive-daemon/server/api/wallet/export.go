@@ -28,6 +28,7 @@ func (f *walletExportContextFactory) Create(args url.Values) (*walletExportConte
 func (f *walletExportContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletExportContext, api.WalletExportData](
 		router, "export", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
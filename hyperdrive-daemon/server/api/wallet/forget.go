@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletForgetContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletForgetContextFactory) Create(args url.Values) (*walletForgetContext, error) {
+	c := &walletForgetContext{
+		handler: f.handler,
+	}
+	return c, nil
+}
+
+func (f *walletForgetContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*walletForgetContext, api.SuccessData](
+		router, "forget", f, f.handler.serviceProvider,
+		nil,
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletForgetContext struct {
+	handler *WalletHandler
+}
+
+func (c *walletForgetContext) PrepareData(data *api.SuccessData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	w := sp.GetWallet()
+
+	err := w.Forget()
+	if err != nil {
+		return fmt.Errorf("error forgetting node wallet: %w", err)
+	}
+	return nil
+}
@@ -34,6 +34,9 @@ func (f *walletGenerateValidatorKeyContextFactory) Create(args url.Values) (*wal
 func (f *walletGenerateValidatorKeyContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletGenerateValidatorKeyContext, api.WalletGenerateValidatorKeyData](
 		router, "generate-validator-key", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("path"),
+		},
 	)
 }
 
@@ -16,22 +16,29 @@ func NewWalletHandler(serviceProvider *common.ServiceProvider) *WalletHandler {
 		serviceProvider: serviceProvider,
 	}
 	h.factories = []server.IContextFactory{
+		&walletChangePasswordContextFactory{h},
 		&walletDeletePasswordContextFactory{h},
 		&walletExportContextFactory{h},
 		&walletExportEthKeyContextFactory{h},
+		&walletExportSecondaryEthKeyContextFactory{h},
+		&walletExportSharesContextFactory{h},
+		&walletForgetContextFactory{h},
 		&walletGenerateValidatorKeyContextFactory{h},
 		&walletInitializeContextFactory{h},
 		&walletMasqueradeContextFactory{h},
 		&walletRecoverContextFactory{h},
+		&walletRecoverFromSharesContextFactory{h},
 		&walletRestoreAddressContextFactory{h},
 		&walletSearchAndRecoverContextFactory{h},
 		&walletSendMessageContextFactory{h},
 		&walletSetEnsNameContextFactory{h},
 		&walletSetPasswordContextFactory{h},
 		&walletSignMessageContextFactory{h},
+		&walletSignTypedDataContextFactory{h},
 		&walletSignTxContextFactory{h},
 		&walletStatusFactory{h},
 		&walletTestRecoverContextFactory{h},
+		&walletTestRecoverFromSharesContextFactory{h},
 		&walletTestSearchAndRecoverContextFactory{h},
 	}
 	return h
@@ -40,6 +40,13 @@ func (f *walletInitializeContextFactory) Create(args url.Values) (*walletInitial
 func (f *walletInitializeContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletInitializeContext, api.WalletInitializeData](
 		router, "initialize", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[string]("derivation-path"),
+			server.OptionalArg[uint64]("index"),
+			server.Arg[string]("password"),
+			server.Arg[bool]("save-wallet"),
+			server.Arg[bool]("save-password"),
+		},
 	)
 }
 
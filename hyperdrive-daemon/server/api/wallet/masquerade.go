@@ -35,6 +35,9 @@ func (f *walletMasqueradeContextFactory) Create(args url.Values) (*walletMasquer
 func (f *walletMasqueradeContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletMasqueradeContext, api.SuccessData](
 		router, "masquerade", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[common.Address]("address"),
+		},
 	)
 }
 
@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/nodeset-org/hyperdrive/shared/utils/slip39"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletRecoverFromSharesContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletRecoverFromSharesContextFactory) Create(args url.Values) (*walletRecoverFromSharesContext, error) {
+	c := &walletRecoverFromSharesContext{
+		handler: f.handler,
+	}
+	server.GetOptionalStringFromVars("derivation-path", args, &c.derivationPath)
+	inputErrs := []error{
+		server.ValidateArgBatch("shares", args, slip39.MaxShareCount, input.ValidateWalletShare, &c.shares),
+		server.ValidateOptionalArg("index", args, input.ValidateUint, &c.index, nil),
+		server.ValidateArg("password", args, input.ValidateNodePassword, &c.password),
+		server.ValidateArg("save-password", args, input.ValidateBool, &c.savePassword),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *walletRecoverFromSharesContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*walletRecoverFromSharesContext, api.WalletRecoverData](
+		router, "recover-from-shares", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[string]("derivation-path"),
+			server.BatchArg[string]("shares"),
+			server.OptionalArg[uint64]("index"),
+			server.Arg[string]("password"),
+			server.Arg[bool]("save-password"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletRecoverFromSharesContext struct {
+	handler        *WalletHandler
+	shares         []string
+	derivationPath string
+	index          uint64
+	password       string
+	savePassword   bool
+}
+
+func (c *walletRecoverFromSharesContext) PrepareData(data *api.WalletRecoverData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	w := sp.GetWallet()
+
+	// Requirements
+	status, err := w.GetStatus()
+	if err != nil {
+		return fmt.Errorf("error getting wallet status: %w", err)
+	}
+	if status.Wallet.IsOnDisk {
+		return fmt.Errorf("a wallet is already present")
+	}
+
+	// Parse the derivation path
+	path, err := GetDerivationPath(types.DerivationPath(c.derivationPath))
+	if err != nil {
+		return err
+	}
+
+	// Recover the wallet
+	err = w.RecoverFromShares(path, uint(c.index), c.shares, c.password, c.savePassword, false)
+	if err != nil {
+		return fmt.Errorf("error recovering wallet: %w", err)
+	}
+	data.AccountAddress, _ = w.GetAddress()
+	return nil
+}
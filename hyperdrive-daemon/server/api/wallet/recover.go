@@ -39,6 +39,13 @@ func (f *walletRecoverContextFactory) Create(args url.Values) (*walletRecoverCon
 func (f *walletRecoverContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletRecoverContext, api.WalletRecoverData](
 		router, "recover", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[string]("derivation-path"),
+			server.Arg[string]("mnemonic"),
+			server.OptionalArg[uint64]("index"),
+			server.Arg[string]("password"),
+			server.Arg[bool]("save-password"),
+		},
 	)
 }
 
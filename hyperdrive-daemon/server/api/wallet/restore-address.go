@@ -28,6 +28,7 @@ func (f *walletRestoreAddressContextFactory) Create(args url.Values) (*walletRes
 func (f *walletRestoreAddressContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletRestoreAddressContext, api.SuccessData](
 		router, "restore-address", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
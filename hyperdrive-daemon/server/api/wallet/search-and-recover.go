@@ -43,6 +43,12 @@ func (f *walletSearchAndRecoverContextFactory) Create(args url.Values) (*walletS
 func (f *walletSearchAndRecoverContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletSearchAndRecoverContext, api.WalletSearchAndRecoverData](
 		router, "search-and-recover", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("mnemonic"),
+			server.Arg[common.Address]("address"),
+			server.Arg[string]("password"),
+			server.Arg[bool]("save-password"),
+		},
 	)
 }
 
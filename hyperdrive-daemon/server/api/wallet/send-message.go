@@ -36,6 +36,10 @@ func (f *walletSendMessageContextFactory) Create(args url.Values) (*walletSendMe
 func (f *walletSendMessageContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletSendMessageContext, api.TxInfoData](
 		router, "send-message", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[[]byte]("message"),
+			server.Arg[common.Address]("address"),
+		},
 	)
 }
 
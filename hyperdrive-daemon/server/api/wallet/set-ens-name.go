@@ -34,6 +34,9 @@ func (f *walletSetEnsNameContextFactory) Create(args url.Values) (*walletSetEnsN
 func (f *walletSetEnsNameContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletSetEnsNameContext, api.WalletSetEnsNameData](
 		router, "set-ens-name", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("name"),
+		},
 	)
 }
 
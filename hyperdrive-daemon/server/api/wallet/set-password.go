@@ -34,6 +34,10 @@ func (f *walletSetPasswordContextFactory) Create(args url.Values) (*walletSetPas
 func (f *walletSetPasswordContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletSetPasswordContext, api.SuccessData](
 		router, "set-password", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("password"),
+			server.Arg[bool]("save"),
+		},
 	)
 }
 
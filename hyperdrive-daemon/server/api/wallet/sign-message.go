@@ -35,6 +35,9 @@ func (f *walletSignMessageContextFactory) Create(args url.Values) (*walletSignMe
 func (f *walletSignMessageContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletSignMessageContext, api.WalletSignMessageData](
 		router, "sign-message", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[[]byte]("message"),
+		},
 	)
 }
 
@@ -35,6 +35,9 @@ func (f *walletSignTxContextFactory) Create(args url.Values) (*walletSignTxConte
 func (f *walletSignTxContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletSignTxContext, api.WalletSignTxData](
 		router, "sign-tx", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[[]byte]("tx"),
+		},
 	)
 }
 
@@ -0,0 +1,91 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	_ "time/tzdata"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletSignTypedDataContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletSignTypedDataContextFactory) Create(body apitypes.TypedData) (*walletSignTypedDataContext, error) {
+	c := &walletSignTypedDataContext{
+		handler: f.handler,
+		body:    body,
+	}
+	// Validate the payload
+	if body.PrimaryType == "" {
+		return nil, fmt.Errorf("typed data primary type must be set")
+	}
+	if _, exists := body.Types[body.PrimaryType]; !exists {
+		return nil, fmt.Errorf("typed data primary type [%s] is not defined in its types", body.PrimaryType)
+	}
+	if _, exists := body.Types["EIP712Domain"]; !exists {
+		return nil, fmt.Errorf("typed data must define the EIP712Domain type")
+	}
+	if body.Domain.ChainId == nil {
+		return nil, fmt.Errorf("typed data domain chain ID must be set")
+	}
+	return c, nil
+}
+
+func (f *walletSignTypedDataContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessPost[*walletSignTypedDataContext, apitypes.TypedData, api.WalletSignTypedDataData](
+		router, "sign-typed-data", f, f.handler.serviceProvider,
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletSignTypedDataContext struct {
+	handler *WalletHandler
+	body    apitypes.TypedData
+}
+
+func (c *walletSignTypedDataContext) PrepareData(data *api.WalletSignTypedDataData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	w := sp.GetWallet()
+	res := sp.GetResources()
+
+	err := errors.Join(
+		sp.RequireWalletReady(),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Make sure the signature can't be replayed on the network Hyperdrive is configured for if it was meant for another one
+	chainID := (*big.Int)(c.body.Domain.ChainId)
+	expectedChainID := new(big.Int).SetUint64(uint64(res.ChainID))
+	if chainID.Cmp(expectedChainID) != 0 {
+		return fmt.Errorf("typed data is for chain ID %s but Hyperdrive is configured for chain ID %s", chainID.String(), expectedChainID.String())
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(c.body)
+	if err != nil {
+		return fmt.Errorf("error hashing typed data: %w", err)
+	}
+	signature, err := w.SignTypedData(c.body)
+	if err != nil {
+		return fmt.Errorf("error signing typed data: %w", err)
+	}
+	data.Hash = common.BytesToHash(hash)
+	data.Signature = signature
+	return nil
+}
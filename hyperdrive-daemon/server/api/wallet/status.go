@@ -27,6 +27,7 @@ func (f *walletStatusFactory) Create(args url.Values) (*walletStatusContext, err
 func (f *walletStatusFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletStatusContext, api.WalletStatusData](
 		router, "status", f, f.handler.serviceProvider,
+		nil,
 	)
 }
 
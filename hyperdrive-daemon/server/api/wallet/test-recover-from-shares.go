@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common/wallet"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/utils"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	"github.com/nodeset-org/hyperdrive/shared/utils/slip39"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletTestRecoverFromSharesContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletTestRecoverFromSharesContextFactory) Create(args url.Values) (*walletTestRecoverFromSharesContext, error) {
+	c := &walletTestRecoverFromSharesContext{
+		handler: f.handler,
+	}
+	server.GetOptionalStringFromVars("derivation-path", args, &c.derivationPath)
+	inputErrs := []error{
+		server.ValidateArgBatch("shares", args, slip39.MaxShareCount, input.ValidateWalletShare, &c.shares),
+		server.ValidateOptionalArg("index", args, input.ValidateUint, &c.index, nil),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *walletTestRecoverFromSharesContextFactory) RegisterRoute(router *mux.Router) {
+	utils.RegisterQuerylessGet[*walletTestRecoverFromSharesContext, api.WalletRecoverData](
+		router, "test-recover-from-shares", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[string]("derivation-path"),
+			server.BatchArg[string]("shares"),
+			server.OptionalArg[uint64]("index"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletTestRecoverFromSharesContext struct {
+	handler        *WalletHandler
+	shares         []string
+	derivationPath string
+	index          uint64
+}
+
+func (c *walletTestRecoverFromSharesContext) PrepareData(data *api.WalletRecoverData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	rs := sp.GetResources()
+
+	// Parse the derivation path
+	path, err := GetDerivationPath(types.DerivationPath(c.derivationPath))
+	if err != nil {
+		return err
+	}
+
+	// Recover the wallet
+	w, err := wallet.TestRecoveryFromShares(path, uint(c.index), c.shares, rs.ChainID)
+	if err != nil {
+		return fmt.Errorf("error recovering wallet: %w", err)
+	}
+	data.AccountAddress, _ = w.GetAddress()
+	return nil
+}
@@ -38,6 +38,11 @@ func (f *walletTestRecoverContextFactory) Create(args url.Values) (*walletTestRe
 func (f *walletTestRecoverContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletTestRecoverContext, api.WalletRecoverData](
 		router, "test-recover", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[string]("derivation-path"),
+			server.Arg[string]("mnemonic"),
+			server.OptionalArg[uint64]("index"),
+		},
 	)
 }
 
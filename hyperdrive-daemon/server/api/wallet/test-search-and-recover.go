@@ -37,6 +37,10 @@ func (f *walletTestSearchAndRecoverContextFactory) Create(args url.Values) (*wal
 func (f *walletTestSearchAndRecoverContextFactory) RegisterRoute(router *mux.Router) {
 	utils.RegisterQuerylessGet[*walletTestSearchAndRecoverContext, api.WalletSearchAndRecoverData](
 		router, "test-search-and-recover", f, f.handler.serviceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("mnemonic"),
+			server.Arg[common.Address]("address"),
+		},
 	)
 }
 
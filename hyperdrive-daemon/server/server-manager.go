@@ -7,6 +7,7 @@ import (
 	"syscall"
 
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
+	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 )
 
@@ -37,7 +38,7 @@ func NewServerManager(sp *common.ServiceProvider, cfgPath string, stopWg *sync.W
 
 	// Start the CLI server
 	cliSocketPath := filepath.Join(sp.GetUserDir(), config.HyperdriveSocketFilename)
-	cliServer, err := NewHyperdriveServer(sp, cliSocketPath)
+	cliServer, err := NewHyperdriveServer(sp, cliSocketPath, CliCaller, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating CLI server: %w", err)
 	}
@@ -52,7 +53,11 @@ func NewServerManager(sp *common.ServiceProvider, cfgPath string, stopWg *sync.W
 	for _, module := range moduleNames {
 		modulesDir := filepath.Join(sp.GetConfig().UserDataPath.Value, config.ModulesName)
 		moduleSocketPath := filepath.Join(modulesDir, module, config.HyperdriveSocketFilename)
-		server, err := NewHyperdriveServer(sp, moduleSocketPath)
+		moduleCfg, err := getModuleConfig(sp.GetConfig(), module)
+		if err != nil {
+			return nil, err
+		}
+		server, err := NewHyperdriveServer(sp, moduleSocketPath, module, moduleCfg)
 		if err != nil {
 			return nil, fmt.Errorf("error creating server for module [%s]: %w", module, err)
 		}
@@ -67,6 +72,17 @@ func NewServerManager(sp *common.ServiceProvider, cfgPath string, stopWg *sync.W
 	return mgr, nil
 }
 
+// Get the config for a module. This is built by the daemon itself rather than read from anything in the module's
+// directories, so a module can't grant itself more routes or containers than it was built with.
+func getModuleConfig(hdCfg *config.HyperdriveConfig, module string) (config.IModuleConfig, error) {
+	switch module {
+	case swconfig.ModuleName:
+		return swconfig.NewStakewiseConfig(hdCfg), nil
+	default:
+		return nil, fmt.Errorf("unknown module [%s]", module)
+	}
+}
+
 // Stops and shuts down the servers
 func (m *ServerManager) Stop() {
 	err := m.cliServer.Stop()
@@ -4,21 +4,59 @@ import (
 	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/api/service"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/api/tasks"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/api/tx"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/api/utils"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/api/validator"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/server/api/wallet"
+	"github.com/nodeset-org/hyperdrive/shared"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 )
 
+// The caller name recorded in the audit log for calls from the CLI
+const CliCaller string = "cli"
+
+// Routes that handle keys, signatures, or the wallet password, which are recorded in the audit log
+var auditedRoutes = []string{
+	"wallet/change-password",
+	"wallet/delete-password",
+	"wallet/export",
+	"wallet/export-eth-key",
+	"wallet/export-secondary-eth-key",
+	"wallet/export-shares",
+	"wallet/forget",
+	"wallet/generate-validator-key",
+	"wallet/initialize",
+	"wallet/masquerade",
+	"wallet/recover",
+	"wallet/recover-from-shares",
+	"wallet/restore-address",
+	"wallet/search-and-recover",
+	"wallet/set-password",
+	"wallet/sign-message",
+	"wallet/sign-typed-data",
+	"wallet/sign-tx",
+	"wallet/test-recover",
+	"wallet/test-recover-from-shares",
+	"wallet/test-search-and-recover",
+	"tx/sign-tx",
+	"tx/batch-sign-txs",
+	"validator/set-withdrawal-address",
+}
+
 type HyperdriveServer struct {
 	*server.ApiManager
 }
 
-func NewHyperdriveServer(sp *common.ServiceProvider, socketPath string) (*HyperdriveServer, error) {
+// Create a new server; caller is the name recorded in the audit log for calls coming in on its socket.
+// If module is set, the server belongs to that module and only lets it use the routes and containers it was built with.
+func NewHyperdriveServer(sp *common.ServiceProvider, socketPath string, caller string, module config.IModuleConfig) (*HyperdriveServer, error) {
 	handlers := []server.IHandler{
-		service.NewServiceHandler(sp),
+		service.NewServiceHandler(sp, module),
+		tasks.NewTasksHandler(sp),
 		tx.NewTxHandler(sp),
 		utils.NewUtilsHandler(sp),
+		validator.NewValidatorHandler(sp),
 		wallet.NewWalletHandler(sp),
 	}
 
@@ -26,6 +64,12 @@ func NewHyperdriveServer(sp *common.ServiceProvider, socketPath string) (*Hyperd
 	if err != nil {
 		return nil, err
 	}
+	mgr.RegisterEventStream(sp.GetEventBus())
+	mgr.EnableAuditLog(sp.GetAuditLog(), caller, auditedRoutes)
+	mgr.RegisterApiSpec("Hyperdrive Daemon API", shared.HyperdriveVersion)
+	if module != nil {
+		mgr.RestrictRoutes(caller, module.GetAllowedHyperdriveRoutes())
+	}
 
 	return &HyperdriveServer{
 		ApiManager: mgr,
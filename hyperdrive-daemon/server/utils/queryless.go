@@ -48,14 +48,16 @@ type IQuerylessPostContextFactory[ContextType IQuerylessCallContext[DataType], B
 }
 
 // Registers a new route with the router, which will invoke the provided factory to create and execute the context
-// for the route when it's called via GET; use this for typical general-purpose calls
+// for the route when it's called via GET; use this for typical general-purpose calls.
+// Args describes the query args the factory parses, for the API spec.
 func RegisterQuerylessGet[ContextType IQuerylessCallContext[DataType], DataType any](
 	router *mux.Router,
 	functionName string,
 	factory IQuerylessGetContextFactory[ContextType, DataType],
 	serviceProvider *common.ServiceProvider,
+	args []server.ArgInfo,
 ) {
-	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+	route := router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
 		// Log
 		args := r.URL.Query()
 		isDebug := serviceProvider.IsDebugMode()
@@ -83,6 +85,9 @@ func RegisterQuerylessGet[ContextType IQuerylessCallContext[DataType], DataType
 		response, err := runQuerylessRoute[DataType](context, serviceProvider)
 		server.HandleResponse(log, w, response, err, isDebug)
 	})
+
+	// Record the route for the API spec
+	server.SetRouteInfo(route, server.NewGetRouteInfo[DataType](args))
 }
 
 // Registers a new route with the router, which will invoke the provided factory to create and execute the context
@@ -93,7 +98,7 @@ func RegisterQuerylessPost[ContextType IQuerylessCallContext[DataType], BodyType
 	factory IQuerylessPostContextFactory[ContextType, BodyType, DataType],
 	serviceProvider *common.ServiceProvider,
 ) {
-	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+	route := router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
 		// Log
 		log := serviceProvider.GetApiLogger()
 		isDebug := serviceProvider.IsDebugMode()
@@ -134,6 +139,9 @@ func RegisterQuerylessPost[ContextType IQuerylessCallContext[DataType], BodyType
 		response, err := runQuerylessRoute[DataType](context, serviceProvider)
 		server.HandleResponse(log, w, response, err, isDebug)
 	})
+
+	// Record the route for the API spec
+	server.SetRouteInfo(route, server.NewPostRouteInfo[BodyType, DataType]())
 }
 
 // Run a route registered with no structured chain query pattern
@@ -45,14 +45,16 @@ type ISingleStagePostContextFactory[ContextType ISingleStageCallContext[DataType
 }
 
 // Registers a new route with the router, which will invoke the provided factory to create and execute the context
-// for the route when it's called; use this for typical general-purpose calls
+// for the route when it's called; use this for typical general-purpose calls.
+// Args describes the query args the factory parses, for the API spec.
 func RegisterSingleStageRoute[ContextType ISingleStageCallContext[DataType], DataType any](
 	router *mux.Router,
 	functionName string,
 	factory ISingleStageGetContextFactory[ContextType, DataType],
 	serviceProvider *common.ServiceProvider,
+	args []server.ArgInfo,
 ) {
-	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+	route := router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
 		// Log
 		args := r.URL.Query()
 		log := serviceProvider.GetApiLogger()
@@ -80,6 +82,9 @@ func RegisterSingleStageRoute[ContextType ISingleStageCallContext[DataType], Dat
 		response, err := runSingleStageRoute[DataType](context, serviceProvider)
 		server.HandleResponse(log, w, response, err, isDebug)
 	})
+
+	// Record the route for the API spec
+	server.SetRouteInfo(route, server.NewGetRouteInfo[DataType](args))
 }
 
 // Registers a new route with the router, which will invoke the provided factory to create and execute the context
@@ -90,7 +95,7 @@ func RegisterSingleStagePost[ContextType ISingleStageCallContext[DataType], Body
 	factory ISingleStagePostContextFactory[ContextType, BodyType, DataType],
 	serviceProvider *common.ServiceProvider,
 ) {
-	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+	route := router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
 		// Log
 		log := serviceProvider.GetApiLogger()
 		isDebug := serviceProvider.IsDebugMode()
@@ -131,6 +136,9 @@ func RegisterSingleStagePost[ContextType ISingleStageCallContext[DataType], Body
 		response, err := runSingleStageRoute[DataType](context, serviceProvider)
 		server.HandleResponse(log, w, response, err, isDebug)
 	})
+
+	// Record the route for the API spec
+	server.SetRouteInfo(route, server.NewPostRouteInfo[BodyType, DataType]())
 }
 
 // Run a route registered with the common single-stage querying pattern
@@ -0,0 +1,199 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/nodeset-org/eth-utils/eth"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+)
+
+const (
+	// Alert IDs
+	walletNotLoadedAlertID string = "wallet-not-loaded"
+	lowBalanceAlertID      string = "low-balance"
+	stuckTxAlertID         string = "stuck-transaction"
+	fallbackAlertIdFormat  string = "%s-using-fallback"
+	offlineAlertIdFormat   string = "%s-offline"
+)
+
+// Check alerts task
+type CheckAlerts struct {
+	sp       *common.ServiceProvider
+	log      log.ColorLogger
+	notifier *notifications.Notifier
+
+	// The latest nonce seen while the node had pending transactions, and when it was first seen
+	pendingNonce      uint64
+	pendingNonceSince time.Time
+}
+
+// Create check alerts task
+func NewCheckAlerts(sp *common.ServiceProvider, logger log.ColorLogger) *CheckAlerts {
+	return &CheckAlerts{
+		sp:       sp,
+		log:      logger,
+		notifier: sp.GetNotifier(),
+	}
+}
+
+// Check the status of the Execution and Beacon clients; this doesn't require either of them to be synced
+func (t *CheckAlerts) CheckClients() {
+	if !t.notifier.IsEnabled() {
+		return
+	}
+
+	ecStatus := t.sp.GetEthClient().CheckStatus(context.Background())
+	t.checkClientStatus("execution-client", "Execution Client", ecStatus)
+
+	bnStatus := t.sp.GetBeaconClient().CheckStatus(context.Background())
+	t.checkClientStatus("beacon-node", "Beacon Node", bnStatus)
+}
+
+// Check the node wallet and its transactions
+func (t *CheckAlerts) Run() error {
+	if !t.notifier.IsEnabled() {
+		return nil
+	}
+	t.log.Println("Checking for alerts...")
+
+	// Check the wallet status
+	w := t.sp.GetWallet()
+	status, err := w.GetStatus()
+	if err != nil {
+		return fmt.Errorf("error getting wallet status: %w", err)
+	}
+	if !status.Address.HasAddress {
+		// Nothing else to check until the node has an address
+		return nil
+	}
+	if !status.Wallet.IsLoaded {
+		t.raise(walletNotLoadedAlertID, notifications.AlertLevel_Critical, "Node wallet not loaded", "Your node wallet is not loaded, so Hyperdrive cannot submit transactions or generate validator keys. If the password isn't saved to disk, provide it with `hyperdrive wallet set-password`.")
+	} else {
+		t.resolve(walletNotLoadedAlertID, "Node wallet loaded", "Your node wallet is loaded again.")
+	}
+
+	// Check the balance and pending transactions
+	nodeAddress := status.Address.NodeAddress
+	err = t.checkBalance(nodeAddress)
+	if err != nil {
+		return err
+	}
+	return t.checkPendingTransactions(nodeAddress)
+}
+
+// Check if the primary client is down and the fallback is in use, or if neither are available
+func (t *CheckAlerts) checkClientStatus(id string, name string, status *api.ClientManagerStatus) {
+	fallbackID := fmt.Sprintf(fallbackAlertIdFormat, id)
+	offlineID := fmt.Sprintf(offlineAlertIdFormat, id)
+	primary := status.PrimaryClientStatus
+	fallback := status.FallbackClientStatus
+
+	// Primary is fine
+	if primary.IsWorking && primary.IsSynced {
+		t.resolve(fallbackID, fmt.Sprintf("Primary %s restored", name), fmt.Sprintf("Your primary %s is available and synced again.", name))
+		t.resolve(offlineID, fmt.Sprintf("%s restored", name), fmt.Sprintf("Your primary %s is available and synced again.", name))
+		return
+	}
+
+	primaryIssue := primary.Error
+	if primaryIssue == "" {
+		primaryIssue = fmt.Sprintf("syncing, %.2f%% complete", primary.SyncProgress*100)
+	}
+
+	// Fallback is in use
+	if status.FallbackEnabled && fallback.IsWorking && fallback.IsSynced {
+		t.resolve(offlineID, fmt.Sprintf("%s available via fallback", name), fmt.Sprintf("Your fallback %s is available and synced.", name))
+		t.raise(fallbackID, notifications.AlertLevel_Warning, fmt.Sprintf("Using fallback %s", name), fmt.Sprintf("Your primary %s is unavailable (%s), so Hyperdrive is using your fallback %s.", name, primaryIssue, name))
+		return
+	}
+
+	// Nothing is available
+	message := fmt.Sprintf("Your primary %s is unavailable (%s)", name, primaryIssue)
+	if status.FallbackEnabled {
+		fallbackIssue := fallback.Error
+		if fallbackIssue == "" {
+			fallbackIssue = fmt.Sprintf("syncing, %.2f%% complete", fallback.SyncProgress*100)
+		}
+		message += fmt.Sprintf(" and your fallback %s is unavailable (%s)", name, fallbackIssue)
+	} else {
+		message += " and no fallback is configured"
+	}
+	message += ". Your validators cannot perform their duties until it's restored."
+	t.raise(offlineID, notifications.AlertLevel_Critical, fmt.Sprintf("%s unavailable", name), message)
+}
+
+// Check if the node's ETH balance is below the threshold
+func (t *CheckAlerts) checkBalance(nodeAddress ethcommon.Address) error {
+	threshold := t.sp.GetConfig().Notifications.LowBalanceThreshold.Value
+	if threshold <= 0 {
+		return nil
+	}
+
+	balance, err := t.sp.GetEthClient().BalanceAt(context.Background(), nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("error getting node balance: %w", err)
+	}
+	balanceEth := eth.WeiToEth(balance)
+	if balanceEth < threshold {
+		t.raise(lowBalanceAlertID, notifications.AlertLevel_Warning, "Low node balance", fmt.Sprintf("Your node wallet only has %.6f ETH, which is below your alert threshold of %.6f ETH. It may not be able to pay for transactions.", balanceEth, threshold))
+	} else {
+		t.resolve(lowBalanceAlertID, "Node balance restored", fmt.Sprintf("Your node wallet now has %.6f ETH.", balanceEth))
+	}
+	return nil
+}
+
+// Check if the node has had a transaction pending for too long
+func (t *CheckAlerts) checkPendingTransactions(nodeAddress ethcommon.Address) error {
+	ec := t.sp.GetEthClient()
+	latestNonce, err := ec.NonceAt(context.Background(), nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("error getting latest nonce: %w", err)
+	}
+	pendingNonce, err := ec.PendingNonceAt(context.Background(), nodeAddress)
+	if err != nil {
+		return fmt.Errorf("error getting pending nonce: %w", err)
+	}
+
+	// No pending transactions
+	if pendingNonce <= latestNonce {
+		t.pendingNonceSince = time.Time{}
+		t.resolve(stuckTxAlertID, "Transactions no longer stuck", "Your node wallet no longer has any pending transactions.")
+		return nil
+	}
+
+	// Track how long the oldest pending transaction has been waiting
+	if t.pendingNonceSince.IsZero() || t.pendingNonce != latestNonce {
+		t.pendingNonce = latestNonce
+		t.pendingNonceSince = time.Now()
+		t.resolve(stuckTxAlertID, "Transactions no longer stuck", "Your node wallet's stuck transaction has been mined.")
+		return nil
+	}
+	timeout := time.Duration(t.sp.GetConfig().Notifications.StuckTransactionTimeout.Value) * time.Minute
+	pendingTime := time.Since(t.pendingNonceSince)
+	if pendingTime > timeout {
+		t.raise(stuckTxAlertID, notifications.AlertLevel_Warning, "Transaction stuck", fmt.Sprintf("The transaction from your node wallet with nonce %d has been pending for %s. It may need to be resubmitted with a higher gas price.", latestNonce, pendingTime.Round(time.Minute)))
+	}
+	return nil
+}
+
+// Raise an alert, logging any delivery errors
+func (t *CheckAlerts) raise(id string, level notifications.AlertLevel, title string, message string) {
+	err := t.notifier.Raise(id, level, title, message)
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
+
+// Resolve an alert, logging any delivery errors
+func (t *CheckAlerts) resolve(id string, title string, message string) {
+	err := t.notifier.Resolve(id, title, message)
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
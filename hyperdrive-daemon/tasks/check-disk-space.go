@@ -0,0 +1,384 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	dt "github.com/docker/docker/api/types"
+	dtc "github.com/docker/docker/api/types/container"
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+	"github.com/nodeset-org/hyperdrive/shared/utils/sys"
+)
+
+const (
+	// Alert IDs
+	lowDiskSpaceAlertID string = "ec-low-disk-space"
+	ecPruneAlertID      string = "ec-prune"
+
+	// How long to wait after a prune finishes before starting another one automatically, in case it didn't free up enough space
+	autoPruneCooldown time.Duration = 7 * 24 * time.Hour
+
+	// How long a prune can run before Hyperdrive stops tracking it and reports it as stuck
+	maxPruneDuration time.Duration = 48 * time.Hour
+
+	// The number of bytes in a GB, which is what the thresholds are measured in
+	gigabyte uint64 = 1000 * 1000 * 1000
+)
+
+// The state of an automatic Execution client prune, saved to disk so it can be tracked across daemon restarts
+type ecPruneState struct {
+	// True while a prune is running
+	IsPruning bool `json:"isPruning"`
+
+	// When the prune was started
+	StartTime time.Time `json:"startTime"`
+
+	// The ID of the EC container that was restarted to begin pruning
+	ContainerID string `json:"containerId"`
+
+	// When that container started pruning; it changes once the prune is done and the container restarts normally
+	StartedAt string `json:"startedAt"`
+
+	// The size of the EC's volume when the prune was started
+	VolumeSizeBefore int64 `json:"volumeSizeBefore"`
+
+	// The free space on the EC's disk when the prune was started
+	FreeSpaceBefore uint64 `json:"freeSpaceBefore"`
+
+	// When the last prune finished
+	LastFinishTime time.Time `json:"lastFinishTime"`
+}
+
+// Check disk space task
+type CheckDiskSpace struct {
+	sp       *common.ServiceProvider
+	log      log.ColorLogger
+	notifier *notifications.Notifier
+}
+
+// Create check disk space task
+func NewCheckDiskSpace(sp *common.ServiceProvider, logger log.ColorLogger) *CheckDiskSpace {
+	return &CheckDiskSpace{
+		sp:       sp,
+		log:      logger,
+		notifier: sp.GetNotifier(),
+	}
+}
+
+// Check the free space on the Execution client's disk, alerting if it's low and pruning the client if enabled
+func (t *CheckDiskSpace) Run(ctx context.Context) error {
+	cfg := t.sp.GetConfig()
+	if !cfg.IsLocalMode() {
+		return nil
+	}
+	ecCfg := cfg.LocalExecutionConfig
+
+	// Track a prune that's already running; the free space is still checked while it runs
+	state, err := t.loadState()
+	if err != nil {
+		return err
+	}
+	if state.IsPruning {
+		err = t.trackPrune(ctx, state)
+		if err != nil {
+			t.log.Printlnf("WARNING: error checking on the Execution Client prune: %s", err.Error())
+		}
+	}
+
+	// Get the free space on the disk holding the EC's volume
+	free, err := t.getFreeSpace()
+	if err != nil {
+		return err
+	}
+	warningThreshold := ecCfg.LowDiskSpaceWarningThreshold.Value * gigabyte
+	criticalThreshold := ecCfg.LowDiskSpaceCriticalThreshold.Value * gigabyte
+	switch {
+	case free < criticalThreshold:
+		t.log.Printlnf("WARNING: only %s is free on your Execution Client's disk.", formatGB(free))
+		t.raise(lowDiskSpaceAlertID, notifications.AlertLevel_Critical, "Execution Client disk almost full", fmt.Sprintf("Only %s is free on the disk holding your Execution Client's data, which is below your critical threshold of %s. Your Execution Client will stop working if it runs out of space, so please free some up or move it to a larger disk.", formatGB(free), formatGB(criticalThreshold)))
+	case free < warningThreshold:
+		t.log.Printlnf("WARNING: only %s is free on your Execution Client's disk.", formatGB(free))
+		t.raise(lowDiskSpaceAlertID, notifications.AlertLevel_Warning, "Execution Client disk low on space", fmt.Sprintf("Only %s is free on the disk holding your Execution Client's data, which is below your warning threshold of %s.", formatGB(free), formatGB(warningThreshold)))
+	default:
+		t.resolve(lowDiskSpaceAlertID, "Execution Client disk space restored", fmt.Sprintf("%s is free on the disk holding your Execution Client's data.", formatGB(free)))
+		return nil
+	}
+
+	if state.IsPruning || !ecCfg.EnableAutoPrune.Value || cfg.IsNativeMode() {
+		return nil
+	}
+	return t.tryAutoPrune(ctx, state, free)
+}
+
+// Start a prune if it's safe to do so right now
+func (t *CheckDiskSpace) tryAutoPrune(ctx context.Context, state *ecPruneState, free uint64) error {
+	cfg := t.sp.GetConfig()
+	ecCfg := cfg.LocalExecutionConfig
+	if !ecCfg.SupportsPruning() {
+		t.log.Printlnf("Auto-prune isn't supported by %s with the current settings.", ecCfg.ExecutionClient.Value)
+		return nil
+	}
+	if !state.LastFinishTime.IsZero() && time.Since(state.LastFinishTime) < autoPruneCooldown {
+		t.log.Printlnf("Your Execution Client was pruned recently (%s), so it won't be pruned again until %s.", state.LastFinishTime.UTC().Format(time.RFC1123), state.LastFinishTime.Add(autoPruneCooldown).UTC().Format(time.RFC1123))
+		return nil
+	}
+	if !ecCfg.IsInAutoPruneWindow(time.Now()) {
+		t.log.Printlnf("A prune will be started during the auto-prune window (%02d:00 UTC for %d hours).", ecCfg.AutoPruneWindowStart.Value, ecCfg.AutoPruneWindowLength.Value)
+		return nil
+	}
+
+	// The primary EC needs to be synced for the prune to work, and the fallbacks need to be healthy so the validators can keep attesting while it's down.
+	// The fallback BN is checked too, since the primary BN can't follow the chain without the primary EC.
+	ecStatus := t.sp.GetEthClient().CheckStatus(ctx)
+	if !ecStatus.PrimaryClientStatus.IsWorking || !ecStatus.PrimaryClientStatus.IsSynced {
+		t.log.Println("Your Execution Client isn't synced, so it can't be pruned yet.")
+		return nil
+	}
+	if !ecStatus.FallbackEnabled || !ecStatus.FallbackClientStatus.IsWorking || !ecStatus.FallbackClientStatus.IsSynced {
+		t.log.Println("Your fallback Execution Client isn't available and synced, so it isn't safe to prune your Execution Client.")
+		return nil
+	}
+	bnStatus := t.sp.GetBeaconClient().CheckStatus(ctx)
+	if !bnStatus.FallbackEnabled || !bnStatus.FallbackClientStatus.IsWorking || !bnStatus.FallbackClientStatus.IsSynced {
+		t.log.Println("Your fallback Beacon Node isn't available and synced, so it isn't safe to prune your Execution Client.")
+		return nil
+	}
+
+	return t.startPrune(ctx, state, free)
+}
+
+// Flag the EC for pruning and restart it so its start script runs the prune
+func (t *CheckDiskSpace) startPrune(ctx context.Context, state *ecPruneState, free uint64) error {
+	cfg := t.sp.GetConfig()
+	d := t.sp.GetDocker()
+	containerName := cfg.GetDockerArtifactName(string(config.ContainerID_ExecutionClient))
+	t.log.Printlnf("Starting a prune of your Execution Client (%s free)...", formatGB(free))
+
+	volumeSize, err := t.getVolumeSize(ctx)
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+
+	// Create the lock file and restart the EC
+	lockPath := filepath.Join(config.DaemonEcDataPath, config.EcPruneLockFilename)
+	err = os.WriteFile(lockPath, []byte{}, 0644)
+	if err != nil {
+		return fmt.Errorf("error creating prune lock file [%s]: %w", lockPath, err)
+	}
+	err = d.ContainerRestart(ctx, containerName, dtc.StopOptions{})
+	if err != nil {
+		_ = os.Remove(lockPath)
+		return fmt.Errorf("error restarting Execution Client container [%s]: %w", containerName, err)
+	}
+	info, err := d.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return fmt.Errorf("error inspecting Execution Client container [%s]: %w", containerName, err)
+	}
+
+	// Save the state so the prune can be tracked
+	state.IsPruning = true
+	state.StartTime = time.Now()
+	state.ContainerID = info.ID
+	state.StartedAt = info.State.StartedAt
+	state.VolumeSizeBefore = volumeSize
+	state.FreeSpaceBefore = free
+	err = t.saveState(state)
+	if err != nil {
+		return err
+	}
+
+	t.log.Println("Your Execution Client is pruning; Hyperdrive will use your fallback clients until it's done.")
+	t.send(notifications.AlertLevel_Info, "Execution Client pruning started", fmt.Sprintf("Only %s was free on the disk holding your Execution Client's data, so Hyperdrive has started pruning it. Your fallback clients will be used until it's done.", formatGB(free)))
+	return nil
+}
+
+// Check on a running prune, restoring the EC to normal operation once it's done
+func (t *CheckDiskSpace) trackPrune(ctx context.Context, state *ecPruneState) error {
+	cfg := t.sp.GetConfig()
+	d := t.sp.GetDocker()
+	containerName := cfg.GetDockerArtifactName(string(config.ContainerID_ExecutionClient))
+	info, err := d.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return fmt.Errorf("error inspecting Execution Client container [%s]: %w", containerName, err)
+	}
+
+	// The start script removes the lock file when the prune is done (Geth) or starts (Nethermind), and the container restarts once the prune exits.
+	// Runs are compared by container ID and start time since recreating the container (such as with `hyperdrive service start`) resets its restart count.
+	lockPath := filepath.Join(config.DaemonEcDataPath, config.EcPruneLockFilename)
+	_, err = os.Stat(lockPath)
+	lockExists := !errors.Is(err, fs.ErrNotExist)
+	isNewRun := info.ID != state.ContainerID || info.State.StartedAt != state.StartedAt
+	isDone := isNewRun && !lockExists
+
+	// Make sure the EC is running, since it won't be able to finish the prune (or run normally afterwards) otherwise
+	if !info.State.Running && !info.State.Restarting {
+		t.log.Println("Your Execution Client container isn't running, starting it...")
+		err = d.ContainerStart(ctx, containerName, dt.ContainerStartOptions{})
+		if err != nil {
+			return fmt.Errorf("error starting Execution Client container [%s]: %w", containerName, err)
+		}
+	}
+
+	// Report progress
+	elapsed := time.Since(state.StartTime).Round(time.Minute)
+	volumeSize, err := t.getVolumeSize(ctx)
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+	if !isDone {
+		t.log.Printlnf("Your Execution Client has been pruning for %s (its data was %s, it's now %s).", elapsed, formatGB(uint64(state.VolumeSizeBefore)), formatGB(uint64(volumeSize)))
+		if elapsed > maxPruneDuration {
+			// Stop tracking it, since an interrupted prune may never finish and would otherwise block future ones forever
+			state.IsPruning = false
+			state.LastFinishTime = time.Now()
+			err = t.saveState(state)
+			if err != nil {
+				return err
+			}
+			message := fmt.Sprintf("Your Execution Client has been pruning for %s, so Hyperdrive has stopped tracking it. Please check its logs with `hyperdrive service logs ec` to make sure it's running normally.", elapsed)
+			t.log.Printlnf("WARNING: %s", message)
+			t.send(notifications.AlertLevel_Warning, "Execution Client prune taking too long", message)
+		}
+		return nil
+	}
+
+	// Wrap up
+	free, err := t.getFreeSpace()
+	if err != nil {
+		return err
+	}
+	state.IsPruning = false
+	state.LastFinishTime = time.Now()
+	err = t.saveState(state)
+	if err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Your Execution Client finished pruning after %s and is running normally again. Its data went from %s to %s, and %s is now free on its disk (up from %s).", elapsed, formatGB(uint64(state.VolumeSizeBefore)), formatGB(uint64(volumeSize)), formatGB(free), formatGB(state.FreeSpaceBefore))
+	t.log.Println(message)
+	t.send(notifications.AlertLevel_Info, "Execution Client prune finished", message)
+	return nil
+}
+
+// Get the free space on the disk holding the EC's volume, which is mounted into the daemon's container
+func (t *CheckDiskSpace) getFreeSpace() (uint64, error) {
+	// Don't let GetFreeSpace fall back to the container's own filesystem if the volume isn't mounted
+	_, err := os.Stat(config.DaemonEcDataPath)
+	if err != nil {
+		return 0, fmt.Errorf("the Execution Client's data isn't available at [%s]; restart Hyperdrive with `hyperdrive service start` to mount it: %w", config.DaemonEcDataPath, err)
+	}
+	free, _, err := sys.GetFreeSpace(config.DaemonEcDataPath)
+	if err != nil {
+		return 0, fmt.Errorf("error getting free space for the Execution Client's data: %w", err)
+	}
+	return free, nil
+}
+
+// Get the size of the EC's volume
+func (t *CheckDiskSpace) getVolumeSize(ctx context.Context) (int64, error) {
+	volumeName := t.sp.GetConfig().GetDockerArtifactName(config.ExecutionClientDataVolume)
+	du, err := t.sp.GetDocker().DiskUsage(ctx, dt.DiskUsageOptions{
+		Types: []dt.DiskUsageObject{dt.VolumeObject},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error getting disk usage: %w", err)
+	}
+	for _, volume := range du.Volumes {
+		if volume.Name == volumeName && volume.UsageData != nil {
+			return volume.UsageData.Size, nil
+		}
+	}
+	return 0, fmt.Errorf("couldn't find a volume named [%s]", volumeName)
+}
+
+// Load the prune state from disk, or create a new one if it doesn't exist yet
+func (t *CheckDiskSpace) loadState() (*ecPruneState, error) {
+	path, err := t.getStatePath()
+	if err != nil {
+		return nil, err
+	}
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &ecPruneState{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading prune state [%s]: %w", path, err)
+	}
+
+	state := &ecPruneState{}
+	err = json.Unmarshal(bytes, state)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing prune state [%s]: %w", path, err)
+	}
+	return state, nil
+}
+
+// Save the prune state to disk
+func (t *CheckDiskSpace) saveState(state *ecPruneState) error {
+	path, err := t.getStatePath()
+	if err != nil {
+		return err
+	}
+	bytes, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("error serializing prune state: %w", err)
+	}
+	err = os.WriteFile(path, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error saving prune state [%s]: %w", path, err)
+	}
+	return nil
+}
+
+// Get the path of the prune state file
+func (t *CheckDiskSpace) getStatePath() (string, error) {
+	userDataPath := t.sp.GetConfig().UserDataPath.Value
+	expandedPath, err := homedir.Expand(userDataPath)
+	if err != nil {
+		return "", fmt.Errorf("error expanding user data path [%s]: %w", userDataPath, err)
+	}
+	return filepath.Join(expandedPath, config.EcPruneStateFilename), nil
+}
+
+// Raise an alert, logging any delivery errors
+func (t *CheckDiskSpace) raise(id string, level notifications.AlertLevel, title string, message string) {
+	err := t.notifier.Raise(id, level, title, message)
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
+
+// Resolve an alert, logging any delivery errors
+func (t *CheckDiskSpace) resolve(id string, title string, message string) {
+	err := t.notifier.Resolve(id, title, message)
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
+
+// Send a one-off notification, logging any delivery errors
+func (t *CheckDiskSpace) send(level notifications.AlertLevel, title string, message string) {
+	err := t.notifier.Send(notifications.Alert{
+		ID:      ecPruneAlertID,
+		Level:   level,
+		Title:   title,
+		Message: message,
+	})
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
+
+// Format a number of bytes in GB
+func formatGB(size uint64) string {
+	return fmt.Sprintf("%.1f GB", float64(size)/float64(gigabyte))
+}
@@ -2,22 +2,29 @@ package tasks
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/scheduler"
 	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
 	"github.com/nodeset-org/hyperdrive/shared/utils/log"
 )
 
 // Config
 var tasksInterval, _ = time.ParseDuration("5m")
-var taskCooldown, _ = time.ParseDuration("10s")
+var taskTimeout, _ = time.ParseDuration("2m")
+var diskSpaceInterval, _ = time.ParseDuration("15m")
+var diskSpaceTimeout, _ = time.ParseDuration("20m") // Restarting the EC to prune it can take as long as its 15 minute stop grace period
 
 const (
 	ErrorColor             = color.FgRed
 	WarningColor           = color.FgYellow
 	UpdateDepositDataColor = color.FgHiWhite
+	CheckAlertsColor       = color.FgHiMagenta
+	VerifyCheckpointColor  = color.FgHiBlue
+	CheckDiskSpaceColor    = color.FgHiGreen
 )
 
 type TaskLoop struct {
@@ -37,48 +44,67 @@ func NewTaskLoop(sp *common.ServiceProvider, wg *sync.WaitGroup) *TaskLoop {
 	}
 }
 
-// Run daemon
+// Register the tasks with the scheduler and start running them
 func (t *TaskLoop) Run() error {
-	// Initialize loggers
-	errorLog := log.NewColorLogger(ErrorColor)
-
 	// Initialize tasks
-	// Nothing here yet
-
-	// Run the loop
-	go func() {
-		for {
-			// Check the EC status
-			err := t.sp.WaitEthClientSynced(t.ctx, false) // Force refresh the primary / fallback EC status
-			if err != nil {
-				errorLog.Println(err)
-				if t.sleepAndCheckIfCancelled(taskCooldown) {
-					break
-				}
-				continue
-			}
-
-			// Check the BC status
-			err = t.sp.WaitBeaconClientSynced(t.ctx, false) // Force refresh the primary / fallback BC status
-			if err != nil {
-				errorLog.Println(err)
-				if t.sleepAndCheckIfCancelled(taskCooldown) {
-					break
-				}
-				continue
-			}
-
-			// Tasks go here
-
-			if t.sleepAndCheckIfCancelled(tasksInterval) {
-				break
-			}
+	checkAlerts := NewCheckAlerts(t.sp, log.NewColorLogger(CheckAlertsColor))
+	verifyCheckpoint := NewVerifyCheckpoint(t.sp, log.NewColorLogger(VerifyCheckpointColor))
+	checkDiskSpace := NewCheckDiskSpace(t.sp, log.NewColorLogger(CheckDiskSpaceColor))
+
+	// Register them; each pass runs them in this order
+	taskScheduler := t.sp.GetTaskScheduler()
+	tasks := []scheduler.TaskInfo{
+		{
+			// Alert on client problems before waiting for them to sync
+			Name:        "check-clients",
+			Description: "Checks the Execution and Beacon clients for problems",
+			Interval:    tasksInterval,
+			Timeout:     taskTimeout,
+			Run: func(ctx context.Context) error {
+				checkAlerts.CheckClients()
+				return nil
+			},
+		},
+		{
+			// This needs to run while the EC is down for pruning, so it doesn't require the clients to be synced
+			Name:        "check-disk-space",
+			Description: "Checks the free space on the Execution client's disk and prunes it automatically if enabled",
+			Interval:    diskSpaceInterval,
+			Timeout:     diskSpaceTimeout,
+			Run: func(ctx context.Context) error {
+				return checkDiskSpace.Run(ctx)
+			},
+		},
+		{
+			Name:         "verify-checkpoint",
+			Description:  "Verifies the Beacon Node's finalized checkpoint against independent sources",
+			Interval:     tasksInterval,
+			Timeout:      taskTimeout,
+			Requirements: scheduler.Requirement_EthClientSynced | scheduler.Requirement_BeaconClientSynced,
+			Run: func(ctx context.Context) error {
+				return verifyCheckpoint.Run()
+			},
+		},
+		{
+			Name:         "check-alerts",
+			Description:  "Checks for node wallet alerts",
+			Interval:     tasksInterval,
+			Timeout:      taskTimeout,
+			Requirements: scheduler.Requirement_EthClientSynced | scheduler.Requirement_BeaconClientSynced,
+			Run: func(ctx context.Context) error {
+				return checkAlerts.Run()
+			},
+		},
+	}
+	for _, task := range tasks {
+		err := taskScheduler.Register(task)
+		if err != nil {
+			return fmt.Errorf("error registering task [%s]: %w", task.Name, err)
 		}
+	}
 
-		// Signal the task loop is done
-		t.wg.Done()
-	}()
-	t.wg.Add(1)
+	// Run the scheduler
+	taskScheduler.Start(t.ctx, t.wg)
 
 	/*
 		// Run metrics loop
@@ -96,17 +122,3 @@ func (t *TaskLoop) Run() error {
 func (t *TaskLoop) Stop() {
 	t.cancel()
 }
-
-func (t *TaskLoop) sleepAndCheckIfCancelled(duration time.Duration) bool {
-	timer := time.NewTimer(duration)
-	select {
-	case <-t.ctx.Done():
-		// Cancel occurred
-		timer.Stop()
-		return true
-
-	case <-timer.C:
-		// Duration has passed without a cancel
-		return false
-	}
-}
@@ -0,0 +1,176 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dt "github.com/docker/docker/api/types"
+	dtc "github.com/docker/docker/api/types/container"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
+	"github.com/nodeset-org/hyperdrive/hyperdrive-daemon/common"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+	"github.com/nodeset-org/hyperdrive/shared/utils/sys"
+)
+
+const (
+	checkpointMismatchAlertID string = "checkpoint-mismatch"
+)
+
+// Verify checkpoint task
+type VerifyCheckpoint struct {
+	sp  *common.ServiceProvider
+	log log.ColorLogger
+}
+
+// Create verify checkpoint task
+func NewVerifyCheckpoint(sp *common.ServiceProvider, logger log.ColorLogger) *VerifyCheckpoint {
+	return &VerifyCheckpoint{
+		sp:  sp,
+		log: logger,
+	}
+}
+
+// Compare the Beacon Node's finalized checkpoint against the independent sources, stopping the Validator Clients if they disagree
+func (t *VerifyCheckpoint) Run() error {
+	verifier := t.sp.GetCheckpointVerifier()
+	if !verifier.IsEnabled() {
+		return nil
+	}
+	t.log.Println("Verifying the Beacon Node's finalized checkpoint...")
+
+	status := verifier.Verify(context.Background())
+	if status.Error != "" {
+		return fmt.Errorf("error verifying finalized checkpoint: %s", status.Error)
+	}
+	for _, source := range status.Sources {
+		if source.Error != "" {
+			t.log.Printlnf("WARNING: couldn't verify against %s: %s", source.Url, source.Error)
+		}
+	}
+
+	// Handle a match
+	if !status.IsMismatched {
+		if status.IsVerified {
+			t.log.Printlnf("Finalized checkpoint (epoch %d, root %s) matches the independent sources.", status.LocalEpoch, status.LocalRoot.Hex())
+			t.resolve()
+		}
+		return nil
+	}
+
+	// Handle a mismatch
+	mismatches := getMismatchDescriptions(status)
+	t.log.Printlnf("WARNING: your Beacon Node's finalized checkpoint (epoch %d, root %s) does not match the following sources:", status.LocalEpoch, status.LocalRoot.Hex())
+	for _, mismatch := range mismatches {
+		t.log.Printlnf("\t%s", mismatch)
+	}
+	if status.IsOverridden {
+		t.log.Println("Checkpoint mismatches are being ignored, so your Validator Clients will be left running.")
+		t.raise(notifications.AlertLevel_Warning, status, mismatches, "Checkpoint mismatches are being ignored, so your Validator Clients have been left running.")
+		return nil
+	}
+
+	stopped, err := t.stopValidatorClients()
+	if err != nil {
+		t.raise(notifications.AlertLevel_Critical, status, mismatches, "Hyperdrive tried to stop your Validator Clients but failed; please stop them manually.")
+		return fmt.Errorf("error stopping Validator Clients: %w", err)
+	}
+	for _, vc := range stopped {
+		t.log.Printlnf("Stopped Validator Client [%s].", vc)
+	}
+	t.log.Println("Your Validator Clients will not be allowed to run until the mismatch is resolved. Resync your Beacon Node from a trusted source with `hyperdrive service resync-bn`.")
+	t.raise(notifications.AlertLevel_Critical, status, mismatches, "Your Validator Clients have been stopped. Resync your Beacon Node from a trusted source with `hyperdrive service resync-bn`.")
+	return nil
+}
+
+// Stop all of the running Validator Clients belonging to the project, returning the names of the ones that were stopped
+func (t *VerifyCheckpoint) stopValidatorClients() ([]string, error) {
+	if t.sp.GetConfig().IsNativeMode() {
+		return t.stopNativeValidatorClients()
+	}
+	d := t.sp.GetDocker()
+	cl, err := d.ContainerList(context.Background(), dt.ContainerListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting container list: %w", err)
+	}
+
+	prefix := t.sp.GetConfig().ProjectName.Value + "_"
+	stopped := []string{}
+	for _, container := range cl {
+		if !strings.Contains(container.Command, config.VcStartScript) {
+			continue
+		}
+		for _, name := range container.Names {
+			name = strings.TrimPrefix(name, "/") // Docker throws a leading / on names
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			err := d.ContainerStop(context.Background(), container.ID, dtc.StopOptions{})
+			if err != nil {
+				return stopped, fmt.Errorf("error stopping [%s]: %w", name, err)
+			}
+			stopped = append(stopped, name)
+			break
+		}
+	}
+	return stopped, nil
+}
+
+// Stop all of the running Validator Client units belonging to the project, returning the names of the ones that were stopped
+func (t *VerifyCheckpoint) stopNativeValidatorClients() ([]string, error) {
+	cfg := t.sp.GetConfig()
+	paths, err := sys.GetUnitFiles(cfg.Native.UnitDirectory.Value, cfg.ProjectName.Value+"_")
+	if err != nil {
+		return nil, err
+	}
+
+	stopped := []string{}
+	for _, path := range paths {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return stopped, fmt.Errorf("error reading unit file [%s]: %w", path, err)
+		}
+		unit := filepath.Base(path)
+		if !strings.Contains(string(contents), config.VcStartScript) || !sys.IsUnitActive(unit) {
+			continue
+		}
+		err = sys.RunSystemctl("stop", unit)
+		if err != nil {
+			return stopped, fmt.Errorf("error stopping [%s]: %w", unit, err)
+		}
+		stopped = append(stopped, unit)
+	}
+	return stopped, nil
+}
+
+// Raise the checkpoint mismatch alert, logging any delivery errors
+func (t *VerifyCheckpoint) raise(level notifications.AlertLevel, status api.CheckpointVerificationStatus, mismatches []string, action string) {
+	message := fmt.Sprintf("Your Beacon Node's finalized checkpoint (epoch %d, root %s) does not match the following sources:\n%s\n\n%s", status.LocalEpoch, status.LocalRoot.Hex(), strings.Join(mismatches, "\n"), action)
+	err := t.sp.GetNotifier().Raise(checkpointMismatchAlertID, level, "Finalized checkpoint mismatch", message)
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
+
+// Resolve the checkpoint mismatch alert, logging any delivery errors
+func (t *VerifyCheckpoint) resolve() {
+	err := t.sp.GetNotifier().Resolve(checkpointMismatchAlertID, "Finalized checkpoint verified", "Your Beacon Node's finalized checkpoint matches the independent sources again. Restart your Validator Clients with `hyperdrive service start` if they were stopped.")
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
+
+// Describe each of the sources that disagree with the local checkpoint
+func getMismatchDescriptions(status api.CheckpointVerificationStatus) []string {
+	mismatches := []string{}
+	for _, source := range status.Sources {
+		if source.Error == "" && !source.IsMatched {
+			mismatches = append(mismatches, fmt.Sprintf("%s (epoch %d, root %s)", source.Url, source.Epoch, source.Root.Hex()))
+		}
+	}
+	return mismatches
+}
@@ -211,7 +211,8 @@ if [ "$CLIENT" = "nethermind" ]; then
     fi
 
     if [ ! -z "$HD_NETHERMIND_PRUNE" ]; then
-        CMD="$CMD --Pruning.Mode Full --Pruning.FullPruningCompletionBehavior AlwaysShutdown"
+        # Start a full prune as soon as the client is synced, and shut down when it's done so it restarts normally
+        CMD="$CMD --Pruning.Mode Full --Pruning.FullPruningTrigger StateDbSize --Pruning.FullPruningThresholdMb 1 --Pruning.FullPruningCompletionBehavior AlwaysShutdown"
     else
         CMD="$CMD --Pruning.Mode Memory"
     fi
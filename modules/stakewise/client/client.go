@@ -6,8 +6,10 @@ import (
 	"net/http"
 
 	"github.com/fatih/color"
+	"github.com/goccy/go-json"
 	"github.com/nodeset-org/hyperdrive/client"
 	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
 	"github.com/nodeset-org/hyperdrive/shared/utils/log"
 )
 
@@ -23,6 +25,7 @@ type ApiClient struct {
 	Validator *ValidatorRequester
 	Wallet    *WalletRequester
 	Status    *StatusRequester
+	Tasks     *TasksRequester
 	context   *client.RequesterContext
 }
 
@@ -51,5 +54,26 @@ func NewApiClient(baseRoute string, socketPath string, debugMode bool) *ApiClien
 	apiRequester.Validator = NewValidatorRequester(apiRequester.context)
 	apiRequester.Wallet = NewWalletRequester(apiRequester.context)
 	apiRequester.Status = NewStatusRequester(apiRequester.context)
+	apiRequester.Tasks = NewTasksRequester(apiRequester.context)
 	return apiRequester
 }
+
+// Set debug mode
+func (c *ApiClient) SetDebug(debug bool) {
+	c.context.DebugMode = debug
+}
+
+// Set a function to call with the data of each successful response
+func (c *ApiClient) SetResponseHook(hook func(route string, data any)) {
+	c.context.ResponseHook = hook
+}
+
+// Subscribe to the Stakewise daemon's event stream. If types is provided, only events of those types will be received.
+func (c *ApiClient) SubscribeToEvents(ctx context.Context, types ...api.EventType) (*client.EventSubscription, error) {
+	return client.SubscribeToEvents(ctx, c.context, types)
+}
+
+// Get the OpenAPI document describing the Stakewise daemon's API
+func (c *ApiClient) GetApiSpec() (json.RawMessage, error) {
+	return client.GetApiSpec(c.context)
+}
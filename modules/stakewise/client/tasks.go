@@ -0,0 +1,41 @@
+package swclient
+
+import (
+	"github.com/nodeset-org/hyperdrive/client"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+type TasksRequester struct {
+	context *client.RequesterContext
+}
+
+func NewTasksRequester(context *client.RequesterContext) *TasksRequester {
+	return &TasksRequester{
+		context: context,
+	}
+}
+
+func (r *TasksRequester) GetName() string {
+	return "Tasks"
+}
+
+func (r *TasksRequester) GetRoute() string {
+	return "tasks"
+}
+
+func (r *TasksRequester) GetContext() *client.RequesterContext {
+	return r.context
+}
+
+// Get the status of each of the daemon's tasks
+func (r *TasksRequester) List() (*api.ApiResponse[api.TaskListData], error) {
+	return client.SendGetRequest[api.TaskListData](r, "list", "List", nil)
+}
+
+// Run a task immediately instead of waiting for its next scheduled run
+func (r *TasksRequester) Run(name string) (*api.ApiResponse[api.SuccessData], error) {
+	args := map[string]string{
+		"name": name,
+	}
+	return client.SendGetRequest[api.SuccessData](r, "run", "Run", args)
+}
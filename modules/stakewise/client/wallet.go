@@ -1,6 +1,7 @@
 package swclient
 
 import (
+	"math/big"
 	"strconv"
 
 	"github.com/nodeset-org/hyperdrive/client"
@@ -37,7 +38,38 @@ func (r *WalletRequester) GenerateKeys(count uint64, restartVc bool) (*api.ApiRe
 	return client.SendGetRequest[swapi.WalletGenerateKeysData](r, "generate-keys", "GenerateKeys", args)
 }
 
-// Export the wallet in encrypted ETH key format
+// Derive the Stakewise operator's hot wallet from the node wallet and save it for the operator container
 func (r *WalletRequester) Initialize() (*api.ApiResponse[swapi.WalletInitializeData], error) {
 	return client.SendGetRequest[swapi.WalletInitializeData](r, "initialize", "Initialize", nil)
 }
+
+// Get the address and balance of the Stakewise operator's hot wallet
+func (r *WalletRequester) OperatorStatus() (*api.ApiResponse[swapi.WalletOperatorStatusData], error) {
+	return client.SendGetRequest[swapi.WalletOperatorStatusData](r, "operator-status", "OperatorStatus", nil)
+}
+
+// Replace the Stakewise operator's hot wallet with the next one derived from the node wallet, moving the old one's ETH to it
+func (r *WalletRequester) RotateOperator(restartOperator bool) (*api.ApiResponse[swapi.WalletRotateOperatorData], error) {
+	args := map[string]string{
+		"restart-operator": strconv.FormatBool(restartOperator),
+	}
+	return client.SendGetRequest[swapi.WalletRotateOperatorData](r, "rotate-operator", "RotateOperator", args)
+}
+
+// Send ETH from the node wallet to the Stakewise operator's hot wallet
+func (r *WalletRequester) TopUpOperator(amount *big.Int) (*api.ApiResponse[api.TxInfoData], error) {
+	args := map[string]string{
+		"amount": amount.String(),
+	}
+	return client.SendGetRequest[api.TxInfoData](r, "top-up-operator", "TopUpOperator", args)
+}
+
+// Recover the validator keys derived from the node wallet that have been registered with NodeSet or the Beacon chain
+func (r *WalletRequester) RecoverKeys(startIndex uint64, gapLimit uint64, restartVc bool) (*api.ApiResponse[swapi.WalletRecoverKeysData], error) {
+	args := map[string]string{
+		"start-index": strconv.FormatUint(startIndex, 10),
+		"gap-limit":   strconv.FormatUint(gapLimit, 10),
+		"restart-vc":  strconv.FormatBool(restartVc),
+	}
+	return client.SendGetRequest[swapi.WalletRecoverKeysData](r, "recover-keys", "RecoverKeys", args)
+}
@@ -1,6 +1,8 @@
 package swapi
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/nodeset-org/eth-utils/beacon"
 )
@@ -9,6 +11,36 @@ type WalletInitializeData struct {
 	AccountAddress common.Address `json:"accountAddress"`
 }
 
+type WalletOperatorStatusData struct {
+	IsInitialized  bool           `json:"isInitialized"`
+	IsNodeAccount  bool           `json:"isNodeAccount"`
+	AccountAddress common.Address `json:"accountAddress"`
+	Account        uint64         `json:"account"`
+	Balance        *big.Int       `json:"balance"`
+	MinBalance     *big.Int       `json:"minBalance"`
+	IsBalanceLow   bool           `json:"isBalanceLow"`
+}
+
+type WalletRotateOperatorData struct {
+	OldAddress  common.Address `json:"oldAddress"`
+	NewAddress  common.Address `json:"newAddress"`
+	Account     uint64         `json:"account"`
+	SweepTxHash *common.Hash   `json:"sweepTxHash,omitempty"`
+}
+
 type WalletGenerateKeysData struct {
 	Pubkeys []beacon.ValidatorPubkey `json:"pubkeys"`
 }
+
+type RecoveredValidatorKey struct {
+	Pubkey       beacon.ValidatorPubkey `json:"pubkey"`
+	Account      uint64                 `json:"account"`
+	IsRegistered bool                   `json:"isRegistered"`
+	IsOnBeacon   bool                   `json:"isOnBeacon"`
+}
+
+type WalletRecoverKeysData struct {
+	RecoveredKeys []RecoveredValidatorKey `json:"recoveredKeys"`
+	SearchedCount uint64                  `json:"searchedCount"`
+	NextAccount   uint64                  `json:"nextAccount"`
+}
@@ -7,4 +7,5 @@ const (
 	PasswordFilename     string = "password.txt"
 	KeystorePasswordFile string = "secret.txt"
 	DepositDataFile      string = "deposit-data.json"
+	QuarantineFile       string = "deposit-data-quarantine.json"
 )
@@ -1,6 +1,8 @@
 package swconfig
 
 import (
+	"fmt"
+
 	"github.com/nodeset-org/hyperdrive/shared"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/config/validator"
@@ -12,6 +14,8 @@ const (
 	OperatorContainerTagID string = "operatorContainerTag"
 	AdditionalOpFlagsID    string = "additionalOpFlags"
 	VerifyDepositRootsID   string = "verifyDepositRoots"
+	QuarantineDepositsID   string = "quarantineInvalidDeposits"
+	OperatorMinBalanceID   string = "operatorMinBalance"
 
 	// Tags
 	daemonTag   string = "nodeset/hyperdrive-stakewise:v" + shared.HyperdriveVersion
@@ -28,6 +32,12 @@ type StakewiseConfig struct {
 	// Toggle for verifying deposit data Merkle roots before saving
 	VerifyDepositsRoot config.Parameter[bool]
 
+	// Toggle for saving the valid deposit data entries and quarantining the invalid ones, instead of rejecting the whole update
+	QuarantineInvalidDeposits config.Parameter[bool]
+
+	// The balance of the operator's hot wallet, in ETH, that it should be topped up at
+	OperatorMinBalance config.Parameter[float64]
+
 	// The Docker Hub tag for the Stakewise operator
 	OperatorContainerTag config.Parameter[string]
 
@@ -76,6 +86,34 @@ func NewStakewiseConfig(hdCfg *config.HyperdriveConfig) *StakewiseConfig {
 			},
 		},
 
+		QuarantineInvalidDeposits: config.Parameter[bool]{
+			ParameterCommon: &config.ParameterCommon{
+				ID:                 QuarantineDepositsID,
+				Name:               "Quarantine Invalid Deposits",
+				Description:        "Each deposit data entry returned by the NodeSet server is validated before it's saved. By default, a single invalid entry causes the whole update to be rejected. Enable this to save the valid entries anyway and move the invalid ones into a quarantine file instead.\n\n[orange]Note that the NodeSet vault's Merkle root covers every entry, so the Stakewise Operator may refuse to deposit until NodeSet corrects the invalid entries. This requires Verify Deposits Root to be enabled.",
+				AffectsContainers:  []config.ContainerID{ContainerID_StakewiseDaemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[config.Network]bool{
+				config.Network_All: false,
+			},
+		},
+
+		OperatorMinBalance: config.Parameter[float64]{
+			ParameterCommon: &config.ParameterCommon{
+				ID:                 OperatorMinBalanceID,
+				Name:               "Operator Minimum Balance",
+				Description:        "The Stakewise Operator signs its transactions with its own hot wallet, derived from your node wallet but separate from it. Hyperdrive will warn you when that wallet's balance drops below this amount (in ETH) so you can top it up with `hyperdrive stakewise wallet top-up-operator`.",
+				AffectsContainers:  []config.ContainerID{ContainerID_StakewiseDaemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[config.Network]float64{
+				config.Network_All: 0.05,
+			},
+		},
+
 		OperatorContainerTag: config.Parameter[string]{
 			ParameterCommon: &config.ParameterCommon{
 				ID:                 OperatorContainerTagID,
@@ -125,6 +163,8 @@ func (cfg *StakewiseConfig) GetParameters() []config.IParameter {
 	return []config.IParameter{
 		&cfg.Enabled,
 		&cfg.VerifyDepositsRoot,
+		&cfg.QuarantineInvalidDeposits,
+		&cfg.OperatorMinBalance,
 		&cfg.OperatorContainerTag,
 		&cfg.AdditionalOpFlags,
 	}
@@ -142,6 +182,27 @@ func (cfg *StakewiseConfig) GetSubconfigs() map[string]config.IConfigSection {
 	}
 }
 
+// Check if a parameter or subsection is used with the current settings
+func (cfg *StakewiseConfig) IsInUse(id string) bool {
+	switch id {
+	case "lighthouse", "lodestar", "nimbus", "prysm", "teku":
+		return id == string(cfg.hdCfg.GetSelectedBeaconNode())
+	}
+	return true
+}
+
+// Check the rules that span multiple settings
+func (cfg *StakewiseConfig) ValidateSettings() []*config.ValidationError {
+	errs := []*config.ValidationError{}
+	if cfg.OperatorMinBalance.Value < 0 {
+		errs = append(errs, config.NewValidationError(&cfg.OperatorMinBalance, "can't be negative."))
+	}
+	if cfg.QuarantineInvalidDeposits.Value && !cfg.VerifyDepositsRoot.Value {
+		errs = append(errs, config.NewValidationError(&cfg.QuarantineInvalidDeposits, fmt.Sprintf("requires %s, so the entries it keeps are still checked against the NodeSet vault.", cfg.VerifyDepositsRoot.Name)))
+	}
+	return errs
+}
+
 // ===================
 // === Module Info ===
 // ===================
@@ -164,3 +225,16 @@ func (cfg *StakewiseConfig) GetContainersToDeploy() []config.ContainerID {
 		ContainerID_StakewiseValidator,
 	}
 }
+
+func (cfg *StakewiseConfig) GetAllowedHyperdriveRoutes() []string {
+	return []string{
+		"service/get-config",
+		"service/restart-container",
+		"wallet/export-secondary-eth-key",
+		"wallet/generate-validator-key",
+		"wallet/sign-message",
+		"wallet/sign-tx",
+		"wallet/sign-typed-data",
+		"wallet/status",
+	}
+}
@@ -0,0 +1,73 @@
+package swconfig
+
+import (
+	"testing"
+
+	"github.com/nodeset-org/hyperdrive/shared/config"
+)
+
+func TestStakewiseValidateSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		// Changes the default settings
+		setup func(cfg *StakewiseConfig)
+		// The path of the only expected error, or blank if the settings are valid
+		expectedPath string
+	}{
+		{
+			name:  "defaults",
+			setup: func(cfg *StakewiseConfig) {},
+		},
+		{
+			name: "negative operator balance",
+			setup: func(cfg *StakewiseConfig) {
+				cfg.OperatorMinBalance.Value = -0.1
+			},
+			expectedPath: "modules.stakewise.operatorMinBalance",
+		},
+		{
+			name: "quarantine with root verification",
+			setup: func(cfg *StakewiseConfig) {
+				cfg.QuarantineInvalidDeposits.Value = true
+			},
+		},
+		{
+			name: "quarantine without root verification",
+			setup: func(cfg *StakewiseConfig) {
+				cfg.QuarantineInvalidDeposits.Value = true
+				cfg.VerifyDepositsRoot.Value = false
+			},
+			expectedPath: "modules.stakewise.quarantineInvalidDeposits",
+		},
+		{
+			name: "disabled modules aren't checked",
+			setup: func(cfg *StakewiseConfig) {
+				cfg.Enabled.Value = false
+				cfg.OperatorMinBalance.Value = -0.1
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hdCfg := config.NewHyperdriveConfig("/tmp/hyperdrive")
+			config.ApplyDefaults(hdCfg, config.Network_Holesky)
+			hdCfg.Network.Value = config.Network_Holesky
+			cfg := NewStakewiseConfig(hdCfg)
+			config.ApplyDefaults(cfg, config.Network_Holesky)
+			cfg.Enabled.Value = true
+			test.setup(cfg)
+
+			errs := hdCfg.Validate([]config.IModuleConfig{cfg})
+			if test.expectedPath == "" {
+				if len(errs) > 0 {
+					t.Fatalf("expected no errors but got %v", config.JoinValidationErrors(errs))
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Path != test.expectedPath {
+				t.Fatalf("expected a single error for [%s] but got %v", test.expectedPath, config.JoinValidationErrors(errs))
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -16,6 +17,7 @@ import (
 	"github.com/goccy/go-json"
 	"github.com/nodeset-org/eth-utils/beacon"
 	"github.com/nodeset-org/hyperdrive/daemon-utils/validator/utils"
+	swshared "github.com/nodeset-org/hyperdrive/modules/stakewise/shared"
 	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/types"
@@ -27,6 +29,22 @@ const (
 	StakewiseDepositAmount uint64 = 32e9
 )
 
+// A deposit data entry from the NodeSet server that failed validation
+type RejectedDepositData struct {
+	Index   int                       `json:"index"`
+	Pubkey  beacon.ValidatorPubkey    `json:"pubkey"`
+	Reasons []string                  `json:"reasons"`
+	Data    types.ExtendedDepositData `json:"data"`
+}
+
+// The contents of the deposit data quarantine file
+type DepositDataQuarantine struct {
+	Version       int                   `json:"version"`
+	Time          time.Time             `json:"time"`
+	IsQuarantined bool                  `json:"isQuarantined"`
+	Entries       []RejectedDepositData `json:"entries"`
+}
+
 // DepositDataManager manages the aggregated deposit data file that Stakewise uses
 type DepositDataManager struct {
 	dataPath string
@@ -111,6 +129,106 @@ func (m *DepositDataManager) UpdateDepositData(data []types.ExtendedDepositData)
 	return nil
 }
 
+// Validate each entry of the aggregated deposit data, returning the entries that passed and a report of the ones that didn't
+func (m *DepositDataManager) ValidateDepositData(data []types.ExtendedDepositData) ([]types.ExtendedDepositData, []RejectedDepositData) {
+	return validateDepositData(m.sp.GetResources(), data)
+}
+
+// Validate each entry of the aggregated deposit data against the provided network's resources
+func validateDepositData(resources *swshared.StakewiseResources, data []types.ExtendedDepositData) ([]types.ExtendedDepositData, []RejectedDepositData) {
+	withdrawalCreds := utils.GetWithdrawalCredsFromAddress(resources.Vault)
+
+	valid := []types.ExtendedDepositData{}
+	rejected := []RejectedDepositData{}
+	seenPubkeys := map[beacon.ValidatorPubkey]int{}
+	for i, dd := range data {
+		reasons := []string{}
+
+		// Check the lengths before copying the pubkey and signature, since the server could send anything
+		var pubkey beacon.ValidatorPubkey
+		if len(dd.PublicKey) != beacon.ValidatorPubkeyLength {
+			reasons = append(reasons, fmt.Sprintf("pubkey has length %d instead of %d", len(dd.PublicKey), beacon.ValidatorPubkeyLength))
+		} else {
+			copy(pubkey[:], dd.PublicKey)
+		}
+		var signature beacon.ValidatorSignature
+		if len(dd.Signature) != beacon.ValidatorSignatureLength {
+			reasons = append(reasons, fmt.Sprintf("signature has length %d instead of %d", len(dd.Signature), beacon.ValidatorSignatureLength))
+		} else {
+			copy(signature[:], dd.Signature)
+		}
+
+		// Check the fields against the network
+		if !bytes.Equal(dd.WithdrawalCredentials, withdrawalCreds[:]) {
+			reasons = append(reasons, fmt.Sprintf("withdrawal credentials are %s instead of the vault's %s", common.BytesToHash(dd.WithdrawalCredentials).Hex(), withdrawalCreds.Hex()))
+		}
+		if dd.Amount != StakewiseDepositAmount {
+			reasons = append(reasons, fmt.Sprintf("amount is %d gwei instead of %d", dd.Amount, StakewiseDepositAmount))
+		}
+		if !bytes.Equal(dd.ForkVersion, resources.GenesisForkVersion) {
+			reasons = append(reasons, fmt.Sprintf("fork version is 0x%x instead of 0x%x", []byte(dd.ForkVersion), resources.GenesisForkVersion))
+		}
+		if dd.NetworkName != resources.NodesetNetwork {
+			reasons = append(reasons, fmt.Sprintf("network name is [%s] instead of [%s]", dd.NetworkName, resources.NodesetNetwork))
+		}
+		if len(dd.PublicKey) == beacon.ValidatorPubkeyLength {
+			if firstIndex, exists := seenPubkeys[pubkey]; exists {
+				reasons = append(reasons, fmt.Sprintf("pubkey is a duplicate of entry %d", firstIndex))
+			} else {
+				seenPubkeys[pubkey] = i
+			}
+		}
+
+		// Check the signature and the deposit data root
+		if len(reasons) == 0 {
+			err := utils.VerifyDepositSignature(resources.GenesisForkVersion, dd.Amount, pubkey, withdrawalCreds, signature)
+			if err != nil {
+				reasons = append(reasons, fmt.Sprintf("signature is invalid: %s", err.Error()))
+			} else {
+				root, err := regenerateDepositDataRoot(dd)
+				if err != nil {
+					reasons = append(reasons, fmt.Sprintf("error computing deposit data root: %s", err.Error()))
+				} else if !bytes.Equal(dd.DepositDataRoot, root[:]) {
+					reasons = append(reasons, fmt.Sprintf("deposit data root is 0x%x instead of %s", []byte(dd.DepositDataRoot), root.Hex()))
+				}
+			}
+		}
+
+		if len(reasons) > 0 {
+			rejected = append(rejected, RejectedDepositData{
+				Index:   i,
+				Pubkey:  pubkey,
+				Reasons: reasons,
+				Data:    dd,
+			})
+			continue
+		}
+		valid = append(valid, dd)
+	}
+	return valid, rejected
+}
+
+// Save the report of rejected deposit data entries to the quarantine file
+func (m *DepositDataManager) SaveQuarantine(version int, isQuarantined bool, rejected []RejectedDepositData) error {
+	quarantine := DepositDataQuarantine{
+		Version:       version,
+		Time:          time.Now(),
+		IsQuarantined: isQuarantined,
+		Entries:       rejected,
+	}
+	bytes, err := json.Marshal(quarantine)
+	if err != nil {
+		return fmt.Errorf("error serializing deposit data quarantine: %w", err)
+	}
+
+	path := filepath.Join(m.sp.GetModuleDir(), swconfig.QuarantineFile)
+	err = os.WriteFile(path, bytes, fileMode)
+	if err != nil {
+		return fmt.Errorf("error saving deposit data quarantine to [%s]: %w", path, err)
+	}
+	return nil
+}
+
 // Compute the Merkle root of the aggregated deposit data using the Stakewise rules
 // NOTE: reverse engineered from https://github.com/stakewise/v3-operator/blob/fa4ac2673a64a486ced51098005376e56e2ddd19/src/validators/utils.py#L207
 func (m *DepositDataManager) ComputeMerkleRoot(data []types.ExtendedDepositData) (common.Hash, error) {
@@ -120,10 +238,9 @@ func (m *DepositDataManager) ComputeMerkleRoot(data []types.ExtendedDepositData)
 	// Create leaf data for each deposit data
 	for i, dd := range data {
 		// Get the deposit data root for this deposit data
-		ddRoot, err := m.regenerateDepositDataRoot(dd)
+		ddRoot, err := regenerateDepositDataRoot(dd)
 		if err != nil {
-			pubkey := beacon.ValidatorPubkey(dd.PublicKey)
-			return common.Hash{}, fmt.Errorf("error generating deposit data root for validator %d (%s): %w", i, pubkey.Hex(), err)
+			return common.Hash{}, fmt.Errorf("error generating deposit data root for validator %d (%x): %w", i, []byte(dd.PublicKey), err)
 		}
 
 		// Get the index
@@ -192,7 +309,7 @@ func (m *DepositDataManager) ComputeMerkleRoot(data []types.ExtendedDepositData)
 }
 
 // Regenerate the deposit data hash root from a deposit data object instead of explicitly relying on the deposit data root provided in the EDD
-func (m *DepositDataManager) regenerateDepositDataRoot(dd types.ExtendedDepositData) (common.Hash, error) {
+func regenerateDepositDataRoot(dd types.ExtendedDepositData) (common.Hash, error) {
 	var depositData = beacon.DepositData{
 		PublicKey:             dd.PublicKey,
 		WithdrawalCredentials: dd.WithdrawalCredentials,
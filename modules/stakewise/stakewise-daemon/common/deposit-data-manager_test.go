@@ -0,0 +1,131 @@
+package swcommon
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/validator/utils"
+	swshared "github.com/nodeset-org/hyperdrive/modules/stakewise/shared"
+	"github.com/nodeset-org/hyperdrive/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+// Get resources for a test network
+func getTestResources() *swshared.StakewiseResources {
+	return &swshared.StakewiseResources{
+		Network:            config.Network_Holesky,
+		Vault:              common.HexToAddress("0x646F5285D195e08E309cF9A5aDFDF68D6Fcc51C4"),
+		GenesisForkVersion: []byte{0x01, 0x01, 0x70, 0x00},
+		NodesetNetwork:     "holesky",
+	}
+}
+
+// Create a valid deposit data entry for a new key
+func getTestDepositData(t *testing.T, resources *swshared.StakewiseResources) types.ExtendedDepositData {
+	err := utils.InitializeBls()
+	if err != nil {
+		t.Fatalf("error initializing BLS: %s", err.Error())
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %s", err.Error())
+	}
+	dd, err := utils.GetDepositData(key, utils.GetWithdrawalCredsFromAddress(resources.Vault), resources.GenesisForkVersion, StakewiseDepositAmount, config.Network(resources.NodesetNetwork))
+	if err != nil {
+		t.Fatalf("error creating deposit data: %s", err.Error())
+	}
+	return dd
+}
+
+func TestValidateDepositData(t *testing.T) {
+	resources := getTestResources()
+	tests := []struct {
+		name   string
+		modify func(dd *types.ExtendedDepositData)
+		reason string
+	}{
+		{
+			name:   "valid",
+			modify: func(dd *types.ExtendedDepositData) {},
+		},
+		{
+			name:   "short pubkey",
+			modify: func(dd *types.ExtendedDepositData) { dd.PublicKey = dd.PublicKey[:20] },
+			reason: "pubkey has length 20 instead of 48",
+		},
+		{
+			name:   "empty pubkey",
+			modify: func(dd *types.ExtendedDepositData) { dd.PublicKey = nil },
+			reason: "pubkey has length 0 instead of 48",
+		},
+		{
+			name:   "short signature",
+			modify: func(dd *types.ExtendedDepositData) { dd.Signature = dd.Signature[:95] },
+			reason: "signature has length 95 instead of 96",
+		},
+		{
+			name:   "empty signature",
+			modify: func(dd *types.ExtendedDepositData) { dd.Signature = nil },
+			reason: "signature has length 0 instead of 96",
+		},
+		{
+			name:   "bad fork version",
+			modify: func(dd *types.ExtendedDepositData) { dd.ForkVersion = []byte{0x00, 0x00, 0x00, 0x00} },
+			reason: "fork version is 0x00000000 instead of 0x01017000",
+		},
+		{
+			name:   "wrong amount",
+			modify: func(dd *types.ExtendedDepositData) { dd.Amount = 1e9 },
+			reason: "amount is 1000000000 gwei instead of 32000000000",
+		},
+		{
+			name: "bad signature",
+			modify: func(dd *types.ExtendedDepositData) {
+				dd.Signature = append([]byte{}, dd.Signature...)
+				dd.Signature[10] ^= 0xff
+			},
+			reason: "signature is invalid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dd := getTestDepositData(t, resources)
+			test.modify(&dd)
+			valid, rejected := validateDepositData(resources, []types.ExtendedDepositData{dd})
+			if test.reason == "" {
+				if len(valid) != 1 || len(rejected) != 0 {
+					t.Fatalf("expected the entry to be valid but it was rejected: %v", rejected)
+				}
+				return
+			}
+			if len(valid) != 0 || len(rejected) != 1 {
+				t.Fatalf("expected the entry to be rejected but it was accepted")
+			}
+			found := false
+			for _, reason := range rejected[0].Reasons {
+				if strings.Contains(reason, test.reason) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("expected a rejection reason containing [%s] but got %v", test.reason, rejected[0].Reasons)
+			}
+		})
+	}
+}
+
+func TestValidateDepositDataDuplicate(t *testing.T) {
+	resources := getTestResources()
+	dd := getTestDepositData(t, resources)
+	valid, rejected := validateDepositData(resources, []types.ExtendedDepositData{dd, dd})
+	if len(valid) != 1 || len(rejected) != 1 {
+		t.Fatalf("expected 1 valid and 1 rejected entry but got %d and %d", len(valid), len(rejected))
+	}
+	if rejected[0].Index != 1 || rejected[0].Reasons[0] != "pubkey is a duplicate of entry 0" {
+		t.Fatalf("unexpected rejection %+v", rejected[0])
+	}
+}
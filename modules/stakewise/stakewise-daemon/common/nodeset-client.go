@@ -2,37 +2,129 @@ package swcommon
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
 
 	"github.com/nodeset-org/eth-utils/beacon"
 	"github.com/nodeset-org/eth-utils/common"
+	"github.com/nodeset-org/hyperdrive/client"
 	swshared "github.com/nodeset-org/hyperdrive/modules/stakewise/shared"
 	"github.com/nodeset-org/hyperdrive/shared/types"
 )
 
 const (
-	// The message to sign with the node wallet when uploading deposit data
-	nodesetAuthMessage string = "nodesetdev"
+	// The message the node wallet signs to log in, laid out like an EIP-4361 sign-in message
+	loginMessageFormat string = "%s wants you to sign in with your node address:\n%s\n\nURI: %s\nNetwork: %s\nNonce: %s\nExpiration Time: %s"
 
-	// Header used for the wallet signature during a deposit data upload
+	// How long a signed login message is valid for
+	loginMessageValidity time.Duration = 5 * time.Minute
+
+	// How long before a session expires to log in again
+	sessionRefreshMargin time.Duration = 1 * time.Minute
+
+	// Header used for the session token
 	authHeader string = "Authorization"
 
 	// API paths
 	depositDataPath string = "deposit-data"
+	loginPath       string = "login"
 	metaPath        string = "meta"
+	noncePath       string = "nonce"
 	validatorsPath  string = "validators"
+
+	// Default connection settings
+	defaultNodesetRequestTimeout time.Duration = 30 * time.Second
+	defaultNodesetMaxRetries     int           = 4
+	defaultNodesetRetryDelay     time.Duration = 1 * time.Second
+	defaultNodesetMaxRetryDelay  time.Duration = 30 * time.Second
+)
+
+var (
+	// The NodeSet server rejected the node's authorization, typically because the node isn't registered
+	ErrNodesetUnauthorized = errors.New("node is not authorized by the NodeSet server")
+
+	// The NodeSet server rejected the request's contents
+	ErrNodesetInvalidRequest = errors.New("NodeSet server rejected the request")
+
+	// The NodeSet server failed to process the request
+	ErrNodesetServerError = errors.New("NodeSet server encountered an error")
 )
 
+// Matches JSON token fields, capturing everything before the value
+var tokenFieldRegex = regexp.MustCompile(`("token"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// An error response from the NodeSet server; use errors.Is with ErrNodesetUnauthorized, ErrNodesetInvalidRequest, or ErrNodesetServerError to check its kind
+type NodesetError struct {
+	StatusCode int
+	Status     string
+	Message    string
+	kind       error
+}
+
+func (e *NodesetError) Error() string {
+	return fmt.Sprintf("%s: server responded with code %s: [%s]", e.kind.Error(), e.Status, e.Message)
+}
+
+func (e *NodesetError) Unwrap() error {
+	return e.kind
+}
+
+// Create an error for a non-200 response, classifying it by its status code
+func newNodesetError(resp *http.Response, body []byte) *NodesetError {
+	var kind error
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		kind = ErrNodesetUnauthorized
+	case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests:
+		kind = ErrNodesetServerError
+	default:
+		kind = ErrNodesetInvalidRequest
+	}
+	return &NodesetError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Message:    string(body),
+		kind:       kind,
+	}
+}
+
 // =================
 // === Responses ===
 // =================
 
+// api/login/nonce
+type NonceResponse struct {
+	Nonce string `json:"nonce"`
+}
+
+// api/login
+type LoginRequest struct {
+	Address    string    `json:"address"`
+	Network    string    `json:"network"`
+	Nonce      string    `json:"nonce"`
+	Expiration time.Time `json:"expiration"`
+	Message    string    `json:"message"`
+	Signature  string    `json:"signature"`
+}
+
+// api/login
+type LoginResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
 // api/deposit-data/meta
 type DepositDataMetaResponse struct {
 	Version int `json:"version"`
@@ -53,43 +145,212 @@ type ValidatorsResponse struct {
 // === Client ===
 // ==============
 
+// The node wallet the NodeSet client logs in with
+type NodesetSigner interface {
+	// Get the address of the node wallet
+	GetNodeAddress() (ethcommon.Address, error)
+
+	// Sign a message with the node wallet
+	SignMessage(message []byte) ([]byte, error)
+}
+
+// A NodesetSigner that uses the node wallet loaded by the Hyperdrive daemon
+type hyperdriveNodesetSigner struct {
+	hd *client.ApiClient
+}
+
+// Create a NodesetSigner that asks the Hyperdrive daemon to sign with its node wallet
+func NewHyperdriveNodesetSigner(hd *client.ApiClient) NodesetSigner {
+	return &hyperdriveNodesetSigner{
+		hd: hd,
+	}
+}
+
+func (s *hyperdriveNodesetSigner) GetNodeAddress() (ethcommon.Address, error) {
+	response, err := s.hd.Wallet.Status()
+	if err != nil {
+		return ethcommon.Address{}, fmt.Errorf("error getting wallet status: %w", err)
+	}
+	walletStatus := response.Data.WalletStatus
+	if !walletStatus.Wallet.IsLoaded {
+		return ethcommon.Address{}, fmt.Errorf("the node wallet is not loaded")
+	}
+	return walletStatus.Wallet.WalletAddress, nil
+}
+
+func (s *hyperdriveNodesetSigner) SignMessage(message []byte) ([]byte, error) {
+	response, err := s.hd.Wallet.SignMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	return response.Data.SignedMessage, nil
+}
+
+// Settings for the NodeSet client's connection to the server
+type NodesetClientSettings struct {
+	// The base URL of the NodeSet API
+	BaseUrl string
+
+	// The name of the network on the NodeSet server
+	Network string
+
+	// The address of the StakeWise vault
+	Vault ethcommon.Address
+
+	// The transport to send requests with; nil uses http.DefaultTransport
+	Transport http.RoundTripper
+
+	// The deadline for each attempt of a request
+	RequestTimeout time.Duration
+
+	// The number of times to retry a request after a server or network error.
+	// Requests that aren't idempotent, like uploads, are only retried when the server reports it didn't process them.
+	MaxRetries int
+
+	// The delay before the first retry, which doubles after each subsequent one
+	RetryDelay time.Duration
+
+	// The maximum delay between retries, including ones requested by the server
+	MaxRetryDelay time.Duration
+
+	// True to log requests and responses
+	Debug bool
+}
+
+// Get the default client settings for the provided resources
+func DefaultNodesetClientSettings(res *swshared.StakewiseResources) NodesetClientSettings {
+	return NodesetClientSettings{
+		BaseUrl:        res.NodesetApiUrl,
+		Network:        res.NodesetNetwork,
+		Vault:          res.Vault,
+		RequestTimeout: defaultNodesetRequestTimeout,
+		MaxRetries:     defaultNodesetMaxRetries,
+		RetryDelay:     defaultNodesetRetryDelay,
+		MaxRetryDelay:  defaultNodesetMaxRetryDelay,
+	}
+}
+
 // Client for interacting with the Nodeset server
 type NodesetClient struct {
-	sp            *StakewiseServiceProvider
-	res           *swshared.StakewiseResources
-	debug         bool
-	authSignature []byte
+	signer        NodesetSigner
+	settings      NodesetClientSettings
+	client        *http.Client
+	sessionToken  string
+	sessionExpiry time.Time
+	sessionLock   *sync.Mutex
 }
 
-// Creates a new Nodeset client
-func NewNodesetClient(sp *StakewiseServiceProvider) *NodesetClient {
-	cfg := sp.GetHyperdriveConfig()
+// Creates a new Nodeset client that logs in with the provided signer
+func NewNodesetClient(signer NodesetSigner, settings NodesetClientSettings) *NodesetClient {
 	return &NodesetClient{
-		sp:    sp,
-		res:   sp.GetResources(),
-		debug: cfg.DebugMode.Value,
+		signer:   signer,
+		settings: settings,
+		client: &http.Client{
+			Transport: settings.Transport,
+		},
+		sessionLock: &sync.Mutex{},
 	}
 }
 
-// Checks if the NodeSet authorization signature has been set, and if not, creates it by getting a signed message from the node wallet
-func (c *NodesetClient) EnsureAuthSignatureExists() error {
-	if c.authSignature != nil {
-		return nil
+// Get the token for the current session with the NodeSet server, logging in first if there isn't one or it's about to expire.
+// Sessions the server didn't provide an expiration time for are used until it rejects them.
+func (c *NodesetClient) getSessionToken(ctx context.Context) (string, error) {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+
+	if c.sessionToken != "" && (c.sessionExpiry.IsZero() || time.Until(c.sessionExpiry) > sessionRefreshMargin) {
+		return c.sessionToken, nil
+	}
+	err := c.login(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error logging into the NodeSet server: %w", err)
 	}
+	return c.sessionToken, nil
+}
 
-	// Sign the auth message
-	hd := c.sp.GetHyperdriveClient()
-	signResponse, err := hd.Wallet.SignMessage([]byte(nodesetAuthMessage))
+// Clear the session if it still uses the provided token, so the next request logs in again
+func (c *NodesetClient) invalidateSession(token string) {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+
+	if c.sessionToken == token {
+		c.sessionToken = ""
+		c.sessionExpiry = time.Time{}
+	}
+}
+
+// Log into the NodeSet server by signing a one-time nonce it issues with the node wallet
+func (c *NodesetClient) login(ctx context.Context) error {
+	// Get the node wallet's address
+	nodeAddress, err := c.signer.GetNodeAddress()
+	if err != nil {
+		return err
+	}
+	address := nodeAddress.Hex()
+
+	// Get a nonce
+	params := map[string]string{
+		"address": address,
+		"network": c.settings.Network,
+	}
+	response, err := c.submitRequestWithRetries(ctx, http.MethodGet, nil, params, "", loginPath, noncePath)
+	if err != nil {
+		return fmt.Errorf("error getting login nonce: %w", err)
+	}
+	var nonceBody NonceResponse
+	err = json.Unmarshal(response, &nonceBody)
+	if err != nil {
+		return fmt.Errorf("error deserializing login nonce response: %w", err)
+	}
+	if nonceBody.Nonce == "" {
+		return fmt.Errorf("server did not provide a login nonce")
+	}
+
+	// Sign the login message
+	baseUrl, err := url.Parse(c.settings.BaseUrl)
+	if err != nil {
+		return fmt.Errorf("error parsing NodeSet API URL [%s]: %w", c.settings.BaseUrl, err)
+	}
+	expiration := time.Now().Add(loginMessageValidity).UTC().Truncate(time.Second)
+	message := fmt.Sprintf(loginMessageFormat, baseUrl.Host, address, c.settings.BaseUrl, c.settings.Network, nonceBody.Nonce, expiration.Format(time.RFC3339))
+	signature, err := c.signer.SignMessage([]byte(message))
+	if err != nil {
+		return fmt.Errorf("error signing login message: %w", err)
+	}
+
+	// Exchange it for a session token
+	request, err := json.Marshal(LoginRequest{
+		Address:    address,
+		Network:    c.settings.Network,
+		Nonce:      nonceBody.Nonce,
+		Expiration: expiration,
+		Message:    message,
+		Signature:  common.EncodeHexWithPrefix(signature),
+	})
+	if err != nil {
+		return fmt.Errorf("error serializing login request: %w", err)
+	}
+	response, err = c.submitRequestWithRetries(ctx, http.MethodPost, request, nil, "", loginPath)
 	if err != nil {
-		return fmt.Errorf("error signing authorization message: %w", err)
+		return err
 	}
-	c.authSignature = signResponse.Data.SignedMessage
+	var loginBody LoginResponse
+	err = json.Unmarshal(response, &loginBody)
+	if err != nil {
+		return fmt.Errorf("error deserializing login response: %w", err)
+	}
+	if loginBody.Token == "" {
+		return fmt.Errorf("server did not provide a session token")
+	}
+
+	c.sessionToken = loginBody.Token
+	c.sessionExpiry = loginBody.ExpiresAt
 	return nil
 }
 
 // Uploads deposit data to Nodeset
-func (c *NodesetClient) UploadDepositData(depositData []byte) ([]byte, error) {
-	response, err := c.submitRequest(http.MethodPost, bytes.NewBuffer(depositData), nil, depositDataPath)
+func (c *NodesetClient) UploadDepositData(ctx context.Context, depositData []byte) ([]byte, error) {
+	response, err := c.submitRequest(ctx, http.MethodPost, depositData, nil, depositDataPath)
 	if err != nil {
 		return nil, fmt.Errorf("error uploading deposit data: %w", err)
 	}
@@ -97,13 +358,13 @@ func (c *NodesetClient) UploadDepositData(depositData []byte) ([]byte, error) {
 }
 
 // Get the current version of the aggregated deposit data on the server
-func (c *NodesetClient) GetServerDepositDataVersion() (int, error) {
-	vault := common.RemovePrefix(strings.ToLower(c.res.Vault.Hex()))
+func (c *NodesetClient) GetServerDepositDataVersion(ctx context.Context) (int, error) {
+	vault := common.RemovePrefix(strings.ToLower(c.settings.Vault.Hex()))
 	params := map[string]string{
 		"vault":   vault,
-		"network": c.res.NodesetNetwork,
+		"network": c.settings.Network,
 	}
-	response, err := c.submitRequest(http.MethodGet, nil, params, depositDataPath, metaPath)
+	response, err := c.submitRequest(ctx, http.MethodGet, nil, params, depositDataPath, metaPath)
 	if err != nil {
 		return 0, fmt.Errorf("error getting deposit data version: %w", err)
 	}
@@ -117,13 +378,13 @@ func (c *NodesetClient) GetServerDepositDataVersion() (int, error) {
 }
 
 // Get the aggregated deposit data from the server
-func (c *NodesetClient) GetServerDepositData() (int, []types.ExtendedDepositData, error) {
-	vault := common.RemovePrefix(strings.ToLower(c.res.Vault.Hex()))
+func (c *NodesetClient) GetServerDepositData(ctx context.Context) (int, []types.ExtendedDepositData, error) {
+	vault := common.RemovePrefix(strings.ToLower(c.settings.Vault.Hex()))
 	params := map[string]string{
 		"vault":   vault,
-		"network": c.res.NodesetNetwork,
+		"network": c.settings.Network,
 	}
-	response, err := c.submitRequest(http.MethodGet, nil, params, depositDataPath)
+	response, err := c.submitRequest(ctx, http.MethodGet, nil, params, depositDataPath)
 	if err != nil {
 		return 0, nil, fmt.Errorf("error getting deposit data: %w", err)
 	}
@@ -137,8 +398,8 @@ func (c *NodesetClient) GetServerDepositData() (int, []types.ExtendedDepositData
 }
 
 // Get a list of all of the pubkeys that have already been registered with NodeSet for this node
-func (c *NodesetClient) GetRegisteredValidators() ([]beacon.ValidatorPubkey, error) {
-	response, err := c.submitRequest(http.MethodGet, nil, nil, validatorsPath)
+func (c *NodesetClient) GetRegisteredValidators(ctx context.Context) ([]beacon.ValidatorPubkey, error) {
+	response, err := c.submitRequest(ctx, http.MethodGet, nil, nil, validatorsPath)
 	if err != nil {
 		return nil, fmt.Errorf("error getting registered validators: %w", err)
 	}
@@ -151,16 +412,88 @@ func (c *NodesetClient) GetRegisteredValidators() ([]beacon.ValidatorPubkey, err
 	return body.Data, nil
 }
 
-// Send a request to the server and read the response
-func (c *NodesetClient) submitRequest(method string, body io.Reader, queryParams map[string]string, subroutes ...string) ([]byte, error) {
-	// Make the request
-	path, err := url.JoinPath(c.res.NodesetApiUrl, subroutes...)
+// Send an authenticated request to the server and read the response
+func (c *NodesetClient) submitRequest(ctx context.Context, method string, body []byte, queryParams map[string]string, subroutes ...string) ([]byte, error) {
+	token, err := c.getSessionToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.submitRequestWithRetries(ctx, method, body, queryParams, token, subroutes...)
+
+	// The server may have ended the session early, so log in again once
+	if errors.Is(err, ErrNodesetUnauthorized) {
+		c.invalidateSession(token)
+		token, err = c.getSessionToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		response, err = c.submitRequestWithRetries(ctx, method, body, queryParams, token, subroutes...)
+	}
+	return response, err
+}
+
+// Send a request to the server and read the response, retrying with exponential backoff on server and network errors.
+// Requests that aren't idempotent are only retried if the server rejected them without processing them, since a network error or
+// a generic server error could happen after the server already accepted them.
+// The session token is only attached if it's provided.
+func (c *NodesetClient) submitRequestWithRetries(ctx context.Context, method string, body []byte, queryParams map[string]string, token string, subroutes ...string) ([]byte, error) {
+	// Get the path
+	path, err := url.JoinPath(c.settings.BaseUrl, subroutes...)
 	if err != nil {
 		return nil, fmt.Errorf("error joining path [%v]: %w", subroutes, err)
 	}
-	request, err := http.NewRequest(method, path, body)
+
+	delay := c.settings.RetryDelay
+	for attempt := 0; ; attempt++ {
+		response, retryAfter, err := c.submitRequestOnce(ctx, method, path, body, queryParams, token)
+		if err == nil {
+			return response, nil
+		}
+
+		// Only retry server and network errors, not ones caused by the request or the caller's context
+		var nodesetErr *NodesetError
+		var isRetryable bool
+		if errors.As(err, &nodesetErr) {
+			isRetryable = errors.Is(nodesetErr, ErrNodesetServerError) && (isIdempotent(method) || isUnprocessed(nodesetErr))
+		} else {
+			isRetryable = isIdempotent(method) && ctx.Err() == nil
+		}
+		if !isRetryable || attempt >= c.settings.MaxRetries {
+			return nil, err
+		}
+
+		// Wait for the server's requested delay if it provided one, otherwise back off
+		wait := delay
+		if retryAfter > 0 {
+			wait = min(retryAfter, c.settings.MaxRetryDelay)
+		}
+		if c.settings.Debug {
+			fmt.Printf("NodeSet request failed (%s), retrying in %s...\n", err.Error(), wait)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w (gave up retrying: %w)", err, ctx.Err())
+		case <-time.After(wait):
+		}
+		delay = min(delay*2, c.settings.MaxRetryDelay)
+	}
+}
+
+// Send a single attempt of a request to the server, returning the server's requested retry delay if it provided one
+func (c *NodesetClient) submitRequestOnce(ctx context.Context, method string, path string, body []byte, queryParams map[string]string, token string) ([]byte, time.Duration, error) {
+	// Make the request
+	if c.settings.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.settings.RequestTimeout)
+		defer cancel()
+	}
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, path, bodyReader)
 	if err != nil {
-		return nil, fmt.Errorf("error generating request to [%s]: %w", path, err)
+		return nil, 0, fmt.Errorf("error generating request to [%s]: %w", path, err)
 	}
 	query := request.URL.Query()
 	for name, value := range queryParams {
@@ -169,21 +502,18 @@ func (c *NodesetClient) submitRequest(method string, body io.Reader, queryParams
 	request.URL.RawQuery = query.Encode()
 
 	// Set the headers
-	err = c.EnsureAuthSignatureExists()
-	if err != nil {
-		return nil, fmt.Errorf("initializing authorization signature failed: %w", err)
+	if token != "" {
+		request.Header.Set(authHeader, "Bearer "+token)
 	}
-	request.Header.Set(authHeader, common.EncodeHexWithPrefix(c.authSignature))
 	request.Header.Set("Content-Type", "application/json; charset=UTF-8")
 
 	// Upload it to the server
-	if c.debug {
+	if c.settings.Debug {
 		fmt.Printf("Sending NodeSet server request => %s\n", request.URL)
 	}
-	client := &http.Client{}
-	resp, err := client.Do(request)
+	resp, err := c.client.Do(request)
 	if err != nil {
-		return nil, fmt.Errorf("error submitting request to nodeset server: %w", err)
+		return nil, 0, fmt.Errorf("error submitting request to nodeset server: %w", err)
 	}
 
 	// Read the body
@@ -193,18 +523,54 @@ func (c *NodesetClient) submitRequest(method string, body io.Reader, queryParams
 	// Check if the request failed
 	if resp.StatusCode != http.StatusOK {
 		if err != nil {
-			return nil, fmt.Errorf("nodeset server responded to request with code %s but reading the response body failed: %w", resp.Status, err)
+			return nil, 0, fmt.Errorf("nodeset server responded to request with code %s but reading the response body failed: %w", resp.Status, err)
 		}
-		msg := string(bytes)
-		return nil, fmt.Errorf("nodeset server responded to request with code %s: [%s]", resp.Status, msg)
+		return nil, getRetryAfter(resp), newNodesetError(resp, bytes)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("error reading the response body for nodeset request: %w", err)
+		return nil, 0, fmt.Errorf("error reading the response body for nodeset request: %w", err)
 	}
 
 	// Debug log
-	if c.debug {
-		fmt.Printf("NodeSet response <= %s\n", string(bytes))
+	if c.settings.Debug {
+		fmt.Printf("NodeSet response <= %s\n", redactTokens(bytes))
+	}
+	return bytes, 0, nil
+}
+
+// Hide the values of any session tokens in a response body so they don't end up in the logs
+func redactTokens(body []byte) string {
+	return tokenFieldRegex.ReplaceAllString(string(body), `$1"<redacted>"`)
+}
+
+// Check if a request with the provided method can safely be sent more than once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// Check if an error response means the server turned the request away without processing it
+func isUnprocessed(err *NodesetError) bool {
+	return err.StatusCode == http.StatusTooManyRequests || err.StatusCode == http.StatusServiceUnavailable
+}
+
+// Get the delay requested by the Retry-After header of a response, which can either be a number of seconds or an HTTP date
+func getRetryAfter(resp *http.Response) time.Duration {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+	seconds, err := strconv.ParseUint(header, 10, 32)
+	if err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	date, err := http.ParseTime(header)
+	if err == nil {
+		return max(time.Until(date), 0)
 	}
-	return bytes, nil
+	return 0
 }
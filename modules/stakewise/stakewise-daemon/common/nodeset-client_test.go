@@ -0,0 +1,319 @@
+package swcommon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+)
+
+// A signer with a fixed address and signature
+type fakeNodesetSigner struct{}
+
+func (s *fakeNodesetSigner) GetNodeAddress() (ethcommon.Address, error) {
+	return ethcommon.HexToAddress("0x18E8bE1bC1D7Fa4E1fBB0d4cFcBd14d6E6A9C1d1"), nil
+}
+
+func (s *fakeNodesetSigner) SignMessage(message []byte) ([]byte, error) {
+	return []byte{0x01, 0x02, 0x03}, nil
+}
+
+// A NodeSet server that issues sessions and hands the deposit data routes to a test-provided handler
+type fakeNodesetServer struct {
+	*httptest.Server
+	lock       sync.Mutex
+	logins     int
+	requests   int
+	tokens     []string
+	expiry     time.Duration
+	handleData func(w http.ResponseWriter, r *http.Request, attempt int)
+}
+
+// Start a fake NodeSet server; sessions expire after the provided duration, or never if it's 0
+func newFakeNodesetServer(t *testing.T, expiry time.Duration, handleData func(w http.ResponseWriter, r *http.Request, attempt int)) *fakeNodesetServer {
+	s := &fakeNodesetServer{
+		expiry:     expiry,
+		handleData: handleData,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/nonce", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(t, w, NonceResponse{Nonce: "nonce"})
+	})
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		s.logins++
+		token := "token-" + strconv.Itoa(s.logins)
+		s.lock.Unlock()
+
+		response := LoginResponse{Token: token}
+		if s.expiry > 0 {
+			response.ExpiresAt = time.Now().Add(s.expiry)
+		}
+		writeJson(t, w, response)
+	})
+	serveData := func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		s.requests++
+		attempt := s.requests
+		s.tokens = append(s.tokens, r.Header.Get(authHeader))
+		s.lock.Unlock()
+		s.handleData(w, r, attempt)
+	}
+	mux.HandleFunc("/deposit-data", serveData)
+	mux.HandleFunc("/deposit-data/", serveData)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Get the number of logins the server handled
+func (s *fakeNodesetServer) getLogins() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.logins
+}
+
+// Get the number of deposit data requests the server handled
+func (s *fakeNodesetServer) getRequests() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests
+}
+
+// Get the session tokens sent with each deposit data request
+func (s *fakeNodesetServer) getTokens() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.tokens
+}
+
+// Serialize a response body
+func writeJson(t *testing.T, w http.ResponseWriter, body any) {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		t.Errorf("error serializing response: %s", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write(bytes)
+}
+
+// Create a client for a fake server with short retry delays
+func newTestNodesetClient(server *fakeNodesetServer) *NodesetClient {
+	return NewNodesetClient(&fakeNodesetSigner{}, NodesetClientSettings{
+		BaseUrl:        server.URL,
+		Network:        "holesky",
+		Vault:          ethcommon.HexToAddress("0x646F5285D195e08E309cF9A5aDFDF68D6Fcc51C4"),
+		RequestTimeout: 5 * time.Second,
+		MaxRetries:     3,
+		RetryDelay:     time.Millisecond,
+		MaxRetryDelay:  10 * time.Millisecond,
+	})
+}
+
+func TestNodesetRetryOnServerError(t *testing.T) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusTooManyRequests} {
+		server := newFakeNodesetServer(t, 0, func(w http.ResponseWriter, r *http.Request, attempt int) {
+			if attempt < 3 {
+				w.WriteHeader(status)
+				return
+			}
+			writeJson(t, w, DepositDataMetaResponse{Version: 7})
+		})
+
+		version, err := newTestNodesetClient(server).GetServerDepositDataVersion(context.Background())
+		server.Close()
+		if err != nil {
+			t.Fatalf("status %d: error getting version: %s", status, err.Error())
+		}
+		if version != 7 || server.getRequests() != 3 {
+			t.Fatalf("status %d: expected version 7 after 3 requests but got version %d after %d", status, version, server.getRequests())
+		}
+	}
+}
+
+func TestNodesetNoRetryForUnsafeUpload(t *testing.T) {
+	// A generic server error on an upload might have happened after the server processed it, so it can't be retried
+	server := newFakeNodesetServer(t, 0, func(w http.ResponseWriter, r *http.Request, attempt int) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer server.Close()
+	_, err := newTestNodesetClient(server).UploadDepositData(context.Background(), []byte("[]"))
+	if !errors.Is(err, ErrNodesetServerError) {
+		t.Fatalf("expected a server error but got %v", err)
+	}
+	if server.getRequests() != 1 {
+		t.Fatalf("expected 1 upload but got %d", server.getRequests())
+	}
+
+	// The server explicitly didn't process uploads it turned away, so those can be retried
+	server = newFakeNodesetServer(t, 0, func(w http.ResponseWriter, r *http.Request, attempt int) {
+		if attempt == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	})
+	defer server.Close()
+	_, err = newTestNodesetClient(server).UploadDepositData(context.Background(), []byte("[]"))
+	if err != nil {
+		t.Fatalf("error uploading deposit data: %s", err.Error())
+	}
+	if server.getRequests() != 2 {
+		t.Fatalf("expected 2 uploads but got %d", server.getRequests())
+	}
+}
+
+func TestNodesetRetryAfter(t *testing.T) {
+	server := newFakeNodesetServer(t, 0, func(w http.ResponseWriter, r *http.Request, attempt int) {
+		if attempt == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writeJson(t, w, DepositDataMetaResponse{Version: 1})
+	})
+	defer server.Close()
+
+	// The server's requested delay takes priority over the backoff
+	client := newTestNodesetClient(server)
+	client.settings.MaxRetryDelay = 5 * time.Second
+	start := time.Now()
+	_, err := client.GetServerDepositDataVersion(context.Background())
+	if err != nil {
+		t.Fatalf("error getting version: %s", err.Error())
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("retried after %s instead of waiting for the Retry-After delay", elapsed)
+	}
+}
+
+func TestNodesetRetryAfterCapped(t *testing.T) {
+	server := newFakeNodesetServer(t, 0, func(w http.ResponseWriter, r *http.Request, attempt int) {
+		if attempt == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writeJson(t, w, DepositDataMetaResponse{Version: 1})
+	})
+	defer server.Close()
+
+	// A huge requested delay is capped at the maximum retry delay
+	start := time.Now()
+	_, err := newTestNodesetClient(server).GetServerDepositDataVersion(context.Background())
+	if err != nil {
+		t.Fatalf("error getting version: %s", err.Error())
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("waited %s instead of capping the Retry-After delay", elapsed)
+	}
+}
+
+func TestNodesetRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := newFakeNodesetServer(t, 0, func(w http.ResponseWriter, r *http.Request, attempt int) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+	defer server.Close()
+	defer close(release)
+
+	client := newTestNodesetClient(server)
+	client.settings.RequestTimeout = 20 * time.Millisecond
+	start := time.Now()
+	_, err := client.GetServerDepositDataVersion(context.Background())
+	if err == nil {
+		t.Fatal("request to a hung server succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("request to a hung server took %s instead of timing out", elapsed)
+	}
+	if server.getRequests() != client.settings.MaxRetries+1 {
+		t.Fatalf("expected %d attempts but got %d", client.settings.MaxRetries+1, server.getRequests())
+	}
+}
+
+func TestNodesetErrorTypes(t *testing.T) {
+	tests := []struct {
+		status int
+		kind   error
+	}{
+		{status: http.StatusBadRequest, kind: ErrNodesetInvalidRequest},
+		{status: http.StatusNotFound, kind: ErrNodesetInvalidRequest},
+		{status: http.StatusUnauthorized, kind: ErrNodesetUnauthorized},
+		{status: http.StatusForbidden, kind: ErrNodesetUnauthorized},
+		{status: http.StatusInternalServerError, kind: ErrNodesetServerError},
+		{status: http.StatusTooManyRequests, kind: ErrNodesetServerError},
+	}
+
+	for _, test := range tests {
+		server := newFakeNodesetServer(t, 0, func(w http.ResponseWriter, r *http.Request, attempt int) {
+			w.WriteHeader(test.status)
+			_, _ = w.Write([]byte("nope"))
+		})
+		_, err := newTestNodesetClient(server).GetServerDepositDataVersion(context.Background())
+		server.Close()
+
+		if !errors.Is(err, test.kind) {
+			t.Fatalf("status %d: expected [%v] but got [%v]", test.status, test.kind, err)
+		}
+		var nodesetErr *NodesetError
+		if !errors.As(err, &nodesetErr) {
+			t.Fatalf("status %d: error isn't a NodesetError: %v", test.status, err)
+		}
+		if nodesetErr.StatusCode != test.status || nodesetErr.Message != "nope" {
+			t.Fatalf("status %d: unexpected error %+v", test.status, nodesetErr)
+		}
+	}
+}
+
+func TestNodesetSessionExpiry(t *testing.T) {
+	// Sessions that are about to expire are replaced before they're used
+	server := newFakeNodesetServer(t, sessionRefreshMargin/2, func(w http.ResponseWriter, r *http.Request, attempt int) {
+		writeJson(t, w, DepositDataMetaResponse{Version: 1})
+	})
+	defer server.Close()
+
+	client := newTestNodesetClient(server)
+	for i := 0; i < 2; i++ {
+		_, err := client.GetServerDepositDataVersion(context.Background())
+		if err != nil {
+			t.Fatalf("error getting version: %s", err.Error())
+		}
+	}
+	if server.getLogins() != 2 {
+		t.Fatalf("expected 2 logins but got %d", server.getLogins())
+	}
+	if server.getTokens()[0] != "Bearer token-1" || server.getTokens()[1] != "Bearer token-2" {
+		t.Fatalf("unexpected session tokens %v", server.getTokens())
+	}
+}
+
+func TestNodesetSessionRejected(t *testing.T) {
+	// A session the server ended early is replaced and the request is sent again
+	server := newFakeNodesetServer(t, time.Hour, func(w http.ResponseWriter, r *http.Request, attempt int) {
+		if r.Header.Get(authHeader) == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJson(t, w, DepositDataMetaResponse{Version: 1})
+	})
+	defer server.Close()
+
+	_, err := newTestNodesetClient(server).GetServerDepositDataVersion(context.Background())
+	if err != nil {
+		t.Fatalf("error getting version: %s", err.Error())
+	}
+	if server.getLogins() != 2 || server.getRequests() != 2 {
+		t.Fatalf("expected 2 logins and 2 requests but got %d and %d", server.getLogins(), server.getRequests())
+	}
+}
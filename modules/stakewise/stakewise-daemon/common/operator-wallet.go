@@ -0,0 +1,206 @@
+package swcommon
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/goccy/go-json"
+	"github.com/nodeset-org/eth-utils/eth"
+	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
+	sharedtypes "github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+const (
+	// The gas limit of a plain ETH transfer
+	transferGasLimit uint64 = 21000
+)
+
+// The parts of a Geth keystore that can be read without decrypting it
+type operatorKeystoreHeader struct {
+	Address string `json:"address"`
+}
+
+// Get the index of the secondary account currently used as the Stakewise operator's hot wallet
+func (w *Wallet) GetOperatorAccount() uint64 {
+	return w.data.OperatorAccount
+}
+
+// Get the balance the operator's hot wallet should be kept above, in wei
+func (w *Wallet) GetOperatorMinBalance() *big.Int {
+	return eth.EthToWei(w.sp.GetModuleConfig().OperatorMinBalance.Value)
+}
+
+// Derive the operator's hot wallet for the current account index from the node wallet's seed and save it where the operator container can use it.
+// Returns the address of the hot wallet.
+func (w *Wallet) InitializeOperatorWallet() (common.Address, error) {
+	operatorWallet, err := w.deriveOperatorWallet(w.data.OperatorAccount)
+	if err != nil {
+		return common.Address{}, err
+	}
+	err = w.saveOperatorWallet(operatorWallet)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return operatorWallet.AccountAddress, nil
+}
+
+// Get the address of the operator's hot wallet saved on disk, or false if it hasn't been initialized yet
+func (w *Wallet) GetOperatorAddress() (common.Address, bool, error) {
+	walletPath := filepath.Join(w.sp.GetModuleDir(), swconfig.WalletFilename)
+	bytes, err := os.ReadFile(walletPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return common.Address{}, false, nil
+	}
+	if err != nil {
+		return common.Address{}, false, fmt.Errorf("error reading operator wallet keystore: %w", err)
+	}
+
+	var header operatorKeystoreHeader
+	err = json.Unmarshal(bytes, &header)
+	if err != nil {
+		return common.Address{}, false, fmt.Errorf("error deserializing operator wallet keystore: %w", err)
+	}
+	if !common.IsHexAddress(header.Address) {
+		return common.Address{}, false, fmt.Errorf("operator wallet keystore has an invalid address [%s]", header.Address)
+	}
+	return common.HexToAddress(header.Address), true, nil
+}
+
+// Replace the operator's hot wallet with the next account derived from the node wallet's seed, moving the old one's ETH to it first if sweep is set.
+// The new account index is only saved once the sweep has been submitted, so if it fails the old hot wallet stays in place and the rotation can be retried.
+// Returns the address of the new hot wallet and the sweep transaction, which is nil if there wasn't enough ETH to move.
+func (w *Wallet) RotateOperatorWallet(sweep bool) (common.Address, *types.Transaction, error) {
+	oldKey, err := w.loadOperatorKey()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	nextAccount := w.data.OperatorAccount + 1
+	newWallet, err := w.deriveOperatorWallet(nextAccount)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	// Move the ETH before anything is saved
+	var tx *types.Transaction
+	if sweep {
+		tx, err = w.SweepOperatorWallet(oldKey, newWallet.AccountAddress)
+		if err != nil {
+			return common.Address{}, nil, fmt.Errorf("error moving ETH from the old operator wallet: %w", err)
+		}
+	}
+
+	// Commit the new account index so the old key is never reused
+	w.data.OperatorAccount = nextAccount
+	err = w.saveData()
+	if err != nil {
+		return common.Address{}, tx, err
+	}
+	err = w.saveOperatorWallet(newWallet)
+	if err != nil {
+		return common.Address{}, tx, fmt.Errorf("%w; run `hyperdrive stakewise wallet init` to restore it", err)
+	}
+	return newWallet.AccountAddress, tx, nil
+}
+
+// Send the entire balance of an old operator hot wallet, minus the cost of the transfer, to another address.
+// Returns nil if the balance is too low to cover the transfer.
+func (w *Wallet) SweepOperatorWallet(key *ecdsa.PrivateKey, recipient common.Address) (*types.Transaction, error) {
+	ec := w.sp.GetEthClient()
+	ctx := context.Background()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	balance, err := ec.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance of old operator wallet %s: %w", address.Hex(), err)
+	}
+	gasPrice, err := ec.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting gas price: %w", err)
+	}
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(transferGasLimit))
+	if balance.Cmp(cost) <= 0 {
+		return nil, nil
+	}
+	nonce, err := ec.PendingNonceAt(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("error getting nonce of old operator wallet %s: %w", address.Hex(), err)
+	}
+
+	// Sign and submit the transfer
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      transferGasLimit,
+		To:       &recipient,
+		Value:    new(big.Int).Sub(balance, cost),
+	})
+	chainID := new(big.Int).SetUint64(uint64(w.sp.GetResources().ChainID))
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), key)
+	if err != nil {
+		return nil, fmt.Errorf("error signing sweep transaction: %w", err)
+	}
+	err = ec.SendTransaction(ctx, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("error submitting sweep transaction: %w", err)
+	}
+	return signedTx, nil
+}
+
+// Ask the HD daemon to derive the operator's hot wallet for the given account index
+func (w *Wallet) deriveOperatorWallet(account uint64) (*api.WalletExportSecondaryEthKeyData, error) {
+	path := fmt.Sprintf(sharedtypes.StakewiseOperatorAccountPath, account)
+	client := w.sp.GetHyperdriveClient()
+	response, err := client.Wallet.ExportSecondaryEthKey(path)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving operator wallet for path [%s]: %w", path, err)
+	}
+	return response.Data, nil
+}
+
+// Save an operator hot wallet where the operator container can use it
+func (w *Wallet) saveOperatorWallet(operatorWallet *api.WalletExportSecondaryEthKeyData) error {
+	// Write the wallet to disk
+	moduleDir := w.sp.GetModuleDir()
+	walletPath := filepath.Join(moduleDir, swconfig.WalletFilename)
+	err := os.WriteFile(walletPath, operatorWallet.EthKeyJson, 0600)
+	if err != nil {
+		return fmt.Errorf("error saving operator wallet keystore to disk: %w", err)
+	}
+
+	// Write the password to disk
+	passwordPath := filepath.Join(moduleDir, swconfig.PasswordFilename)
+	err = os.WriteFile(passwordPath, []byte(operatorWallet.Password), 0600)
+	if err != nil {
+		return fmt.Errorf("error saving operator wallet password to disk: %w", err)
+	}
+	return nil
+}
+
+// Load and decrypt the operator's hot wallet from disk
+func (w *Wallet) loadOperatorKey() (*ecdsa.PrivateKey, error) {
+	moduleDir := w.sp.GetModuleDir()
+	walletBytes, err := os.ReadFile(filepath.Join(moduleDir, swconfig.WalletFilename))
+	if err != nil {
+		return nil, fmt.Errorf("error reading operator wallet keystore: %w", err)
+	}
+	password, err := os.ReadFile(filepath.Join(moduleDir, swconfig.PasswordFilename))
+	if err != nil {
+		return nil, fmt.Errorf("error reading operator wallet password: %w", err)
+	}
+	key, err := keystore.DecryptKey(walletBytes, string(password))
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting operator wallet keystore: %w", err)
+	}
+	return key.PrivateKey, nil
+}
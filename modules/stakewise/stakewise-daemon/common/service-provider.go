@@ -43,7 +43,9 @@ func NewStakewiseServiceProvider(sp *services.ServiceProvider[*swconfig.Stakewis
 	stakewiseSp.depositDataManager = ddMgr
 
 	// Create the nodeset client
-	nc := NewNodesetClient(stakewiseSp)
+	settings := DefaultNodesetClientSettings(res)
+	settings.Debug = cfg.DebugMode.Value
+	nc := NewNodesetClient(NewHyperdriveNodesetSigner(sp.GetHyperdriveClient()), settings)
 	stakewiseSp.nodesetClient = nc
 	return stakewiseSp, nil
 }
@@ -27,6 +27,9 @@ type stakewiseWalletData struct {
 
 	// The ID of the nodeset deposit data stored on disk
 	NodeSetDepositDataVersion int `json:"nodeSetDepositDataVersion"`
+
+	// The index of the secondary account used as the Stakewise operator's hot wallet
+	OperatorAccount uint64 `json:"operatorAccount"`
 }
 
 // Wallet manager for the Stakewise daemon
@@ -88,39 +91,70 @@ func NewWallet(sp *services.ServiceProvider[*swconfig.StakewiseConfig]) (*Wallet
 
 // Generate a new validator key and save it
 func (w *Wallet) GenerateNewValidatorKey() (*eth2types.BLSPrivateKey, error) {
-	// Get the path for the next validator key
-	path := fmt.Sprintf(types.StakewiseValidatorPath, w.data.NextAccount)
-
-	// Ask the HD daemon to generate the key
-	client := w.sp.GetHyperdriveClient()
-	response, err := client.Wallet.GenerateValidatorKey(path)
+	// Get the key for the next account
+	account := w.data.NextAccount
+	key, err := w.DeriveValidatorKey(account)
 	if err != nil {
-		return nil, fmt.Errorf("error generating validator key for path [%s]: %w", path, err)
+		return nil, err
 	}
 
 	// Increment the next account index first for safety
-	w.data.NextAccount++
-	err = w.saveData()
+	err = w.SetNextAccount(account + 1)
 	if err != nil {
 		return nil, err
 	}
 
-	// Save the key to the VC stores
+	// Save the key
+	err = w.StoreValidatorKey(key, account)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Derive the validator key for the given account index from the node wallet without saving it
+func (w *Wallet) DeriveValidatorKey(account uint64) (*eth2types.BLSPrivateKey, error) {
+	// Ask the HD daemon to generate the key
+	path := fmt.Sprintf(types.StakewiseValidatorPath, account)
+	client := w.sp.GetHyperdriveClient()
+	response, err := client.Wallet.GenerateValidatorKey(path)
+	if err != nil {
+		return nil, fmt.Errorf("error generating validator key for path [%s]: %w", path, err)
+	}
+
 	key, err := eth2types.BLSPrivateKeyFromBytes(response.Data.PrivateKey)
 	if err != nil {
 		return nil, fmt.Errorf("error converting BLS private key for path %s: %w", path, err)
 	}
-	err = w.validatorManager.StoreKey(key, path)
+	return key, nil
+}
+
+// Save the validator key for the given account index to the VC stores and the Stakewise folder
+func (w *Wallet) StoreValidatorKey(key *eth2types.BLSPrivateKey, account uint64) error {
+	// Save the key to the VC stores
+	path := fmt.Sprintf(types.StakewiseValidatorPath, account)
+	err := w.validatorManager.StoreKey(key, path)
 	if err != nil {
-		return nil, fmt.Errorf("error saving validator key: %w", err)
+		return fmt.Errorf("error saving validator key: %w", err)
 	}
 
 	// Save the key to the Stakewise folder
 	err = w.stakewiseKeystoreManager.StoreValidatorKey(key, path)
 	if err != nil {
-		return nil, fmt.Errorf("error saving validator key to the Stakewise store: %w", err)
+		return fmt.Errorf("error saving validator key to the Stakewise store: %w", err)
 	}
-	return key, nil
+	return nil
+}
+
+// Get the index of the next account to generate a validator key for
+func (w *Wallet) GetNextAccount() uint64 {
+	return w.data.NextAccount
+}
+
+// Set the index of the next account to generate a validator key for and save the wallet data
+func (w *Wallet) SetNextAccount(account uint64) error {
+	w.data.NextAccount = account
+	return w.saveData()
 }
 
 // Get the private validator key with the corresponding pubkey
@@ -35,6 +35,9 @@ func (f *nodesetSetValidatorsRootContextFactory) Create(args url.Values) (*nodes
 func (f *nodesetSetValidatorsRootContextFactory) RegisterRoute(router *mux.Router) {
 	server.RegisterQuerylessGet[*nodesetSetValidatorsRootContext, api.TxInfoData](
 		router, "set-validators-root", f, f.handler.serviceProvider.ServiceProvider,
+		[]server.ArgInfo{
+			server.Arg[common.Hash]("root"),
+		},
 	)
 }
 
@@ -1,6 +1,7 @@
 package swnodeset
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 
@@ -32,6 +33,7 @@ func (f *nodesetUploadDepositDataContextFactory) Create(args url.Values) (*nodes
 func (f *nodesetUploadDepositDataContextFactory) RegisterRoute(router *mux.Router) {
 	server.RegisterQuerylessGet[*nodesetUploadDepositDataContext, swapi.NodesetUploadDepositDataData](
 		router, "upload-deposit-data", f, f.handler.serviceProvider.ServiceProvider,
+		nil,
 	)
 }
 
@@ -48,10 +50,11 @@ func (c *nodesetUploadDepositDataContext) PrepareData(data *swapi.NodesetUploadD
 	ddMgr := sp.GetDepositDataManager()
 	nc := sp.GetNodesetClient()
 	w := sp.GetWallet()
+	ctx := context.Background()
 
 	// Get the list of registered validators
 	registeredPubkeyMap := map[beacon.ValidatorPubkey]bool{}
-	registeredPubkeys, err := nc.GetRegisteredValidators()
+	registeredPubkeys, err := nc.GetRegisteredValidators(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting registered validators: %w", err)
 	}
@@ -96,7 +99,7 @@ func (c *nodesetUploadDepositDataContext) PrepareData(data *swapi.NodesetUploadD
 	}
 
 	// Submit the upload
-	response, err := nc.UploadDepositData(bytes)
+	response, err := nc.UploadDepositData(ctx, bytes)
 	if err != nil {
 		return err
 	}
@@ -8,8 +8,10 @@ import (
 	swcommon "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/common"
 	swnodeset "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/server/nodeset"
 	swstatus "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/server/status"
+	swtasks "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/server/tasks"
 	swvalidator "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/server/validator"
 	swwallet "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/server/wallet"
+	"github.com/nodeset-org/hyperdrive/shared"
 )
 
 type StakewiseServer struct {
@@ -24,11 +26,14 @@ func NewStakewiseServer(sp *swcommon.StakewiseServiceProvider) (*StakewiseServer
 		swvalidator.NewValidatorHandler(sp),
 		swwallet.NewWalletHandler(sp),
 		swstatus.NewStatusHandler(sp),
+		swtasks.NewTasksHandler(sp),
 	}
 	mgr, err := server.NewApiServer(socketPath, handlers, swconfig.ModuleName)
 	if err != nil {
 		return nil, err
 	}
+	mgr.RegisterEventStream(sp.GetEventBus())
+	mgr.RegisterApiSpec("Stakewise Daemon API", shared.HyperdriveVersion)
 
 	return &StakewiseServer{
 		ApiManager: mgr,
@@ -31,6 +31,7 @@ func (f *statusGetActiveValidatorsContextFactory) Create(args url.Values) (*stat
 func (f *statusGetActiveValidatorsContextFactory) RegisterRoute(router *mux.Router) {
 	server.RegisterQuerylessGet[*statusGetActiveValidatorsContext, swapi.ActiveValidatorsData](
 		router, "status", f, f.handler.serviceProvider.ServiceProvider,
+		nil,
 	)
 }
 
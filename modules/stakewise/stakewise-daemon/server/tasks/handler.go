@@ -0,0 +1,30 @@
+package swtasks
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	swcommon "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/common"
+)
+
+type TasksHandler struct {
+	serviceProvider *swcommon.StakewiseServiceProvider
+	factories       []server.IContextFactory
+}
+
+func NewTasksHandler(serviceProvider *swcommon.StakewiseServiceProvider) *TasksHandler {
+	h := &TasksHandler{
+		serviceProvider: serviceProvider,
+	}
+	h.factories = []server.IContextFactory{
+		&tasksListContextFactory{h},
+		&tasksRunContextFactory{h},
+	}
+	return h
+}
+
+func (h *TasksHandler) RegisterRoutes(router *mux.Router) {
+	subrouter := router.PathPrefix("/tasks").Subrouter()
+	for _, factory := range h.factories {
+		factory.RegisterRoute(subrouter)
+	}
+}
@@ -0,0 +1,51 @@
+package swtasks
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type tasksRunContextFactory struct {
+	handler *TasksHandler
+}
+
+func (f *tasksRunContextFactory) Create(args url.Values) (*tasksRunContext, error) {
+	c := &tasksRunContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{
+		server.GetStringFromVars("name", args, &c.name),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *tasksRunContextFactory) RegisterRoute(router *mux.Router) {
+	server.RegisterQuerylessGet[*tasksRunContext, api.SuccessData](
+		router, "run", f, f.handler.serviceProvider.ServiceProvider,
+		[]server.ArgInfo{
+			server.Arg[string]("name"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type tasksRunContext struct {
+	handler *TasksHandler
+	name    string
+}
+
+func (c *tasksRunContext) PrepareData(data *api.SuccessData, opts *bind.TransactOpts) error {
+	return c.handler.serviceProvider.GetTaskScheduler().Trigger(c.name)
+}
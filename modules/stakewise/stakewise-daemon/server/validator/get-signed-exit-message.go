@@ -43,6 +43,10 @@ func (f *validatorGetSignedExitMessagesContextFactory) Create(args url.Values) (
 func (f *validatorGetSignedExitMessagesContextFactory) RegisterRoute(router *mux.Router) {
 	server.RegisterQuerylessGet[*validatorGetSignedExitMessagesContext, api.ValidatorGetSignedExitMessagesData](
 		router, "get-signed-exit-messages", f, f.handler.serviceProvider.ServiceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[uint64]("epoch"),
+			server.BatchArg[beacon.ValidatorPubkey]("pubkeys"),
+		},
 	)
 }
 
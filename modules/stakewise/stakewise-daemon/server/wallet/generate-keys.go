@@ -36,6 +36,10 @@ func (f *walletGenerateKeysContextFactory) Create(args url.Values) (*walletGener
 func (f *walletGenerateKeysContextFactory) RegisterRoute(router *mux.Router) {
 	server.RegisterQuerylessGet[*walletGenerateKeysContext, api.WalletGenerateKeysData](
 		router, "generate-keys", f, f.handler.serviceProvider.ServiceProvider,
+		[]server.ArgInfo{
+			server.Arg[uint64]("count"),
+			server.Arg[bool]("restart-vc"),
+		},
 	)
 }
 
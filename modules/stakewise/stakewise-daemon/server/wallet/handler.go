@@ -18,6 +18,10 @@ func NewWalletHandler(serviceProvider *swcommon.StakewiseServiceProvider) *Walle
 	h.factories = []server.IContextFactory{
 		&walletGenerateKeysContextFactory{h},
 		&walletInitializeContextFactory{h},
+		&walletOperatorStatusContextFactory{h},
+		&walletRecoverKeysContextFactory{h},
+		&walletRotateOperatorContextFactory{h},
+		&walletTopUpOperatorContextFactory{h},
 	}
 	return h
 }
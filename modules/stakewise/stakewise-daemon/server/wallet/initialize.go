@@ -3,14 +3,11 @@ package swwallet
 import (
 	"fmt"
 	"net/url"
-	"os"
-	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/gorilla/mux"
 	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
 	api "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/api"
-	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
 )
 
 // ===============
@@ -31,6 +28,7 @@ func (f *walletInitializeContextFactory) Create(args url.Values) (*walletInitial
 func (f *walletInitializeContextFactory) RegisterRoute(router *mux.Router) {
 	server.RegisterQuerylessGet[*walletInitializeContext, api.WalletInitializeData](
 		router, "initialize", f, f.handler.serviceProvider.ServiceProvider,
+		nil,
 	)
 }
 
@@ -45,6 +43,7 @@ type walletInitializeContext struct {
 func (c *walletInitializeContext) PrepareData(data *api.WalletInitializeData, opts *bind.TransactOpts) error {
 	sp := c.handler.serviceProvider
 	client := sp.GetHyperdriveClient()
+	w := sp.GetWallet()
 
 	// Get the wallet status
 	response, err := client.Wallet.Status()
@@ -64,29 +63,12 @@ func (c *walletInitializeContext) PrepareData(data *api.WalletInitializeData, op
 		}
 	*/
 
-	// Get the Geth keystore in JSON format
-	ethkeyResponse, err := client.Wallet.ExportEthKey()
+	// Derive the operator's hot wallet and write it to disk
+	address, err := w.InitializeOperatorWallet()
 	if err != nil {
-		return fmt.Errorf("error getting geth-style keystore: %w", err)
+		return fmt.Errorf("error initializing operator wallet: %w", err)
 	}
-	ethKey := ethkeyResponse.Data.EthKeyJson
-	password := ethkeyResponse.Data.Password
 
-	// Write the wallet to disk
-	moduleDir := sp.GetModuleDir()
-	walletPath := filepath.Join(moduleDir, swconfig.WalletFilename)
-	err = os.WriteFile(walletPath, ethKey, 0600)
-	if err != nil {
-		return fmt.Errorf("error saving wallet keystore to disk: %w", err)
-	}
-
-	// Write the password to disk
-	passwordPath := filepath.Join(moduleDir, swconfig.PasswordFilename)
-	err = os.WriteFile(passwordPath, []byte(password), 0600)
-	if err != nil {
-		return fmt.Errorf("error saving wallet password to disk: %w", err)
-	}
-
-	data.AccountAddress = status.Wallet.WalletAddress
+	data.AccountAddress = address
 	return nil
 }
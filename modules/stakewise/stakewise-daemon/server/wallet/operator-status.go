@@ -0,0 +1,84 @@
+package swwallet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	api "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/api"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletOperatorStatusContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletOperatorStatusContextFactory) Create(args url.Values) (*walletOperatorStatusContext, error) {
+	c := &walletOperatorStatusContext{
+		handler: f.handler,
+	}
+	return c, nil
+}
+
+func (f *walletOperatorStatusContextFactory) RegisterRoute(router *mux.Router) {
+	server.RegisterQuerylessGet[*walletOperatorStatusContext, api.WalletOperatorStatusData](
+		router, "operator-status", f, f.handler.serviceProvider.ServiceProvider,
+		nil,
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletOperatorStatusContext struct {
+	handler *WalletHandler
+}
+
+func (c *walletOperatorStatusContext) PrepareData(data *api.WalletOperatorStatusData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	client := sp.GetHyperdriveClient()
+	ec := sp.GetEthClient()
+	w := sp.GetWallet()
+
+	data.Account = w.GetOperatorAccount()
+	data.MinBalance = w.GetOperatorMinBalance()
+	data.Balance = big.NewInt(0)
+
+	// Get the hot wallet
+	address, isInitialized, err := w.GetOperatorAddress()
+	if err != nil {
+		return err
+	}
+	data.IsInitialized = isInitialized
+	if !isInitialized {
+		return nil
+	}
+	data.AccountAddress = address
+
+	// Check if it's still a copy of the node wallet from before the operator had its own
+	response, err := client.Wallet.Status()
+	if err != nil {
+		return fmt.Errorf("error getting wallet status: %w", err)
+	}
+	data.IsNodeAccount = (address == response.Data.WalletStatus.Wallet.WalletAddress)
+
+	// Get the balance
+	err = sp.RequireEthClientSynced(context.Background())
+	if err != nil {
+		return err
+	}
+	data.Balance, err = ec.BalanceAt(context.Background(), address, nil)
+	if err != nil {
+		return fmt.Errorf("error getting operator wallet balance: %w", err)
+	}
+	data.IsBalanceLow = (data.Balance.Cmp(data.MinBalance) < 0)
+	return nil
+}
@@ -0,0 +1,168 @@
+package swwallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/eth-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	api "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/api"
+	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+const (
+	defaultRecoverKeysGapLimit uint64 = 20
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletRecoverKeysContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletRecoverKeysContextFactory) Create(args url.Values) (*walletRecoverKeysContext, error) {
+	c := &walletRecoverKeysContext{
+		handler:  f.handler,
+		gapLimit: defaultRecoverKeysGapLimit,
+	}
+	inputErrs := []error{
+		server.ValidateOptionalArg("start-index", args, input.ValidateUint, &c.startIndex, nil),
+		server.ValidateOptionalArg("gap-limit", args, input.ValidatePositiveUint, &c.gapLimit, nil),
+		server.ValidateArg("restart-vc", args, input.ValidateBool, &c.restartVc),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *walletRecoverKeysContextFactory) RegisterRoute(router *mux.Router) {
+	server.RegisterQuerylessGet[*walletRecoverKeysContext, api.WalletRecoverKeysData](
+		router, "recover-keys", f, f.handler.serviceProvider.ServiceProvider,
+		[]server.ArgInfo{
+			server.OptionalArg[uint64]("start-index"),
+			server.OptionalArg[uint64]("gap-limit"),
+			server.Arg[bool]("restart-vc"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletRecoverKeysContext struct {
+	handler    *WalletHandler
+	startIndex uint64
+	gapLimit   uint64
+	restartVc  bool
+}
+
+func (c *walletRecoverKeysContext) PrepareData(data *api.WalletRecoverKeysData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	client := sp.GetHyperdriveClient()
+	bc := sp.GetBeaconClient()
+	nc := sp.GetNodesetClient()
+	wallet := sp.GetWallet()
+	ctx := context.Background()
+	data.RecoveredKeys = []api.RecoveredValidatorKey{}
+
+	// Get the wallet status
+	response, err := client.Wallet.Status()
+	if err != nil {
+		return fmt.Errorf("error getting wallet status: %w", err)
+	}
+	status := response.Data.WalletStatus
+	if !status.Wallet.IsLoaded {
+		return fmt.Errorf("Hyperdrive does not currently have a wallet ready")
+	}
+
+	// Requirements
+	err = sp.RequireBeaconClientSynced(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Get the validators registered with NodeSet
+	registeredPubkeys, err := nc.GetRegisteredValidators(ctx)
+	if err != nil {
+		return err
+	}
+	registered := map[beacon.ValidatorPubkey]bool{}
+	for _, pubkey := range registeredPubkeys {
+		registered[pubkey] = true
+	}
+
+	// Scan the key path in batches until there are gapLimit unused keys in a row after the last used one
+	usedEnd := c.startIndex // One past the highest used account
+	nextAccount := c.startIndex
+	for nextAccount-usedEnd < c.gapLimit {
+		// Derive the next batch of keys
+		batchStart := nextAccount
+		keys := make([]*eth2types.BLSPrivateKey, c.gapLimit)
+		pubkeys := make([]beacon.ValidatorPubkey, c.gapLimit)
+		for i := range keys {
+			key, err := wallet.DeriveValidatorKey(batchStart + uint64(i))
+			if err != nil {
+				return err
+			}
+			keys[i] = key
+			pubkeys[i] = beacon.ValidatorPubkey(key.PublicKey().Marshal())
+		}
+		nextAccount += c.gapLimit
+
+		// Check them against the Beacon chain
+		statuses, err := bc.GetValidatorStatuses(ctx, pubkeys, nil)
+		if err != nil {
+			return fmt.Errorf("error getting validator statuses: %w", err)
+		}
+
+		// Restore the ones that have been used
+		for i, key := range keys {
+			pubkey := pubkeys[i]
+			account := batchStart + uint64(i)
+			beaconStatus, exists := statuses[pubkey]
+			isOnBeacon := exists && beaconStatus.Exists
+			isRegistered := registered[pubkey]
+			if !isOnBeacon && !isRegistered {
+				continue
+			}
+
+			err = wallet.StoreValidatorKey(key, account)
+			if err != nil {
+				return err
+			}
+			usedEnd = account + 1
+			data.RecoveredKeys = append(data.RecoveredKeys, api.RecoveredValidatorKey{
+				Pubkey:       pubkey,
+				Account:      account,
+				IsRegistered: isRegistered,
+				IsOnBeacon:   isOnBeacon,
+			})
+		}
+	}
+	data.SearchedCount = nextAccount - c.startIndex
+
+	// Move the next account past the highest used key so new keys don't collide with recovered ones
+	if len(data.RecoveredKeys) > 0 && usedEnd > wallet.GetNextAccount() {
+		err = wallet.SetNextAccount(usedEnd)
+		if err != nil {
+			return err
+		}
+	}
+	data.NextAccount = wallet.GetNextAccount()
+
+	// Restart the VC
+	if c.restartVc && len(data.RecoveredKeys) > 0 {
+		_, err = client.Service.RestartContainer(string(swconfig.ContainerID_StakewiseValidator))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
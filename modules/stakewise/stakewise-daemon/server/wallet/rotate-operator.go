@@ -0,0 +1,91 @@
+package swwallet
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	api "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/api"
+	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletRotateOperatorContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletRotateOperatorContextFactory) Create(args url.Values) (*walletRotateOperatorContext, error) {
+	c := &walletRotateOperatorContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{
+		server.ValidateArg("restart-operator", args, input.ValidateBool, &c.restartOperator),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *walletRotateOperatorContextFactory) RegisterRoute(router *mux.Router) {
+	server.RegisterQuerylessGet[*walletRotateOperatorContext, api.WalletRotateOperatorData](
+		router, "rotate-operator", f, f.handler.serviceProvider.ServiceProvider,
+		[]server.ArgInfo{
+			server.Arg[bool]("restart-operator"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletRotateOperatorContext struct {
+	handler         *WalletHandler
+	restartOperator bool
+}
+
+func (c *walletRotateOperatorContext) PrepareData(data *api.WalletRotateOperatorData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	client := sp.GetHyperdriveClient()
+	w := sp.GetWallet()
+
+	// Requirements
+	err := sp.RequireWalletReady()
+	if err != nil {
+		return err
+	}
+	oldAddress, isInitialized, err := w.GetOperatorAddress()
+	if err != nil {
+		return err
+	}
+	if !isInitialized {
+		return fmt.Errorf("the operator wallet hasn't been initialized yet")
+	}
+
+	// Replace the hot wallet, moving the old one's ETH to the new one unless the old one was a copy of the node wallet
+	newAddress, tx, err := w.RotateOperatorWallet(oldAddress != opts.From)
+	if tx != nil {
+		hash := tx.Hash()
+		data.SweepTxHash = &hash
+	}
+	if err != nil {
+		return fmt.Errorf("error rotating operator wallet: %w", err)
+	}
+	data.OldAddress = oldAddress
+	data.NewAddress = newAddress
+	data.Account = w.GetOperatorAccount()
+
+	// Restart the operator so it picks up the new wallet
+	if c.restartOperator {
+		_, err = client.Service.RestartContainer(string(swconfig.ContainerID_StakewiseOperator))
+		if err != nil {
+			return fmt.Errorf("error restarting Stakewise operator container: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package swwallet
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/server"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/input"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+type walletTopUpOperatorContextFactory struct {
+	handler *WalletHandler
+}
+
+func (f *walletTopUpOperatorContextFactory) Create(args url.Values) (*walletTopUpOperatorContext, error) {
+	c := &walletTopUpOperatorContext{
+		handler: f.handler,
+	}
+	inputErrs := []error{
+		server.ValidateArg("amount", args, input.ValidatePositiveWeiAmount, &c.amount),
+	}
+	return c, errors.Join(inputErrs...)
+}
+
+func (f *walletTopUpOperatorContextFactory) RegisterRoute(router *mux.Router) {
+	server.RegisterQuerylessGet[*walletTopUpOperatorContext, api.TxInfoData](
+		router, "top-up-operator", f, f.handler.serviceProvider.ServiceProvider,
+		[]server.ArgInfo{
+			server.Arg[*big.Int]("amount"),
+		},
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type walletTopUpOperatorContext struct {
+	handler *WalletHandler
+	amount  *big.Int
+}
+
+func (c *walletTopUpOperatorContext) PrepareData(data *api.TxInfoData, opts *bind.TransactOpts) error {
+	sp := c.handler.serviceProvider
+	txMgr := sp.GetTransactionManager()
+	w := sp.GetWallet()
+
+	// Requirements
+	err := sp.RequireWalletReady()
+	if err != nil {
+		return err
+	}
+
+	// Get the hot wallet
+	address, isInitialized, err := w.GetOperatorAddress()
+	if err != nil {
+		return err
+	}
+	if !isInitialized {
+		return fmt.Errorf("the operator wallet hasn't been initialized yet")
+	}
+	if address == opts.From {
+		return fmt.Errorf("the operator wallet is still a copy of the node wallet; initialize it again to give it its own account before topping it up")
+	}
+
+	// Send ETH from the node wallet to it
+	opts.Value = c.amount
+	data.TxInfo = txMgr.CreateTransactionInfoRaw(address, nil, opts)
+	return nil
+}
@@ -63,9 +63,8 @@ func main() {
 			os.Exit(1)
 		}
 
-		// Wait group to handle the API server (separate because of error handling)
+		// Wait group to handle graceful stopping
 		stopWg := new(sync.WaitGroup)
-		stopWg.Add(1)
 
 		// Create the service provider
 		sp, err := services.NewServiceProvider(moduleDir, swconfig.NewStakewiseConfig)
@@ -0,0 +1,74 @@
+package swtasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nodeset-org/eth-utils/eth"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
+	swcommon "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/common"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
+	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+)
+
+const (
+	operatorBalanceLowAlertID string = "operator-balance-low"
+)
+
+// Check operator balance task
+type CheckOperatorBalance struct {
+	sp  *swcommon.StakewiseServiceProvider
+	log log.ColorLogger
+}
+
+// Create check operator balance task
+func NewCheckOperatorBalance(sp *swcommon.StakewiseServiceProvider, logger log.ColorLogger) *CheckOperatorBalance {
+	return &CheckOperatorBalance{
+		sp:  sp,
+		log: logger,
+	}
+}
+
+// Check if the operator's hot wallet has enough ETH to pay for its transactions
+func (t *CheckOperatorBalance) Run() error {
+	w := t.sp.GetWallet()
+	address, isInitialized, err := w.GetOperatorAddress()
+	if err != nil {
+		return fmt.Errorf("error getting operator wallet address: %w", err)
+	}
+	if !isInitialized {
+		return nil
+	}
+	t.log.Println("Checking operator wallet balance...")
+
+	// Get the balance
+	ec := t.sp.GetEthClient()
+	balance, err := ec.BalanceAt(context.Background(), address, nil)
+	if err != nil {
+		return fmt.Errorf("error getting operator wallet balance: %w", err)
+	}
+	minBalance := w.GetOperatorMinBalance()
+
+	// Send the alerts
+	notifier := t.sp.GetNotifier()
+	if balance.Cmp(minBalance) < 0 {
+		t.log.Printlnf("WARNING: the operator wallet %s only has %.6f ETH, which is below the minimum of %.6f ETH. Top it up with `hyperdrive stakewise wallet top-up-operator`.", address.Hex(), eth.WeiToEth(balance), eth.WeiToEth(minBalance))
+		t.sp.GetEventBus().Publish(api.EventType_OperatorBalanceLow, api.OperatorBalanceLowEvent{
+			Address:    address,
+			Balance:    balance,
+			MinBalance: minBalance,
+		})
+		if notifier.IsEnabled() {
+			err = notifier.Raise(operatorBalanceLowAlertID, notifications.AlertLevel_Warning, "Operator wallet balance low", fmt.Sprintf("The Stakewise operator wallet %s only has %.6f ETH, which is below the minimum of %.6f ETH. It may not be able to pay for its transactions until you top it up.", address.Hex(), eth.WeiToEth(balance), eth.WeiToEth(minBalance)))
+			if err != nil {
+				t.log.Printlnf("WARNING: %s", err.Error())
+			}
+		}
+	} else if notifier.IsEnabled() {
+		err = notifier.Resolve(operatorBalanceLowAlertID, "Operator wallet balance restored", fmt.Sprintf("The Stakewise operator wallet %s has %.6f ETH again.", address.Hex(), eth.WeiToEth(balance)))
+		if err != nil {
+			t.log.Printlnf("WARNING: %s", err.Error())
+		}
+	}
+	return nil
+}
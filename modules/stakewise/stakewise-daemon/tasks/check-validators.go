@@ -0,0 +1,131 @@
+package swtasks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nodeset-org/eth-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
+	swcommon "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/common"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/utils/log"
+)
+
+const (
+	validatorsOfflineAlertID string = "validators-offline"
+	validatorsSlashedAlertID string = "validators-slashed"
+
+	// The number of epochs between balance samples used to detect offline validators
+	balanceSampleEpochs uint64 = 2
+)
+
+// A validator's balance at a given epoch
+type balanceSample struct {
+	epoch   uint64
+	balance uint64
+}
+
+// Check validators task
+type CheckValidators struct {
+	sp      *swcommon.StakewiseServiceProvider
+	log     log.ColorLogger
+	samples map[beacon.ValidatorPubkey]balanceSample
+}
+
+// Create check validators task
+func NewCheckValidators(sp *swcommon.StakewiseServiceProvider, logger log.ColorLogger) *CheckValidators {
+	return &CheckValidators{
+		sp:      sp,
+		log:     logger,
+		samples: map[beacon.ValidatorPubkey]balanceSample{},
+	}
+}
+
+// Check if any of the validators have gone offline or been slashed
+func (t *CheckValidators) Run() error {
+	notifier := t.sp.GetNotifier()
+	if !notifier.IsEnabled() {
+		return nil
+	}
+	t.log.Println("Checking validator statuses...")
+
+	// Get the validators
+	w := t.sp.GetWallet()
+	privateKeys, err := w.GetAllPrivateKeys()
+	if err != nil {
+		return fmt.Errorf("error getting private keys: %w", err)
+	}
+	pubkeys, err := w.DerivePubKeys(privateKeys)
+	if err != nil {
+		return fmt.Errorf("error getting public keys: %w", err)
+	}
+	if len(pubkeys) == 0 {
+		return nil
+	}
+
+	// Get their statuses
+	bc := t.sp.GetBeaconClient()
+	head, err := bc.GetBeaconHead(context.Background())
+	if err != nil {
+		return fmt.Errorf("error getting beacon head: %w", err)
+	}
+	statuses, err := bc.GetValidatorStatuses(context.Background(), pubkeys, nil)
+	if err != nil {
+		return fmt.Errorf("error getting validator statuses: %w", err)
+	}
+
+	slashed := []string{}
+	offline := []string{}
+	comparedCount := 0
+	for _, pubkey := range pubkeys {
+		status, exists := statuses[pubkey]
+		if !exists || !status.Exists {
+			continue
+		}
+		if status.Slashed {
+			slashed = append(slashed, pubkey.HexWithPrefix())
+		}
+
+		// Only active validators should be earning rewards
+		if status.Status != types.ValidatorState_ActiveOngoing {
+			delete(t.samples, pubkey)
+			continue
+		}
+
+		// Compare the balance to the previous sample once enough epochs have passed
+		sample, exists := t.samples[pubkey]
+		if !exists {
+			t.samples[pubkey] = balanceSample{epoch: head.Epoch, balance: status.Balance}
+			continue
+		}
+		if head.Epoch < sample.epoch+balanceSampleEpochs {
+			continue
+		}
+		comparedCount++
+		if status.Balance < sample.balance {
+			offline = append(offline, pubkey.HexWithPrefix())
+		}
+		t.samples[pubkey] = balanceSample{epoch: head.Epoch, balance: status.Balance}
+	}
+
+	// Send the alerts
+	if len(slashed) > 0 {
+		err = notifier.Raise(validatorsSlashedAlertID, notifications.AlertLevel_Critical, "Validators slashed", fmt.Sprintf("The following validators have been slashed:\n%s", strings.Join(slashed, "\n")))
+		if err != nil {
+			t.log.Printlnf("WARNING: %s", err.Error())
+		}
+	}
+	if len(offline) > 0 {
+		err = notifier.Raise(validatorsOfflineAlertID, notifications.AlertLevel_Critical, "Validators offline", fmt.Sprintf("The balances of the following validators have decreased over the last %d epochs, so they are likely offline:\n%s", balanceSampleEpochs, strings.Join(offline, "\n")))
+		if err != nil {
+			t.log.Printlnf("WARNING: %s", err.Error())
+		}
+	} else if comparedCount > 0 {
+		err = notifier.Resolve(validatorsOfflineAlertID, "Validators online", "All of your active validators are earning rewards again.")
+		if err != nil {
+			t.log.Printlnf("WARNING: %s", err.Error())
+		}
+	}
+	return nil
+}
@@ -2,22 +2,27 @@ package swtasks
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/scheduler"
 	swcommon "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/common"
 	"github.com/nodeset-org/hyperdrive/shared/utils/log"
 )
 
 // Config
 var tasksInterval, _ = time.ParseDuration("5m")
-var taskCooldown, _ = time.ParseDuration("10s")
+var taskTimeout, _ = time.ParseDuration("2m")
+var taskJitter, _ = time.ParseDuration("30s")
 
 const (
 	ErrorColor             = color.FgRed
 	WarningColor           = color.FgYellow
 	UpdateDepositDataColor = color.FgHiWhite
+	CheckValidatorsColor   = color.FgHiMagenta
+	CheckOperatorColor     = color.FgHiGreen
 )
 
 type TaskLoop struct {
@@ -37,51 +42,58 @@ func NewTaskLoop(sp *swcommon.StakewiseServiceProvider, wg *sync.WaitGroup) *Tas
 	}
 }
 
-// Run daemon
+// Register the tasks with the scheduler and start running them
 func (t *TaskLoop) Run() error {
-	// Initialize loggers
-	errorLog := log.NewColorLogger(ErrorColor)
-
 	// Initialize tasks
 	updateDepositData := NewUpdateDepositData(t.sp, log.NewColorLogger(UpdateDepositDataColor))
-
-	// Run the loop
-	go func() {
-		for {
-			// Check the EC status
-			err := t.sp.WaitEthClientSynced(t.ctx, false) // Force refresh the primary / fallback EC status
-			if err != nil {
-				errorLog.Println(err)
-				if t.sleepAndCheckIfCancelled(taskCooldown) {
-					break
-				}
-				continue
-			}
-
-			// Check the BC status
-			err = t.sp.WaitBeaconClientSynced(t.ctx, false) // Force refresh the primary / fallback BC status
-			if err != nil {
-				errorLog.Println(err)
-				if t.sleepAndCheckIfCancelled(taskCooldown) {
-					break
-				}
-				continue
-			}
-
-			// Update deposit data from the NodeSet server
-			if err := updateDepositData.Run(); err != nil {
-				errorLog.Println(err)
-			}
-			// time.Sleep(taskCooldown)
-
-			if t.sleepAndCheckIfCancelled(tasksInterval) {
-				break
-			}
+	checkValidators := NewCheckValidators(t.sp, log.NewColorLogger(CheckValidatorsColor))
+	checkOperatorBalance := NewCheckOperatorBalance(t.sp, log.NewColorLogger(CheckOperatorColor))
+
+	// Register them; each pass runs them in this order
+	taskScheduler := t.sp.GetTaskScheduler()
+	tasks := []scheduler.TaskInfo{
+		{
+			// Jittered so nodes don't all hit the NodeSet server at the same time
+			Name:         "update-deposit-data",
+			Description:  "Updates the vault's deposit data from the NodeSet server",
+			Interval:     tasksInterval,
+			Jitter:       taskJitter,
+			Timeout:      taskTimeout,
+			Requirements: scheduler.Requirement_EthClientSynced | scheduler.Requirement_BeaconClientSynced,
+			Run: func(ctx context.Context) error {
+				return updateDepositData.Run()
+			},
+		},
+		{
+			Name:         "check-validators",
+			Description:  "Checks for offline or slashed validators",
+			Interval:     tasksInterval,
+			Timeout:      taskTimeout,
+			Requirements: scheduler.Requirement_EthClientSynced | scheduler.Requirement_BeaconClientSynced,
+			Run: func(ctx context.Context) error {
+				return checkValidators.Run()
+			},
+		},
+		{
+			Name:         "check-operator-balance",
+			Description:  "Checks that the operator's hot wallet has enough ETH for its transactions",
+			Interval:     tasksInterval,
+			Timeout:      taskTimeout,
+			Requirements: scheduler.Requirement_EthClientSynced,
+			Run: func(ctx context.Context) error {
+				return checkOperatorBalance.Run()
+			},
+		},
+	}
+	for _, task := range tasks {
+		err := taskScheduler.Register(task)
+		if err != nil {
+			return fmt.Errorf("error registering task [%s]: %w", task.Name, err)
 		}
+	}
 
-		// Signal the task loop is done
-		t.wg.Done()
-	}()
+	// Run the scheduler
+	taskScheduler.Start(t.ctx, t.wg)
 
 	/*
 		// Run metrics loop
@@ -99,17 +111,3 @@ func (t *TaskLoop) Run() error {
 func (t *TaskLoop) Stop() {
 	t.cancel()
 }
-
-func (t *TaskLoop) sleepAndCheckIfCancelled(duration time.Duration) bool {
-	timer := time.NewTimer(duration)
-	select {
-	case <-t.ctx.Done():
-		// Cancel occurred
-		timer.Stop()
-		return true
-
-	case <-timer.C:
-		// Duration has passed without a cancel
-		return false
-	}
-}
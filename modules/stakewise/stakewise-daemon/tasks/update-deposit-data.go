@@ -1,17 +1,27 @@
 package swtasks
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/nodeset-org/hyperdrive/daemon-utils/notifications"
 	swconfig "github.com/nodeset-org/hyperdrive/modules/stakewise/shared/config"
 	swcommon "github.com/nodeset-org/hyperdrive/modules/stakewise/stakewise-daemon/common"
 	"github.com/nodeset-org/hyperdrive/shared/config"
 	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/types/api"
 	"github.com/nodeset-org/hyperdrive/shared/utils/log"
 	batch "github.com/rocket-pool/batch-query"
 )
 
+const (
+	depositsRootMismatchAlertID string = "deposits-root-mismatch"
+	invalidDepositDataAlertID   string = "invalid-deposit-data"
+)
+
 // Update deposit data task
 type UpdateDepositData struct {
 	sp  *swcommon.StakewiseServiceProvider
@@ -37,9 +47,13 @@ func (t *UpdateDepositData) Run() error {
 	ns := t.sp.GetNodesetClient()
 	ddMgr := t.sp.GetDepositDataManager()
 	cfg := t.sp.GetModuleConfig()
+	ctx := context.Background()
 
 	// Get the version on the server
-	remoteVersion, err := ns.GetServerDepositDataVersion()
+	remoteVersion, err := ns.GetServerDepositDataVersion(ctx)
+	if errors.Is(err, swcommon.ErrNodesetUnauthorized) {
+		t.log.Println("WARNING: the NodeSet server didn't accept this node's authorization. Make sure your node address is registered with NodeSet.")
+	}
 	if err != nil {
 		return fmt.Errorf("error getting latest deposit data version: %w", err)
 	}
@@ -53,12 +67,18 @@ func (t *UpdateDepositData) Run() error {
 
 	// Get the new data
 	t.log.Printlnf("Latest data version is %d but we have %d, retrieving latest data...", remoteVersion, localVersion)
-	_, depositData, err := ns.GetServerDepositData()
+	_, depositData, err := ns.GetServerDepositData(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting latest deposit data: %w", err)
 	}
 
-	// Verify the merkle roots if enabled
+	// Validate each entry
+	validData, isValid := t.validateDepositData(depositData, remoteVersion, cfg.QuarantineInvalidDeposits.Value)
+	if !isValid {
+		return nil
+	}
+
+	// Verify the merkle roots if enabled - this covers every entry from the server, including quarantined ones
 	if cfg.VerifyDepositsRoot.Value {
 		isMatch, err := t.verifyDepositsRoot(depositData)
 		if err != nil {
@@ -70,7 +90,7 @@ func (t *UpdateDepositData) Run() error {
 	}
 
 	// Save it
-	err = ddMgr.UpdateDepositData(depositData)
+	err = ddMgr.UpdateDepositData(validData)
 	if err != nil {
 		return fmt.Errorf("error saving deposit data: %w", err)
 	}
@@ -78,6 +98,11 @@ func (t *UpdateDepositData) Run() error {
 	if err != nil {
 		return fmt.Errorf("error updating latest saved version number: %w", err)
 	}
+	t.sp.GetEventBus().Publish(api.EventType_DepositDataUpdated, api.DepositDataUpdatedEvent{
+		OldVersion: localVersion,
+		NewVersion: remoteVersion,
+		Count:      len(validData),
+	})
 
 	// Restart the Stakewise op container
 	t.log.Printlnf("Restarting Stakewise operator...")
@@ -90,6 +115,48 @@ func (t *UpdateDepositData) Run() error {
 	return nil
 }
 
+// Validate each deposit data entry, returning the entries to save and whether or not the update can proceed
+func (t *UpdateDepositData) validateDepositData(depositData []types.ExtendedDepositData, version int, quarantine bool) ([]types.ExtendedDepositData, bool) {
+	ddMgr := t.sp.GetDepositDataManager()
+	notifier := t.sp.GetNotifier()
+
+	validData, rejected := ddMgr.ValidateDepositData(depositData)
+	if len(rejected) == 0 {
+		t.log.Printlnf("All %d deposit data entries passed validation.", len(depositData))
+		err := notifier.Resolve(invalidDepositDataAlertID, "Deposit data is valid", "All of the deposit data entries from NodeSet passed validation again.")
+		if err != nil {
+			t.log.Printlnf("WARNING: %s", err.Error())
+		}
+		return validData, true
+	}
+
+	// Report the rejected entries
+	t.log.Printlnf("WARNING: %d of %d deposit data entries failed validation:", len(rejected), len(depositData))
+	details := []string{}
+	for _, entry := range rejected {
+		detail := fmt.Sprintf("Entry %d (%s): %s", entry.Index, entry.Pubkey.HexWithPrefix(), strings.Join(entry.Reasons, "; "))
+		t.log.Printlnf("\t%s", detail)
+		details = append(details, detail)
+	}
+	err := ddMgr.SaveQuarantine(version, quarantine, rejected)
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+
+	var action string
+	if quarantine {
+		action = fmt.Sprintf("The invalid entries were quarantined in %s and the %d valid entries were saved.", swconfig.QuarantineFile, len(validData))
+	} else {
+		action = fmt.Sprintf("The new deposit data was not saved for safety; the rejected entries were written to %s.", swconfig.QuarantineFile)
+	}
+	t.log.Println(action)
+	err = notifier.Raise(invalidDepositDataAlertID, notifications.AlertLevel_Critical, "Invalid deposit data", fmt.Sprintf("%d of the deposit data entries from NodeSet (version %d) failed validation:\n%s\n\n%s", len(rejected), version, strings.Join(details, "\n"), action))
+	if err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+	return validData, quarantine
+}
+
 // Verify the Merkle root from the deposits data matches what's on chain before saving
 func (t *UpdateDepositData) verifyDepositsRoot(depositData []types.ExtendedDepositData) (bool, error) {
 	// Get services
@@ -122,11 +189,20 @@ func (t *UpdateDepositData) verifyDepositsRoot(depositData []types.ExtendedDepos
 	t.log.Printlnf("Contract's Merkle root: %s", contractRoot.Hex())
 
 	// Compare them
+	notifier := t.sp.GetNotifier()
 	if localRoot != contractRoot {
 		t.log.Printlnf("WARNING: Locally computed deposits data root does not match the value stored on chain, refusing to save for safety!")
+		err = notifier.Raise(depositsRootMismatchAlertID, notifications.AlertLevel_Critical, "Deposit data root mismatch", fmt.Sprintf("The deposit data from NodeSet has a Merkle root of %s, but the Stakewise vault's root is %s. The new deposit data was not saved.", localRoot.Hex(), contractRoot.Hex()))
+		if err != nil {
+			t.log.Printlnf("WARNING: %s", err.Error())
+		}
 		return false, nil
 	} else {
 		t.log.Println("Locally computed deposits data root matches the root stored on-chain, updating may proceed.")
+		err = notifier.Resolve(depositsRootMismatchAlertID, "Deposit data root matches", "The deposit data from NodeSet matches the Stakewise vault's root again.")
+		if err != nil {
+			t.log.Printlnf("WARNING: %s", err.Error())
+		}
 	}
 	return true, nil
 }
@@ -45,6 +45,7 @@ func NewBitflyNodeMetricsConfig(parent *MetricsConfig) *BitflyNodeMetricsConfig
 				ID:                 BitflyEndpointID,
 				Name:               "Node Metrics Endpoint",
 				Description:        "The endpoint to send your Beaconcha.in Node Metrics data to. Should be left as the default.",
+				Format:             ParameterFormat_HttpUrl,
 				AffectsContainers:  []ContainerID{ContainerID_BeaconNode, ContainerID_ValidatorClients},
 				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
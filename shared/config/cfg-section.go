@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 // Interface for describing config sections
@@ -77,6 +78,69 @@ func Deserialize(cfg IConfigSection, serializedParams map[string]any, network Ne
 	return nil
 }
 
+// Apply a partial set of settings over a config section, leaving anything that isn't in the map untouched.
+// Unlike Deserialize, this is strict: unknown keys and invalid values are errors rather than being ignored or reset to their defaults.
+func ApplyPartial(cfg IConfigSection, settings map[string]any, network Network, path string) error {
+	params := map[string]IParameter{}
+	for _, param := range cfg.GetParameters() {
+		params[param.GetCommon().ID] = param
+	}
+	subconfigs := cfg.GetSubconfigs()
+
+	for key, val := range settings {
+		keyPath := key
+		if path != "" {
+			keyPath = fmt.Sprintf("%s.%s", path, key)
+		}
+
+		// Handle subsections
+		if subconfig, exists := subconfigs[key]; exists {
+			submap, isMap := val.(map[string]any)
+			if !isMap {
+				return fmt.Errorf("[%s] is a section, so it must be a map but it is %s", keyPath, reflect.TypeOf(val))
+			}
+			err := ApplyPartial(subconfig, submap, network, keyPath)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Handle parameters
+		param, exists := params[key]
+		if !exists {
+			return fmt.Errorf("[%s] is not a known setting", keyPath)
+		}
+		switch val.(type) {
+		case nil, map[string]any, []any:
+			return fmt.Errorf("[%s] must be a single value", keyPath)
+		}
+		valString := fmt.Sprint(val)
+
+		// Choices fall back to the default when deserialized with an unknown value, so catch that first
+		options := param.GetOptions()
+		if len(options) > 0 {
+			optionStrings := make([]string, len(options))
+			isValid := false
+			for i, option := range options {
+				optionStrings[i] = option.String()
+				if optionStrings[i] == valString {
+					isValid = true
+				}
+			}
+			if !isValid {
+				return fmt.Errorf("[%s] must be one of [%s] but it was [%s]", keyPath, strings.Join(optionStrings, ", "), valString)
+			}
+		}
+
+		err := param.Deserialize(valString, network)
+		if err != nil {
+			return fmt.Errorf("error setting [%s]: %w", keyPath, err)
+		}
+	}
+	return nil
+}
+
 // Copy a section's settings into the corresponding section of a new config
 func Clone(source IConfigSection, target IConfigSection, network Network) {
 	// Handle the parameters
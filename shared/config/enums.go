@@ -56,6 +56,21 @@ const (
 	ContainerID_Grafana ContainerID = "grafana"
 )
 
+// How Hyperdrive runs its services
+type DeploymentMode string
+
+// Enum to describe the deployment modes
+const (
+	// Unknown
+	DeploymentMode_Unknown DeploymentMode = ""
+
+	// Each service runs in a Docker container managed with Docker Compose
+	DeploymentMode_Docker DeploymentMode = "docker"
+
+	// Each service runs directly on the host as a systemd unit
+	DeploymentMode_Native DeploymentMode = "native"
+)
+
 // An Execution client
 type ExecutionClient string
 
@@ -146,3 +161,54 @@ func (m RpcPortMode) DockerPortMapping(port uint16) string {
 		return ""
 	}
 }
+
+// The payload format used by a notification webhook
+type WebhookFormat string
+
+// Enum to describe the supported webhook payload formats
+const (
+	// A generic JSON payload containing the raw alert
+	WebhookFormat_Json WebhookFormat = "json"
+
+	// A Discord webhook message
+	WebhookFormat_Discord WebhookFormat = "discord"
+
+	// A Slack incoming webhook message
+	WebhookFormat_Slack WebhookFormat = "slack"
+)
+
+// The format that a parameter's value must follow, used when validating a config
+type ParameterFormat string
+
+// Enum to describe the formats a parameter can be validated against
+const (
+	// No particular format
+	ParameterFormat_None ParameterFormat = ""
+
+	// A network port that Hyperdrive binds to; it can't be 0 and can't be shared with any other port
+	ParameterFormat_Port ParameterFormat = "port"
+
+	// An HTTP or HTTPS URL
+	ParameterFormat_HttpUrl ParameterFormat = "httpUrl"
+
+	// A comma-separated list of HTTP or HTTPS URLs
+	ParameterFormat_HttpUrlList ParameterFormat = "httpUrlList"
+
+	// A WS or WSS URL
+	ParameterFormat_WebsocketUrl ParameterFormat = "websocketUrl"
+
+	// A host:port address, optionally prefixed with a URL scheme
+	ParameterFormat_HostPort ParameterFormat = "hostPort"
+
+	// A hostname or IP address, without a scheme or port
+	ParameterFormat_Hostname ParameterFormat = "hostname"
+
+	// An absolute path on the host
+	ParameterFormat_AbsolutePath ParameterFormat = "absolutePath"
+
+	// An email address
+	ParameterFormat_Email ParameterFormat = "email"
+
+	// A comma-separated list of email addresses
+	ParameterFormat_EmailList ParameterFormat = "emailList"
+)
@@ -80,8 +80,9 @@ func NewExternalBeaconConfig(parent *HyperdriveConfig) *ExternalBeaconConfig {
 				ID:                 ids.HttpUrlID,
 				Name:               "HTTP URL",
 				Description:        "The URL of the HTTP Beacon API endpoint for your external client.\nNOTE: If you are running it on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+				Format:             ParameterFormat_HttpUrl,
 				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_ValidatorClients},
-				CanBeBlank:         false,
+				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]string{
@@ -94,8 +95,9 @@ func NewExternalBeaconConfig(parent *HyperdriveConfig) *ExternalBeaconConfig {
 				ID:                 PrysmRpcUrlID,
 				Name:               "Prysm RPC URL",
 				Description:        "The URL of Prysm's gRPC API endpoint for your external Beacon Node. Prysm's Validator Client will need this in order to connect to it.\nNOTE: If you are running it on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+				Format:             ParameterFormat_HostPort,
 				AffectsContainers:  []ContainerID{ContainerID_ValidatorClients},
-				CanBeBlank:         false,
+				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]string{
@@ -123,3 +125,23 @@ func (cfg *ExternalBeaconConfig) GetParameters() []IParameter {
 func (cfg *ExternalBeaconConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{}
 }
+
+// Check if a parameter or subsection is used with the current settings
+func (cfg *ExternalBeaconConfig) IsInUse(id string) bool {
+	if id == PrysmRpcUrlID {
+		return cfg.BeaconNode.Value == BeaconNode_Prysm
+	}
+	return true
+}
+
+// Check the rules that span multiple settings
+func (cfg *ExternalBeaconConfig) ValidateSettings() []*ValidationError {
+	errs := []*ValidationError{}
+	if cfg.HttpUrl.Value == "" {
+		errs = append(errs, NewValidationError(&cfg.HttpUrl, "is required when your clients are externally managed."))
+	}
+	if cfg.BeaconNode.Value == BeaconNode_Prysm && cfg.PrysmRpcUrl.Value == "" {
+		errs = append(errs, NewValidationError(&cfg.PrysmRpcUrl, "is required so Prysm's Validator Client can connect to your Beacon Node."))
+	}
+	return errs
+}
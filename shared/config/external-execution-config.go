@@ -67,8 +67,9 @@ func NewExternalExecutionConfig(parent *HyperdriveConfig) *ExternalExecutionConf
 				ID:                 ids.HttpUrlID,
 				Name:               "HTTP URL",
 				Description:        "The URL of the HTTP RPC endpoint for your external Execution client.\nNOTE: If you are running it on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead, for example 'http://192.168.1.100:8545'.",
+				Format:             ParameterFormat_HttpUrl,
 				AffectsContainers:  []ContainerID{ContainerID_Daemon},
-				CanBeBlank:         false,
+				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]string{
@@ -80,9 +81,10 @@ func NewExternalExecutionConfig(parent *HyperdriveConfig) *ExternalExecutionConf
 			ParameterCommon: &ParameterCommon{
 				ID:                 WebsocketUrlID,
 				Name:               "Websocket URL",
-				Description:        "The URL of the Websocket RPC endpoint for your external Execution client.\nNOTE: If you are running it on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead, for example 'http://192.168.1.100:8545'.",
+				Description:        "The URL of the Websocket RPC endpoint for your external Execution client.\nNOTE: If you are running it on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead, for example 'ws://192.168.1.100:8546'.",
+				Format:             ParameterFormat_WebsocketUrl,
 				AffectsContainers:  []ContainerID{},
-				CanBeBlank:         false,
+				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]string{
@@ -110,3 +112,18 @@ func (cfg *ExternalExecutionConfig) GetParameters() []IParameter {
 func (cfg *ExternalExecutionConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{}
 }
+
+// Check if a parameter is used with the current settings
+func (cfg *ExternalExecutionConfig) IsInUse(id string) bool {
+	// Nothing connects to an external Execution client over Websocket yet
+	return id != WebsocketUrlID
+}
+
+// Check the rules that span multiple settings
+func (cfg *ExternalExecutionConfig) ValidateSettings() []*ValidationError {
+	errs := []*ValidationError{}
+	if cfg.HttpUrl.Value == "" {
+		errs = append(errs, NewValidationError(&cfg.HttpUrl, "is required when your clients are externally managed."))
+	}
+	return errs
+}
@@ -49,8 +49,9 @@ func NewFallbackConfig(parent *HyperdriveConfig) *FallbackConfig {
 				ID:                 EcHttpUrl,
 				Name:               "Execution Client URL",
 				Description:        "The URL of the HTTP API endpoint for your fallback Execution client.\n\nNOTE: If you are running it on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+				Format:             ParameterFormat_HttpUrl,
 				AffectsContainers:  []ContainerID{ContainerID_Daemon},
-				CanBeBlank:         false,
+				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]string{
@@ -63,8 +64,9 @@ func NewFallbackConfig(parent *HyperdriveConfig) *FallbackConfig {
 				ID:                 BnHttpUrl,
 				Name:               "Beacon Node URL",
 				Description:        "The URL of the HTTP Beacon API endpoint for your fallback Beacon Node.\n\nNOTE: If you are running it on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+				Format:             ParameterFormat_HttpUrl,
 				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_ValidatorClients},
-				CanBeBlank:         false,
+				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]string{
@@ -77,8 +79,9 @@ func NewFallbackConfig(parent *HyperdriveConfig) *FallbackConfig {
 				ID:                 PrysmRpcUrlID,
 				Name:               "RPC URL (Prysm Only)",
 				Description:        "**Only used if you have Prysm selected as a Validator Client in one of Hyperdrive's modules.**\n\nThe URL of Prysm's gRPC API endpoint for your fallback Beacon Node. Prysm's Validator Client will need this in order to connect to it.\nNOTE: If you are running it on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+				Format:             ParameterFormat_HostPort,
 				AffectsContainers:  []ContainerID{ContainerID_ValidatorClients},
-				CanBeBlank:         false,
+				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]string{
@@ -107,3 +110,33 @@ func (cfg *FallbackConfig) GetParameters() []IParameter {
 func (cfg *FallbackConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{}
 }
+
+// Check if a parameter or subsection is used with the current settings
+func (cfg *FallbackConfig) IsInUse(id string) bool {
+	switch id {
+	case UseFallbackClientsID:
+		return true
+	case PrysmRpcUrlID:
+		return cfg.UseFallbackClients.Value && cfg.parent.GetSelectedBeaconNode() == BeaconNode_Prysm
+	}
+	return cfg.UseFallbackClients.Value
+}
+
+// Check the rules that span multiple settings
+func (cfg *FallbackConfig) ValidateSettings() []*ValidationError {
+	errs := []*ValidationError{}
+	if !cfg.UseFallbackClients.Value {
+		return errs
+	}
+
+	if cfg.EcHttpUrl.Value == "" {
+		errs = append(errs, NewValidationError(&cfg.EcHttpUrl, "is required when fallback clients are enabled."))
+	}
+	if cfg.BnHttpUrl.Value == "" {
+		errs = append(errs, NewValidationError(&cfg.BnHttpUrl, "is required when fallback clients are enabled."))
+	}
+	if cfg.parent.GetSelectedBeaconNode() == BeaconNode_Prysm && cfg.PrysmRpcUrl.Value == "" {
+		errs = append(errs, NewValidationError(&cfg.PrysmRpcUrl, "is required so Prysm's Validator Client can connect to your fallback Beacon Node."))
+	}
+	return errs
+}
@@ -32,6 +32,7 @@ func NewGrafanaConfig(parent *MetricsConfig) *GrafanaConfig {
 				ID:                 ids.PortID,
 				Name:               "Grafana Port",
 				Description:        "The port Grafana should run its HTTP server on - this is the port you will connect to in your browser.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_Grafana},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/alessio/shellescape"
+	"github.com/hashicorp/go-version"
 	"github.com/nodeset-org/hyperdrive/shared"
 	"github.com/nodeset-org/hyperdrive/shared/config/ids"
 	"github.com/nodeset-org/hyperdrive/shared/config/migration"
@@ -25,6 +26,7 @@ const (
 	DebugModeID          string = "debugMode"
 	NetworkID            string = "network"
 	ClientModeID         string = "clientMode"
+	DeploymentModeID     string = "deploymentMode"
 	UserDataPathID       string = "hdUserDataDir"
 	ProjectNameID        string = "projectName"
 	AutoTxMaxFeeID       string = "autoTxMaxFee"
@@ -44,6 +46,7 @@ type HyperdriveConfig struct {
 	DebugMode          Parameter[bool]
 	Network            Parameter[Network]
 	ClientMode         Parameter[ClientMode]
+	DeploymentMode     Parameter[DeploymentMode]
 	ProjectName        Parameter[string]
 	UserDataPath       Parameter[string]
 	AutoTxMaxFee       Parameter[float64]
@@ -64,6 +67,12 @@ type HyperdriveConfig struct {
 	// Metrics
 	Metrics *MetricsConfig
 
+	// Notifications
+	Notifications *NotificationsConfig
+
+	// Native mode
+	Native *NativeConfig
+
 	// Modules
 	Modules map[string]any
 
@@ -166,6 +175,34 @@ func NewHyperdriveConfig(hdDir string) *HyperdriveConfig {
 			},
 		},
 
+		DeploymentMode: Parameter[DeploymentMode]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 DeploymentModeID,
+				Name:               "Deployment Mode",
+				Description:        "Choose how Hyperdrive runs its services - in Docker containers, or directly on your machine as systemd units (Native Mode).\n\nIn Native Mode, you must install the binaries for your clients and daemons yourself; see the Native Mode settings for where Hyperdrive looks for them. The metrics stack (Prometheus, Grafana, and Node Exporter) is only available in Docker Mode.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_ExecutionClient, ContainerID_BeaconNode, ContainerID_ValidatorClients, ContainerID_Exporter, ContainerID_Grafana, ContainerID_Prometheus},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Options: []*ParameterOption[DeploymentMode]{
+				{
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Docker",
+						Description: "Run each service in a Docker container managed with Docker Compose (Docker Mode)",
+					},
+					Value: DeploymentMode_Docker,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Native",
+						Description: "Run each service directly on your machine as a systemd unit, for machines that can't run Docker (Native Mode)",
+					},
+					Value: DeploymentMode_Native,
+				}},
+			Default: map[Network]DeploymentMode{
+				Network_All: DeploymentMode_Docker,
+			},
+		},
+
 		AutoTxMaxFee: Parameter[float64]{
 			ParameterCommon: &ParameterCommon{
 				ID:                 AutoTxMaxFeeID,
@@ -244,6 +281,8 @@ func NewHyperdriveConfig(hdDir string) *HyperdriveConfig {
 	cfg.LocalBeaconConfig = NewLocalBeaconConfig(cfg)
 	cfg.ExternalBeaconConfig = NewExternalBeaconConfig(cfg)
 	cfg.Metrics = NewMetricsConfig(cfg)
+	cfg.Notifications = NewNotificationsConfig(cfg)
+	cfg.Native = NewNativeConfig(cfg)
 
 	// Apply the default values for mainnet
 	cfg.Network.Value = Network_Mainnet
@@ -263,6 +302,7 @@ func (cfg *HyperdriveConfig) GetParameters() []IParameter {
 		&cfg.ProjectName,
 		&cfg.Network,
 		&cfg.ClientMode,
+		&cfg.DeploymentMode,
 		&cfg.AutoTxMaxFee,
 		&cfg.MaxPriorityFee,
 		&cfg.AutoTxGasThreshold,
@@ -280,6 +320,8 @@ func (cfg *HyperdriveConfig) GetSubconfigs() map[string]IConfigSection {
 		"localBeacon":       cfg.LocalBeaconConfig,
 		"externalBeacon":    cfg.ExternalBeaconConfig,
 		"metrics":           cfg.Metrics,
+		"notifications":     cfg.Notifications,
+		"native":            cfg.Native,
 	}
 }
 
@@ -367,6 +409,121 @@ func (cfg *HyperdriveConfig) Deserialize(masterMap map[string]any) error {
 	return nil
 }
 
+// Applies a partial settings document, laid out like the settings file, over this config and the provided modules.
+// Only the settings present in the document are changed; unknown sections, settings, or modules are errors.
+func (cfg *HyperdriveConfig) ApplyPartial(settings map[string]any, modules []IModuleConfig) error {
+	for key, val := range settings {
+		switch key {
+		case userDirectoryKey, ids.VersionID:
+			// These are managed by Hyperdrive itself, so they're ignored to let a copy of a settings file be applied as-is
+		case ids.RootConfigID, ModulesName:
+			if _, isMap := val.(map[string]any); !isMap {
+				return fmt.Errorf("[%s] is a section, so it must be a map but it is %s", key, reflect.TypeOf(val))
+			}
+		default:
+			return fmt.Errorf("[%s] is not a known section", key)
+		}
+	}
+	hdMap, _ := settings[ids.RootConfigID].(map[string]any)
+	modMap, _ := settings[ModulesName].(map[string]any)
+
+	// Change the network first so the network-specific defaults are updated before the rest of the settings are applied
+	oldNetwork := cfg.Network.Value
+	if networkVal, exists := hdMap[cfg.Network.ID]; exists {
+		err := ApplyPartial(cfg, map[string]any{cfg.Network.ID: networkVal}, oldNetwork, ids.RootConfigID)
+		if err != nil {
+			return err
+		}
+		if cfg.Network.Value != oldNetwork {
+			ChangeNetwork(cfg, oldNetwork, cfg.Network.Value)
+			for _, module := range modules {
+				ChangeNetwork(module, oldNetwork, cfg.Network.Value)
+			}
+		}
+	}
+	network := cfg.Network.Value
+
+	// Apply the Hyperdrive settings
+	err := ApplyPartial(cfg, hdMap, network, ids.RootConfigID)
+	if err != nil {
+		return err
+	}
+
+	// Apply the module settings
+	for name, val := range modMap {
+		var module IModuleConfig
+		for _, candidate := range modules {
+			if candidate.GetModuleName() == name {
+				module = candidate
+				break
+			}
+		}
+		modulePath := fmt.Sprintf("%s.%s", ModulesName, name)
+		if module == nil {
+			return fmt.Errorf("[%s] is not a known module", modulePath)
+		}
+		moduleSettings, isMap := val.(map[string]any)
+		if !isMap {
+			return fmt.Errorf("[%s] is a section, so it must be a map but it is %s", modulePath, reflect.TypeOf(val))
+		}
+		err := ApplyPartial(module, moduleSettings, network, modulePath)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validates this config and the provided modules, returning any problems with their settings.
+// Only the settings that are in use are checked; disabled modules are skipped, and ports are checked for collisions across all of the sections.
+func (cfg *HyperdriveConfig) Validate(modules []IModuleConfig) []*ValidationError {
+	validator := newConfigValidator()
+	validator.validateSection(cfg, ids.RootConfigID, cfg.GetTitle())
+	for _, module := range modules {
+		if !module.IsEnabled() {
+			continue
+		}
+		validator.validateSection(module, fmt.Sprintf("%s.%s", ModulesName, module.GetModuleName()), module.GetTitle())
+	}
+	return validator.errors
+}
+
+// Check if a parameter or subsection is used with the current settings
+func (cfg *HyperdriveConfig) IsInUse(id string) bool {
+	switch id {
+	case "localExecution", "localBeacon":
+		return cfg.IsLocalMode()
+	case "externalExecution", "externalBeacon":
+		return !cfg.IsLocalMode()
+	case "native":
+		return cfg.IsNativeMode()
+	}
+	return true
+}
+
+// Check the rules that span multiple settings
+func (cfg *HyperdriveConfig) ValidateSettings() []*ValidationError {
+	errs := []*ValidationError{}
+	for _, fee := range []*Parameter[float64]{&cfg.AutoTxMaxFee, &cfg.MaxPriorityFee, &cfg.AutoTxGasThreshold} {
+		if fee.Value < 0 {
+			errs = append(errs, NewValidationError(fee, "can't be negative."))
+		}
+	}
+	if cfg.AutoTxMaxFee.Value > 0 && cfg.AutoTxMaxFee.Value < cfg.MaxPriorityFee.Value {
+		errs = append(errs, NewValidationError(&cfg.AutoTxMaxFee, fmt.Sprintf("can't be lower than the %s, since it includes the priority fee.", cfg.MaxPriorityFee.Name)))
+	}
+	return errs
+}
+
+// True if the settings were saved by an older version of Hyperdrive, which may have accepted settings that are now invalid
+func (cfg *HyperdriveConfig) IsFromOlderVersion() bool {
+	savedVersion, err := version.NewVersion(strings.TrimPrefix(cfg.Version, "v"))
+	if err != nil {
+		return true
+	}
+	return savedVersion.LessThan(version.Must(version.NewVersion(shared.HyperdriveVersion)))
+}
+
 // =====================
 // === Field Helpers ===
 // =====================
@@ -44,6 +44,7 @@ func NewLighthouseBnConfig(parent *LocalBeaconConfig) *LighthouseBnConfig {
 				ID:                 LhQuicPortID,
 				Name:               "P2P QUIC Port",
 				Description:        "The port to use for P2P (blockchain) traffic using the QUIC protocol.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
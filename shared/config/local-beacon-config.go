@@ -8,7 +8,9 @@ import (
 
 const (
 	// Param IDs
-	BnCheckpointSyncUrlID string = "checkpointSyncUrl"
+	BnCheckpointSyncUrlID          string = "checkpointSyncUrl"
+	BnCheckpointVerificationUrlsID string = "checkpointVerificationUrls"
+	BnIgnoreCheckpointMismatchID   string = "ignoreCheckpointMismatch"
 )
 
 // Common parameters shared by all of the Beacon Clients
@@ -19,6 +21,12 @@ type LocalBeaconConfig struct {
 	// The checkpoint sync URL if used
 	CheckpointSyncProvider Parameter[string]
 
+	// Independent Beacon APIs used to verify the BN's finalized checkpoint
+	CheckpointVerificationUrls Parameter[string]
+
+	// Toggle for letting the VCs run even if the finalized checkpoint doesn't match the independent sources
+	IgnoreCheckpointMismatch Parameter[bool]
+
 	// The port to use for gossip traffic
 	P2pPort Parameter[uint16]
 
@@ -97,6 +105,7 @@ func NewLocalBeaconConfig(parent *HyperdriveConfig) *LocalBeaconConfig {
 				Description: "If you would like to instantly sync using an existing Beacon node, enter its URL.\n" +
 					"Example: https://<project ID>:<secret>@eth2-beacon-prater.infura.io\n" +
 					"Leave this blank if you want to sync normally from the start of the chain.",
+				Format:             ParameterFormat_HttpUrl,
 				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
 				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
@@ -106,11 +115,43 @@ func NewLocalBeaconConfig(parent *HyperdriveConfig) *LocalBeaconConfig {
 			},
 		},
 
+		CheckpointVerificationUrls: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:   BnCheckpointVerificationUrlsID,
+				Name: "Checkpoint Verification URLs",
+				Description: "A comma-separated list of Beacon Node API URLs that are independent of your Checkpoint Sync URL.\n" +
+					"Once your Beacon Node is running, Hyperdrive will compare its finalized checkpoint against these sources. If any of them disagree, your Validator Clients will be stopped since your Beacon Node may have synced from a malicious or faulty provider.\n" +
+					"Leave this blank to disable checkpoint verification.",
+				Format:             ParameterFormat_HttpUrlList,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
+		IgnoreCheckpointMismatch: Parameter[bool]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 BnIgnoreCheckpointMismatchID,
+				Name:               "Ignore Checkpoint Mismatch",
+				Description:        "[red]Enable this to allow your Validator Clients to run even if your Beacon Node's finalized checkpoint doesn't match the Checkpoint Verification URLs.\n\nOnly do this if you have confirmed that your Beacon Node is on the correct chain, and the verification sources are wrong!",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]bool{
+				Network_All: false,
+			},
+		},
+
 		P2pPort: Parameter[uint16]{
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.P2pPortID,
 				Name:               "P2P Port",
 				Description:        "The port to use for P2P (blockchain) traffic.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -125,6 +166,7 @@ func NewLocalBeaconConfig(parent *HyperdriveConfig) *LocalBeaconConfig {
 				ID:                 ids.HttpPortID,
 				Name:               "HTTP API Port",
 				Description:        "The port your Beacon Node should run its HTTP API on.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_BeaconNode, ContainerID_ValidatorClients, ContainerID_Prometheus},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -169,6 +211,8 @@ func (cfg *LocalBeaconConfig) GetParameters() []IParameter {
 	return []IParameter{
 		&cfg.BeaconNode,
 		&cfg.CheckpointSyncProvider,
+		&cfg.CheckpointVerificationUrls,
+		&cfg.IgnoreCheckpointMismatch,
 		&cfg.P2pPort,
 		&cfg.HttpPort,
 		&cfg.OpenHttpPort,
@@ -186,6 +230,20 @@ func (cfg *LocalBeaconConfig) GetSubconfigs() map[string]IConfigSection {
 	}
 }
 
+// Check if a parameter or subsection is used with the current settings
+func (cfg *LocalBeaconConfig) IsInUse(id string) bool {
+	switch id {
+	case "lighthouse", "lodestar", "nimbus", "prysm", "teku":
+		return id == string(cfg.BeaconNode.Value)
+	}
+	return true
+}
+
+// Check the rules that span multiple settings
+func (cfg *LocalBeaconConfig) ValidateSettings() []*ValidationError {
+	return nil
+}
+
 // ==================
 // === Templating ===
 // ==================
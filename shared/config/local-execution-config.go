@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/nodeset-org/hyperdrive/shared/config/ids"
 )
@@ -11,6 +12,12 @@ const (
 	EcWebsocketPortID string = "wsPort"
 	EcEnginePortID    string = "enginePort"
 	EcOpenApiPortsID  string = "openApiPorts"
+
+	EcLowDiskSpaceWarningThresholdID  string = "lowDiskSpaceWarningThreshold"
+	EcLowDiskSpaceCriticalThresholdID string = "lowDiskSpaceCriticalThreshold"
+	EcEnableAutoPruneID               string = "enableAutoPrune"
+	EcAutoPruneWindowStartID          string = "autoPruneWindowStart"
+	EcAutoPruneWindowLengthID         string = "autoPruneWindowLength"
 )
 
 // Configuration for the Execution client
@@ -33,6 +40,21 @@ type LocalExecutionConfig struct {
 	// P2P traffic port
 	P2pPort Parameter[uint16]
 
+	// The free space on the EC's volume, in GB, below which to raise a warning
+	LowDiskSpaceWarningThreshold Parameter[uint64]
+
+	// The free space on the EC's volume, in GB, below which to raise a critical alert
+	LowDiskSpaceCriticalThreshold Parameter[uint64]
+
+	// Toggle for pruning the EC automatically when its volume is low on space
+	EnableAutoPrune Parameter[bool]
+
+	// The hour of the day (UTC) when automatic pruning is allowed to start
+	AutoPruneWindowStart Parameter[uint64]
+
+	// The number of hours after the start that automatic pruning is allowed to start
+	AutoPruneWindowLength Parameter[uint64]
+
 	// Subconfigs
 	Geth       *GethConfig
 	Nethermind *NethermindConfig
@@ -85,6 +107,7 @@ func NewExecutionCommonConfig(parent *HyperdriveConfig) *LocalExecutionConfig {
 				ID:                 ids.HttpPortID,
 				Name:               "HTTP API Port",
 				Description:        "The port your Execution client should use for its HTTP API endpoint (also known as HTTP RPC API endpoint).",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_ExecutionClient, ContainerID_BeaconNode},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -99,6 +122,7 @@ func NewExecutionCommonConfig(parent *HyperdriveConfig) *LocalExecutionConfig {
 				ID:                 EcWebsocketPortID,
 				Name:               "Websocket API Port",
 				Description:        "The port your Execution client should use for its Websocket API endpoint (also known as Websocket RPC API endpoint).",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -113,6 +137,7 @@ func NewExecutionCommonConfig(parent *HyperdriveConfig) *LocalExecutionConfig {
 				ID:                 EcEnginePortID,
 				Name:               "Engine API Port",
 				Description:        "The port your Execution client should use for its Engine API endpoint (the endpoint the Beacon Node will connect to post-merge).",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient, ContainerID_BeaconNode},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -142,6 +167,7 @@ func NewExecutionCommonConfig(parent *HyperdriveConfig) *LocalExecutionConfig {
 				ID:                 ids.P2pPortID,
 				Name:               "P2P Port",
 				Description:        "The port the Execution Client should use for P2P (blockchain) traffic to communicate with other nodes.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -150,6 +176,78 @@ func NewExecutionCommonConfig(parent *HyperdriveConfig) *LocalExecutionConfig {
 				Network_All: 30303,
 			},
 		},
+
+		LowDiskSpaceWarningThreshold: Parameter[uint64]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 EcLowDiskSpaceWarningThresholdID,
+				Name:               "Low Disk Space Warning",
+				Description:        "The Hyperdrive daemon will warn you when the free space on the disk holding your Execution Client's data drops below this many GB.\n\nIf Auto-Prune is enabled, this is also the point where a prune will be scheduled.\n\nSet this to 0 to disable the warning.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint64{
+				Network_Mainnet: 100,
+				Network_All:     50,
+			},
+		},
+
+		LowDiskSpaceCriticalThreshold: Parameter[uint64]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 EcLowDiskSpaceCriticalThresholdID,
+				Name:               "Low Disk Space Critical Alert",
+				Description:        "The Hyperdrive daemon will raise a critical alert when the free space on the disk holding your Execution Client's data drops below this many GB. Your Execution Client will stop working if it runs out of space entirely.\n\nSet this to 0 to disable the alert.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint64{
+				Network_Mainnet: 40,
+				Network_All:     20,
+			},
+		},
+
+		EnableAutoPrune: Parameter[bool]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 EcEnableAutoPruneID,
+				Name:               "Enable Auto-Prune",
+				Description:        "Enable this to have the Hyperdrive daemon prune your Execution Client automatically when the free space on its disk drops below the Low Disk Space Warning threshold.\n\nPruning will only start during the Auto-Prune window, and only if your primary clients are synced and your fallback clients are healthy so your validators can keep attesting while your Execution Client is pruning.\n\nThis is supported by Geth (unless path-based state is enabled) and Nethermind. Besu does not need pruning.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]bool{
+				Network_All: false,
+			},
+		},
+
+		AutoPruneWindowStart: Parameter[uint64]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 EcAutoPruneWindowStartID,
+				Name:               "Auto-Prune Window Start",
+				Description:        "The hour of the day, in UTC (0 - 23), when automatic pruning is allowed to start.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint64{
+				Network_All: 2,
+			},
+		},
+
+		AutoPruneWindowLength: Parameter[uint64]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 EcAutoPruneWindowLengthID,
+				Name:               "Auto-Prune Window Length",
+				Description:        "How many hours (1 - 24) after the Auto-Prune Window Start automatic pruning is allowed to start. Pruning that starts inside the window may continue after it ends.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint64{
+				Network_All: 4,
+			},
+		},
 	}
 
 	// Create the subconfigs
@@ -174,6 +272,11 @@ func (cfg *LocalExecutionConfig) GetParameters() []IParameter {
 		&cfg.EnginePort,
 		&cfg.OpenApiPorts,
 		&cfg.P2pPort,
+		&cfg.LowDiskSpaceWarningThreshold,
+		&cfg.LowDiskSpaceCriticalThreshold,
+		&cfg.EnableAutoPrune,
+		&cfg.AutoPruneWindowStart,
+		&cfg.AutoPruneWindowLength,
 	}
 }
 
@@ -186,6 +289,66 @@ func (cfg *LocalExecutionConfig) GetSubconfigs() map[string]IConfigSection {
 	}
 }
 
+// Check if a parameter or subsection is used with the current settings
+func (cfg *LocalExecutionConfig) IsInUse(id string) bool {
+	switch id {
+	case "besu", "geth", "nethermind":
+		return id == string(cfg.ExecutionClient.Value)
+	case EcAutoPruneWindowStartID, EcAutoPruneWindowLengthID:
+		return cfg.EnableAutoPrune.Value
+	}
+	return true
+}
+
+// Check the rules that span multiple settings
+func (cfg *LocalExecutionConfig) ValidateSettings() []*ValidationError {
+	errs := []*ValidationError{}
+	if cfg.LowDiskSpaceCriticalThreshold.Value > cfg.LowDiskSpaceWarningThreshold.Value {
+		errs = append(errs, NewValidationError(&cfg.LowDiskSpaceCriticalThreshold, fmt.Sprintf("can't be higher than the %s threshold.", cfg.LowDiskSpaceWarningThreshold.Name)))
+	}
+	if !cfg.EnableAutoPrune.Value {
+		return errs
+	}
+
+	if cfg.parent.IsNativeMode() {
+		errs = append(errs, NewValidationError(&cfg.EnableAutoPrune, "isn't supported in Native Mode."))
+	}
+	if !cfg.SupportsPruning() {
+		errs = append(errs, NewValidationError(&cfg.EnableAutoPrune, fmt.Sprintf("isn't supported by %s with the current settings.", cfg.ExecutionClient.Value)))
+	}
+	if cfg.LowDiskSpaceWarningThreshold.Value == 0 {
+		errs = append(errs, NewValidationError(&cfg.EnableAutoPrune, fmt.Sprintf("requires a %s threshold.", cfg.LowDiskSpaceWarningThreshold.Name)))
+	}
+	if !cfg.parent.Fallback.UseFallbackClients.Value {
+		errs = append(errs, NewValidationError(&cfg.EnableAutoPrune, "requires fallback clients so your validators can keep attesting while the Execution Client is pruning."))
+	}
+	if cfg.AutoPruneWindowStart.Value > 23 {
+		errs = append(errs, NewValidationError(&cfg.AutoPruneWindowStart, "must be an hour between 0 and 23."))
+	}
+	if cfg.AutoPruneWindowLength.Value < 1 || cfg.AutoPruneWindowLength.Value > 24 {
+		errs = append(errs, NewValidationError(&cfg.AutoPruneWindowLength, "must be between 1 and 24 hours."))
+	}
+	return errs
+}
+
+// True if the selected EC can be pruned by restarting it with the prune lock file in its volume
+func (cfg *LocalExecutionConfig) SupportsPruning() bool {
+	switch cfg.ExecutionClient.Value {
+	case ExecutionClient_Geth:
+		return !cfg.Geth.EnablePbss.Value
+	case ExecutionClient_Nethermind:
+		return true
+	default:
+		return false
+	}
+}
+
+// Check if the current time is inside the window where automatic pruning is allowed to start
+func (cfg *LocalExecutionConfig) IsInAutoPruneWindow(now time.Time) bool {
+	hoursSinceStart := (uint64(now.UTC().Hour()) + 24 - cfg.AutoPruneWindowStart.Value%24) % 24
+	return hoursSinceStart < cfg.AutoPruneWindowLength.Value
+}
+
 // ==================
 // === Templating ===
 // ==================
@@ -68,6 +68,7 @@ func NewMetricsConfig(parent *HyperdriveConfig) *MetricsConfig {
 				ID:                 MetricsEcPortID,
 				Name:               "Execution Client Metrics Port",
 				Description:        "The port your Execution client should expose its metrics on.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient, ContainerID_Prometheus},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -82,6 +83,7 @@ func NewMetricsConfig(parent *HyperdriveConfig) *MetricsConfig {
 				ID:                 MetricsBnPortID,
 				Name:               "Beacon Node Metrics Port",
 				Description:        "The port your Beacon Node's Beacon Node should expose its metrics on.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_BeaconNode, ContainerID_Prometheus},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -96,6 +98,7 @@ func NewMetricsConfig(parent *HyperdriveConfig) *MetricsConfig {
 				ID:                 MetricsDaemonPortID,
 				Name:               "Daemon Metrics Port",
 				Description:        "The port your daemon container should expose its metrics on.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_Prometheus},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -110,6 +113,7 @@ func NewMetricsConfig(parent *HyperdriveConfig) *MetricsConfig {
 				ID:                 MetricsExporterPortID,
 				Name:               "Exporter Metrics Port",
 				Description:        "The port that Prometheus's Node Exporter should expose its metrics on.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_Exporter, ContainerID_Prometheus},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -154,3 +158,21 @@ func (cfg *MetricsConfig) GetSubconfigs() map[string]IConfigSection {
 		"bitfly":     cfg.BitflyNodeMetrics,
 	}
 }
+
+// Check if a parameter or subsection is used with the current settings
+func (cfg *MetricsConfig) IsInUse(id string) bool {
+	switch id {
+	case MetricsEnableID:
+		return true
+	case MetricsEcPortID, MetricsBnPortID:
+		return cfg.EnableMetrics.Value && cfg.parent.IsLocalMode()
+	case "bitfly":
+		return cfg.EnableMetrics.Value && cfg.EnableBitflyNodeMetrics.Value
+	}
+	return cfg.EnableMetrics.Value
+}
+
+// Check the rules that span multiple settings
+func (cfg *MetricsConfig) ValidateSettings() []*ValidationError {
+	return nil
+}
@@ -3,6 +3,7 @@ package config
 const (
 	ModulesName         string = "modules"
 	ValidatorsDirectory string = "validators"
+	RuntimeDirectory    string = "runtime"
 )
 
 type IModuleConfig interface {
@@ -21,4 +22,7 @@ type IModuleConfig interface {
 
 	// Get the list of containers that should be deployed
 	GetContainersToDeploy() []ContainerID
+
+	// Get the Hyperdrive daemon routes the module is allowed to call on its socket, such as "wallet/sign-tx"; every other route is denied
+	GetAllowedHyperdriveRoutes() []string
 }
@@ -0,0 +1,148 @@
+package config
+
+const (
+	// Param IDs
+	NativeEcHostID             string = "ecHost"
+	NativeBnHostID             string = "bnHost"
+	NativeBinaryDirectoryID    string = "binaryDirectory"
+	NativeUnitDirectoryID      string = "unitDirectory"
+	NativeChainDataDirectoryID string = "chainDataDirectory"
+)
+
+// Configuration for running Hyperdrive's services directly on the host as systemd units instead of in Docker
+type NativeConfig struct {
+	// The host the local Execution Client listens on
+	EcHost Parameter[string]
+
+	// The host the local Beacon Node listens on
+	BnHost Parameter[string]
+
+	// The folder with the daemon and operator binaries
+	BinaryDirectory Parameter[string]
+
+	// The folder to install the systemd units into
+	UnitDirectory Parameter[string]
+
+	// The folder to store the Execution Client and Beacon Node chain data in
+	ChainDataDirectory Parameter[string]
+
+	// Internal Fields
+	parent *HyperdriveConfig
+}
+
+// Generates a new NativeConfig configuration
+func NewNativeConfig(parent *HyperdriveConfig) *NativeConfig {
+	return &NativeConfig{
+		parent: parent,
+
+		EcHost: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 NativeEcHostID,
+				Name:               "Execution Client Host",
+				Description:        "The hostname or IP address that your locally managed Execution Client listens on. The Beacon Node, the daemons, and the Validator Clients use it with the Execution Client's configured ports to connect to it.",
+				Format:             ParameterFormat_Hostname,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_BeaconNode, ContainerID_ValidatorClients},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "127.0.0.1",
+			},
+		},
+
+		BnHost: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 NativeBnHostID,
+				Name:               "Beacon Node Host",
+				Description:        "The hostname or IP address that your locally managed Beacon Node listens on. The daemons and the Validator Clients use it with the Beacon Node's configured ports to connect to it.",
+				Format:             ParameterFormat_Hostname,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_ValidatorClients},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "127.0.0.1",
+			},
+		},
+
+		BinaryDirectory: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 NativeBinaryDirectoryID,
+				Name:               "Binary Directory",
+				Description:        "The folder that holds the Hyperdrive daemon (`hyperdrive-daemon`), the daemons of any modules you enable (such as `hyperdrive-stakewise-daemon`), and the StakeWise operator (`stakewise-operator`).\n\nThe Execution Client, Beacon Node, and Validator Client are launched by Hyperdrive's start scripts, so they must be installed at the same paths they use in their Docker images (such as `/usr/local/bin/geth`).",
+				Format:             ParameterFormat_AbsolutePath,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "/usr/bin",
+			},
+		},
+
+		UnitDirectory: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 NativeUnitDirectoryID,
+				Name:               "Unit Directory",
+				Description:        "The folder that Hyperdrive installs its systemd unit files into.",
+				Format:             ParameterFormat_AbsolutePath,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_ExecutionClient, ContainerID_BeaconNode, ContainerID_ValidatorClients},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "/etc/systemd/system",
+			},
+		},
+
+		ChainDataDirectory: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 NativeChainDataDirectoryID,
+				Name:               "Chain Data Directory",
+				Description:        "The folder that stores the chain data of your locally managed Execution Client and Beacon Node, in place of their Docker volumes.",
+				Format:             ParameterFormat_AbsolutePath,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_ExecutionClient, ContainerID_BeaconNode},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "/var/lib/hyperdrive/chaindata",
+			},
+		},
+	}
+}
+
+// The title for the config
+func (cfg *NativeConfig) GetTitle() string {
+	return "Native Mode"
+}
+
+// Get the Parameters for this config
+func (cfg *NativeConfig) GetParameters() []IParameter {
+	return []IParameter{
+		&cfg.EcHost,
+		&cfg.BnHost,
+		&cfg.BinaryDirectory,
+		&cfg.UnitDirectory,
+		&cfg.ChainDataDirectory,
+	}
+}
+
+// Get the sections underneath this one
+func (cfg *NativeConfig) GetSubconfigs() map[string]IConfigSection {
+	return map[string]IConfigSection{}
+}
+
+// Check if a parameter or subsection is used with the current settings
+func (cfg *NativeConfig) IsInUse(id string) bool {
+	switch id {
+	case NativeEcHostID, NativeBnHostID, NativeChainDataDirectoryID:
+		return cfg.parent.IsLocalMode()
+	}
+	return true
+}
+
+// Check the rules that span multiple settings
+func (cfg *NativeConfig) ValidateSettings() []*ValidationError {
+	return nil
+}
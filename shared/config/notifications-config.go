@@ -0,0 +1,307 @@
+package config
+
+import "fmt"
+
+const (
+	// Param IDs
+	EnableNotificationsID        string = "enableNotifications"
+	WebhookUrlID                 string = "webhookUrl"
+	WebhookFormatID              string = "webhookFormat"
+	SmtpServerID                 string = "smtpServer"
+	SmtpUsernameID               string = "smtpUsername"
+	SmtpPasswordID               string = "smtpPassword"
+	SmtpFromID                   string = "smtpFrom"
+	SmtpToID                     string = "smtpTo"
+	LowBalanceThresholdID        string = "lowBalanceThreshold"
+	StuckTransactionTimeoutID    string = "stuckTransactionTimeout"
+	NotificationRepeatIntervalID string = "notificationRepeatInterval"
+)
+
+// Configuration for the notification and alerting system
+type NotificationsConfig struct {
+	// Toggle for sending notifications
+	EnableNotifications Parameter[bool]
+
+	// The URL of the webhook to send alerts to
+	WebhookUrl Parameter[string]
+
+	// The payload format the webhook expects
+	WebhookFormat Parameter[WebhookFormat]
+
+	// The host:port of the SMTP server to send alert emails through
+	SmtpServer Parameter[string]
+
+	// The username for the SMTP server
+	SmtpUsername Parameter[string]
+
+	// The password for the SMTP server
+	SmtpPassword Parameter[string]
+
+	// The address alert emails are sent from
+	SmtpFrom Parameter[string]
+
+	// Comma-separated list of addresses alert emails are sent to
+	SmtpTo Parameter[string]
+
+	// The node wallet balance (in ETH) that triggers a low balance alert
+	LowBalanceThreshold Parameter[float64]
+
+	// How long (in minutes) a transaction can be pending before it's considered stuck
+	StuckTransactionTimeout Parameter[uint64]
+
+	// How long (in minutes) to wait before repeating an alert that hasn't been resolved
+	RepeatInterval Parameter[uint64]
+
+	// Internal Fields
+	parent *HyperdriveConfig
+}
+
+// Generates a new notifications configuration
+func NewNotificationsConfig(parent *HyperdriveConfig) *NotificationsConfig {
+	return &NotificationsConfig{
+		parent: parent,
+
+		EnableNotifications: Parameter[bool]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 EnableNotificationsID,
+				Name:               "Enable Notifications",
+				Description:        "Enable this to have Hyperdrive send you alerts when something goes wrong with your node, such as your node wallet not being loaded, your clients falling out of sync, your node's ETH balance running low, or your validators going offline.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]bool{
+				Network_All: false,
+			},
+		},
+
+		WebhookUrl: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 WebhookUrlID,
+				Name:               "Webhook URL",
+				Description:        "The URL of the webhook that alerts should be posted to. Leave this blank if you don't want to use a webhook.\n\nNOTE: If the receiver is running on the same machine as Hyperdrive, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+				Format:             ParameterFormat_HttpUrl,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
+		WebhookFormat: Parameter[WebhookFormat]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 WebhookFormatID,
+				Name:               "Webhook Format",
+				Description:        "The format of the messages sent to the webhook.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Options: []*ParameterOption[WebhookFormat]{
+				{
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "JSON",
+						Description: "Post the raw alert as a JSON object. Use this for custom receivers.",
+					},
+					Value: WebhookFormat_Json,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Discord",
+						Description: "Post the alert as a message to a Discord channel webhook.",
+					},
+					Value: WebhookFormat_Discord,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Slack",
+						Description: "Post the alert as a message to a Slack incoming webhook.",
+					},
+					Value: WebhookFormat_Slack,
+				}},
+			Default: map[Network]WebhookFormat{
+				Network_All: WebhookFormat_Json,
+			},
+		},
+
+		SmtpServer: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 SmtpServerID,
+				Name:               "SMTP Server",
+				Description:        "The address of the SMTP server to send alert emails through, in host:port format (e.g. `smtp.example.com:587`). Leave this blank if you don't want to receive alerts by email.",
+				Format:             ParameterFormat_HostPort,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
+		SmtpUsername: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 SmtpUsernameID,
+				Name:               "SMTP Username",
+				Description:        "The username to log into the SMTP server with. Leave this blank if the server doesn't require authentication.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
+		SmtpPassword: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 SmtpPasswordID,
+				Name:               "SMTP Password",
+				Description:        "The password to log into the SMTP server with.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
+		SmtpFrom: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 SmtpFromID,
+				Name:               "Email Sender",
+				Description:        "The email address that alerts will be sent from.",
+				Format:             ParameterFormat_Email,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
+		SmtpTo: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 SmtpToID,
+				Name:               "Email Recipients",
+				Description:        "A comma-separated list of email addresses that alerts will be sent to.",
+				Format:             ParameterFormat_EmailList,
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
+		LowBalanceThreshold: Parameter[float64]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 LowBalanceThresholdID,
+				Name:               "Low Balance Threshold",
+				Description:        "You will be alerted when your node wallet's balance drops below this amount (in ETH), since it may not be able to pay for transactions.\n\nA value of 0 will disable this alert.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]float64{
+				Network_All: float64(0.05),
+			},
+		},
+
+		StuckTransactionTimeout: Parameter[uint64]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 StuckTransactionTimeoutID,
+				Name:               "Stuck Transaction Timeout",
+				Description:        "You will be alerted if a transaction from your node wallet has been pending for longer than this many minutes.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint64{
+				Network_All: 30,
+			},
+		},
+
+		RepeatInterval: Parameter[uint64]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 NotificationRepeatIntervalID,
+				Name:               "Repeat Interval",
+				Description:        "If a problem hasn't been resolved, Hyperdrive will send the alert for it again after this many minutes.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint64{
+				Network_All: 360,
+			},
+		},
+	}
+}
+
+// The title for the config
+func (cfg *NotificationsConfig) GetTitle() string {
+	return "Notifications"
+}
+
+// Get the parameters for this config
+func (cfg *NotificationsConfig) GetParameters() []IParameter {
+	return []IParameter{
+		&cfg.EnableNotifications,
+		&cfg.WebhookUrl,
+		&cfg.WebhookFormat,
+		&cfg.SmtpServer,
+		&cfg.SmtpUsername,
+		&cfg.SmtpPassword,
+		&cfg.SmtpFrom,
+		&cfg.SmtpTo,
+		&cfg.LowBalanceThreshold,
+		&cfg.StuckTransactionTimeout,
+		&cfg.RepeatInterval,
+	}
+}
+
+// Get the sections underneath this one
+func (cfg *NotificationsConfig) GetSubconfigs() map[string]IConfigSection {
+	return map[string]IConfigSection{}
+}
+
+// Check if a parameter or subsection is used with the current settings
+func (cfg *NotificationsConfig) IsInUse(id string) bool {
+	if id == EnableNotificationsID {
+		return true
+	}
+	if !cfg.EnableNotifications.Value {
+		return false
+	}
+	switch id {
+	case WebhookFormatID:
+		return cfg.WebhookUrl.Value != ""
+	case SmtpUsernameID, SmtpPasswordID, SmtpFromID, SmtpToID:
+		return cfg.SmtpServer.Value != ""
+	}
+	return true
+}
+
+// Check the rules that span multiple settings
+func (cfg *NotificationsConfig) ValidateSettings() []*ValidationError {
+	if !cfg.EnableNotifications.Value {
+		return nil
+	}
+
+	errs := []*ValidationError{}
+	if cfg.WebhookUrl.Value == "" && cfg.SmtpServer.Value == "" {
+		errs = append(errs, NewValidationError(&cfg.EnableNotifications, fmt.Sprintf("requires a %s or an %s to send alerts to.", cfg.WebhookUrl.Name, cfg.SmtpServer.Name)))
+	}
+	if cfg.SmtpServer.Value != "" {
+		if cfg.SmtpFrom.Value == "" {
+			errs = append(errs, NewValidationError(&cfg.SmtpFrom, fmt.Sprintf("is required when an %s is set.", cfg.SmtpServer.Name)))
+		}
+		if cfg.SmtpTo.Value == "" {
+			errs = append(errs, NewValidationError(&cfg.SmtpTo, fmt.Sprintf("is required when an %s is set.", cfg.SmtpServer.Name)))
+		}
+	}
+	return errs
+}
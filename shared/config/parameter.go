@@ -23,6 +23,9 @@ type ParameterCommon struct {
 	// An optional regex used to validate free-form input for the parameter
 	Regex string
 
+	// The format the parameter's value must follow, if any
+	Format ParameterFormat
+
 	// True if this is an advanced parameter and should be hidden unless advanced configuration mode is enabled
 	Advanced bool
 
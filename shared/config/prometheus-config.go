@@ -37,6 +37,7 @@ func NewPrometheusConfig(parent *MetricsConfig) *PrometheusConfig {
 				ID:                 ids.PortID,
 				Name:               "Prometheus Port",
 				Description:        "The port Prometheus should make its statistics available on.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_Prometheus},
 				CanBeBlank:         true,
 				OverwriteOnUpgrade: false,
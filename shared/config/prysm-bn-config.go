@@ -59,6 +59,7 @@ func NewPrysmBnConfig(parent *LocalBeaconConfig) *PrysmBnConfig {
 				ID:                 PrysmRpcPortID,
 				Name:               "RPC Port",
 				Description:        "The port Prysm should run its JSON-RPC API on.",
+				Format:             ParameterFormat_Port,
 				AffectsContainers:  []ContainerID{ContainerID_BeaconNode, ContainerID_ValidatorClients},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
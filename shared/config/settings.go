@@ -14,6 +14,9 @@ const (
 	UserWalletDataFilename string = "wallet"
 	UserPasswordFilename   string = "password"
 
+	// Audit log
+	UserAuditLogFilename string = "audit.log"
+
 	// Scripts
 	EcStartScript string = "start-ec.sh"
 	BnStartScript string = "start-bn.sh"
@@ -25,4 +28,9 @@ const (
 	// Volumes
 	ExecutionClientDataVolume string = "ecdata"
 	BeaconNodeDataVolume      string = "bndata"
+
+	// Execution client pruning
+	DaemonEcDataPath     string = "/ethclient"
+	EcPruneLockFilename  string = "prune.lock"
+	EcPruneStateFilename string = "ec-prune.json"
 )
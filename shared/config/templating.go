@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"strings"
 
 	"github.com/nodeset-org/hyperdrive/shared"
@@ -44,6 +45,10 @@ func (c *HyperdriveConfig) BeaconNodeDataVolume() string {
 	return BeaconNodeDataVolume
 }
 
+func (c *HyperdriveConfig) DaemonEcDataPath() string {
+	return DaemonEcDataPath
+}
+
 // ===============
 // === General ===
 // ===============
@@ -58,6 +63,50 @@ func (cfg *HyperdriveConfig) GetDockerArtifactName(entity string) string {
 	return fmt.Sprintf("%s_%s", cfg.ProjectName.Value, entity)
 }
 
+// True if Hyperdrive's services run directly on the host as systemd units instead of in Docker
+func (cfg *HyperdriveConfig) IsNativeMode() bool {
+	return cfg.DeploymentMode.Value == DeploymentMode_Native
+}
+
+// Gets the name of the systemd unit for the service with the provided name, which matches the name of its Docker container
+func (cfg *HyperdriveConfig) GetNativeUnitName(entity string) string {
+	return cfg.GetDockerArtifactName(entity) + ".service"
+}
+
+// Gets the folder on the host that replaces the Docker volume with the provided name in Native Mode
+func (cfg *HyperdriveConfig) GetNativeVolumePath(volume string) string {
+	return filepath.Join(cfg.Native.ChainDataDirectory.Value, cfg.GetDockerArtifactName(volume))
+}
+
+// Gets the host the other services use to reach the local Execution Client, for use in a URL
+func (cfg *HyperdriveConfig) getLocalEcHost() string {
+	if cfg.IsNativeMode() {
+		return getUrlHost(cfg.Native.EcHost.Value)
+	}
+	return string(ContainerID_ExecutionClient)
+}
+
+// Gets the host the other services use to reach the local Beacon Node, for use in a URL
+func (cfg *HyperdriveConfig) getLocalBnHost() string {
+	if cfg.IsNativeMode() {
+		return getUrlHost(cfg.Native.BnHost.Value)
+	}
+	return string(ContainerID_BeaconNode)
+}
+
+// Wraps IPv6 addresses in brackets so they can be used as the host of a URL
+func getUrlHost(host string) string {
+	if strings.Contains(host, ":") {
+		return "[" + host + "]"
+	}
+	return host
+}
+
+// Joins a URL host and a port
+func getHostPort(host string, port uint16) string {
+	return fmt.Sprintf("%s:%d", host, port)
+}
+
 // Gets the name of the Execution Client start script
 func (cfg *HyperdriveConfig) GetEcStartScript() string {
 	return EcStartScript
@@ -87,7 +136,7 @@ func (cfg *HyperdriveConfig) BnHttpUrl() (string, error) {
 		}
 	*/
 	if cfg.IsLocalMode() {
-		return fmt.Sprintf("http://%s:%d", ContainerID_BeaconNode, cfg.LocalBeaconConfig.HttpPort.Value), nil
+		return "http://" + getHostPort(cfg.getLocalBnHost(), cfg.LocalBeaconConfig.HttpPort.Value), nil
 	}
 	return cfg.ExternalBeaconConfig.HttpUrl.Value, nil
 }
@@ -110,7 +159,7 @@ func (cfg *HyperdriveConfig) BnRpcUrl() (string, error) {
 		}
 	*/
 	if cfg.IsLocalMode() {
-		return fmt.Sprintf("%s:%d", ContainerID_BeaconNode, cfg.LocalBeaconConfig.Prysm.RpcPort.Value), nil
+		return getHostPort(cfg.getLocalBnHost(), cfg.LocalBeaconConfig.Prysm.RpcPort.Value), nil
 	}
 	return cfg.ExternalBeaconConfig.PrysmRpcUrl.Value, nil
 }
@@ -209,7 +258,7 @@ func (cfg *HyperdriveConfig) GetExternalIP() string {
 // Used by text/template to format bn.yml
 func (cfg *HyperdriveConfig) GetEcHttpEndpoint() string {
 	if cfg.ClientMode.Value == ClientMode_Local {
-		return fmt.Sprintf("http://%s:%d", ContainerID_ExecutionClient, cfg.LocalExecutionConfig.HttpPort.Value)
+		return "http://" + getHostPort(cfg.getLocalEcHost(), cfg.LocalExecutionConfig.HttpPort.Value)
 	}
 
 	return cfg.ExternalExecutionConfig.HttpUrl.Value
@@ -254,7 +303,7 @@ func (cfg *HyperdriveConfig) GetBnOpenPorts() []string {
 // Used by text/template to format bn.yml
 func (cfg *HyperdriveConfig) GetEcWsEndpoint() string {
 	if cfg.ClientMode.Value == ClientMode_Local {
-		return fmt.Sprintf("ws://%s:%d", ContainerID_ExecutionClient, cfg.LocalExecutionConfig.WebsocketPort.Value)
+		return "ws://" + getHostPort(cfg.getLocalEcHost(), cfg.LocalExecutionConfig.WebsocketPort.Value)
 	}
 
 	return cfg.ExternalExecutionConfig.WebsocketUrl.Value
@@ -280,7 +329,7 @@ func (cfg *HyperdriveConfig) GetBnAdditionalFlags() (string, error) {
 // Get the HTTP API endpoint for the provided BN
 func (cfg *HyperdriveConfig) GetBnHttpEndpoint() string {
 	if cfg.ClientMode.Value == ClientMode_Local {
-		return fmt.Sprintf("http://%s:%d", ContainerID_BeaconNode, cfg.LocalBeaconConfig.HttpPort.Value)
+		return "http://" + getHostPort(cfg.getLocalBnHost(), cfg.LocalBeaconConfig.HttpPort.Value)
 	}
 
 	return cfg.ExternalBeaconConfig.HttpUrl.Value
@@ -331,7 +380,7 @@ func (cfg *HyperdriveConfig) GetPrometheusOpenPorts() string {
 // Used by text/template to format prometheus.yml.
 func (cfg *HyperdriveConfig) GetExecutionHostname() (string, error) {
 	if cfg.ClientMode.Value == ClientMode_Local {
-		return string(ContainerID_ExecutionClient), nil
+		return cfg.getLocalEcHost(), nil
 	}
 	ecUrl, err := url.Parse(cfg.ExternalExecutionConfig.HttpUrl.Value)
 	if err != nil {
@@ -345,7 +394,7 @@ func (cfg *HyperdriveConfig) GetExecutionHostname() (string, error) {
 // Used by text/template to format prometheus.yml.
 func (cfg *HyperdriveConfig) GetBeaconHostname() (string, error) {
 	if cfg.ClientMode.Value == ClientMode_Local {
-		return string(ContainerID_BeaconNode), nil
+		return cfg.getLocalBnHost(), nil
 	}
 	ccUrl, err := url.Parse(cfg.ExternalBeaconConfig.HttpUrl.Value)
 	if err != nil {
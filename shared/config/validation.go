@@ -0,0 +1,293 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Matches DNS hostnames made of dot-separated labels
+var hostnameRegex = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)*$`)
+
+// A problem with one of the settings in a configuration
+type ValidationError struct {
+	// The path of the setting, using the keys of the settings file (e.g. hyperdrive.externalBeacon.httpUrl)
+	Path string
+
+	// The titles of the sections the setting belongs to (e.g. Hyperdrive > External Beacon Node)
+	Section string
+
+	// The human-readable name of the setting
+	ParameterName string
+
+	// A description of the problem
+	Message string
+}
+
+// Creates a new validation error for one of a section's parameters.
+// The path and section are filled in by the validator, so this is meant for use in IValidatedConfigSection.ValidateSettings().
+func NewValidationError(param IParameter, message string) *ValidationError {
+	common := param.GetCommon()
+	return &ValidationError{
+		Path:          common.ID,
+		ParameterName: common.Name,
+		Message:       message,
+	}
+}
+
+// Get the error as a human-readable string
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("[%s - %s] %s", e.Section, e.ParameterName, e.Message)
+}
+
+// Combine a list of validation errors into a single error, or nil if the list is empty
+func JoinValidationErrors(validationErrors []*ValidationError) error {
+	errs := make([]error, len(validationErrors))
+	for i, err := range validationErrors {
+		errs[i] = err
+	}
+	return errors.Join(errs...)
+}
+
+// Interface for config sections that only use some of their settings depending on the values of others,
+// or that have rules spanning multiple settings
+type IValidatedConfigSection interface {
+	IConfigSection
+
+	// True if the parameter or subsection with the provided ID is used with the section's current settings.
+	// Settings that aren't in use aren't validated.
+	IsInUse(id string) bool
+
+	// Check the rules that span multiple settings of the section
+	ValidateSettings() []*ValidationError
+}
+
+// The parameter that claimed a port during validation
+type portClaim struct {
+	section string
+	name    string
+}
+
+// Validates config sections, tracking the ports they use so collisions can be detected across all of them
+type configValidator struct {
+	errors []*ValidationError
+	ports  map[uint16]portClaim
+}
+
+// Create a new validator
+func newConfigValidator() *configValidator {
+	return &configValidator{
+		errors: []*ValidationError{},
+		ports:  map[uint16]portClaim{},
+	}
+}
+
+// Validate a config section and all of its subsections that are in use
+func (v *configValidator) validateSection(cfg IConfigSection, path string, title string) {
+	validatedCfg, hasRules := cfg.(IValidatedConfigSection)
+	isInUse := func(id string) bool {
+		return !hasRules || validatedCfg.IsInUse(id)
+	}
+
+	// Check the parameters
+	for _, param := range cfg.GetParameters() {
+		id := param.GetCommon().ID
+		if isInUse(id) {
+			v.validateParameter(param, fmt.Sprintf("%s.%s", path, id), title)
+		}
+	}
+	if hasRules {
+		for _, err := range validatedCfg.ValidateSettings() {
+			err.Path = fmt.Sprintf("%s.%s", path, err.Path)
+			err.Section = title
+			v.errors = append(v.errors, err)
+		}
+	}
+
+	// Check the subsections in a consistent order so port collisions are always reported against the same setting
+	subconfigs := cfg.GetSubconfigs()
+	names := make([]string, 0, len(subconfigs))
+	for name := range subconfigs {
+		if isInUse(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		subconfig := subconfigs[name]
+		v.validateSection(subconfig, fmt.Sprintf("%s.%s", path, name), fmt.Sprintf("%s > %s", title, subconfig.GetTitle()))
+	}
+}
+
+// Validate a single parameter
+func (v *configValidator) validateParameter(param IParameter, path string, title string) {
+	common := param.GetCommon()
+	addError := func(message string) {
+		v.errors = append(v.errors, &ValidationError{
+			Path:          path,
+			Section:       title,
+			ParameterName: common.Name,
+			Message:       message,
+		})
+	}
+
+	// Choices must be set to one of their options
+	options := param.GetOptions()
+	if len(options) > 0 {
+		value := param.String()
+		optionStrings := make([]string, len(options))
+		for i, option := range options {
+			optionStrings[i] = option.String()
+			if optionStrings[i] == value {
+				return
+			}
+		}
+		addError(fmt.Sprintf("must be one of [%s] but it is [%s].", strings.Join(optionStrings, ", "), value))
+		return
+	}
+
+	switch value := param.GetValueAsAny().(type) {
+	case string:
+		if value == "" {
+			if !common.CanBeBlank {
+				addError("cannot be blank.")
+			}
+			return
+		}
+		if common.MaxLength > 0 && len(value) > common.MaxLength {
+			addError(fmt.Sprintf("cannot be longer than %d characters.", common.MaxLength))
+			return
+		}
+		if common.Regex != "" && !regexp.MustCompile(common.Regex).MatchString(value) {
+			addError("does not match the expected format.")
+			return
+		}
+		err := checkFormat(common.Format, value)
+		if err != nil {
+			addError(err.Error() + ".")
+		}
+
+	case uint16:
+		if common.Format != ParameterFormat_Port {
+			return
+		}
+		if value == 0 {
+			addError("must be a port between 1 and 65535.")
+			return
+		}
+		claim, exists := v.ports[value]
+		if exists {
+			addError(fmt.Sprintf("port %d is already used by [%s - %s].", value, claim.section, claim.name))
+			return
+		}
+		v.ports[value] = portClaim{
+			section: title,
+			name:    common.Name,
+		}
+	}
+}
+
+// Check that a string value matches the provided format
+func checkFormat(format ParameterFormat, value string) error {
+	switch format {
+	case ParameterFormat_HttpUrl:
+		return checkUrl(value, "http", "https")
+
+	case ParameterFormat_HttpUrlList:
+		for _, entry := range strings.Split(value, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			err := checkUrl(entry, "http", "https")
+			if err != nil {
+				return fmt.Errorf("contains [%s], which %w", entry, err)
+			}
+		}
+
+	case ParameterFormat_WebsocketUrl:
+		return checkUrl(value, "ws", "wss")
+
+	case ParameterFormat_HostPort:
+		// Clients of these addresses strip any scheme, so it's optional
+		_, address, hasScheme := strings.Cut(value, "://")
+		if !hasScheme {
+			address = value
+		}
+		host, port, err := net.SplitHostPort(address)
+		if err != nil || host == "" {
+			return fmt.Errorf("must be an address in host:port format")
+		}
+		portNumber, err := strconv.ParseUint(port, 10, 16)
+		if err != nil || portNumber == 0 {
+			return fmt.Errorf("must have a port between 1 and 65535")
+		}
+
+	case ParameterFormat_Hostname:
+		if net.ParseIP(value) == nil && !hostnameRegex.MatchString(value) {
+			return fmt.Errorf("must be a hostname or IP address without a scheme or port")
+		}
+
+	case ParameterFormat_AbsolutePath:
+		if !filepath.IsAbs(value) {
+			return fmt.Errorf("must be an absolute path")
+		}
+
+	case ParameterFormat_Email:
+		return checkEmail(value)
+
+	case ParameterFormat_EmailList:
+		count := 0
+		for _, entry := range strings.Split(value, ",") {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			err := checkEmail(entry)
+			if err != nil {
+				return fmt.Errorf("contains [%s], which %w", entry, err)
+			}
+			count++
+		}
+		if count == 0 {
+			return fmt.Errorf("must contain at least one email address")
+		}
+	}
+	return nil
+}
+
+// Check that a value is a URL with one of the provided schemes and a host
+func checkUrl(value string, schemes ...string) error {
+	prefixes := make([]string, len(schemes))
+	for i, scheme := range schemes {
+		prefixes[i] = scheme + "://"
+	}
+	formatErr := fmt.Errorf("must be a URL starting with %s", strings.Join(prefixes, " or "))
+
+	parsedUrl, err := url.Parse(value)
+	if err != nil || parsedUrl.Host == "" {
+		return formatErr
+	}
+	for _, scheme := range schemes {
+		if parsedUrl.Scheme == scheme {
+			return nil
+		}
+	}
+	return formatErr
+}
+
+// Check that a value is a plain email address
+func checkEmail(value string) error {
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Address != value {
+		return fmt.Errorf("must be an email address (e.g. name@example.com)")
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nodeset-org/hyperdrive/shared"
+)
+
+// Create a config with the default settings for Holesky, which are valid
+func newTestConfig() *HyperdriveConfig {
+	cfg := NewHyperdriveConfig("/tmp/hyperdrive")
+	ApplyDefaults(cfg, Network_Holesky)
+	cfg.Network.Value = Network_Holesky
+	return cfg
+}
+
+// Switch a config to externally managed clients with valid URLs
+func setExternalMode(cfg *HyperdriveConfig) {
+	cfg.ClientMode.Value = ClientMode_External
+	cfg.ExternalExecutionConfig.HttpUrl.Value = "http://192.168.1.100:8545"
+	cfg.ExternalBeaconConfig.HttpUrl.Value = "http://192.168.1.100:5052"
+}
+
+// Enable fallback clients with valid URLs
+func setFallbackClients(cfg *HyperdriveConfig) {
+	cfg.Fallback.UseFallbackClients.Value = true
+	cfg.Fallback.EcHttpUrl.Value = "http://192.168.1.101:8545"
+	cfg.Fallback.BnHttpUrl.Value = "http://192.168.1.101:5052"
+}
+
+func TestValidateSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		// Changes the default settings
+		setup func(cfg *HyperdriveConfig)
+		// The path of the only expected error, or blank if the settings are valid
+		expectedPath string
+	}{
+		{
+			name:  "defaults",
+			setup: func(cfg *HyperdriveConfig) {},
+		},
+		{
+			name: "external mode with URLs",
+			setup: func(cfg *HyperdriveConfig) {
+				setExternalMode(cfg)
+			},
+		},
+		{
+			name: "external mode without an Execution Client URL",
+			setup: func(cfg *HyperdriveConfig) {
+				setExternalMode(cfg)
+				cfg.ExternalExecutionConfig.HttpUrl.Value = ""
+			},
+			expectedPath: "hyperdrive.externalExecution.httpUrl",
+		},
+		{
+			name: "external mode without a Beacon Node URL",
+			setup: func(cfg *HyperdriveConfig) {
+				setExternalMode(cfg)
+				cfg.ExternalBeaconConfig.HttpUrl.Value = ""
+			},
+			expectedPath: "hyperdrive.externalBeacon.httpUrl",
+		},
+		{
+			name: "local mode ignores the external URLs",
+			setup: func(cfg *HyperdriveConfig) {
+				cfg.ExternalExecutionConfig.HttpUrl.Value = ""
+				cfg.ExternalBeaconConfig.HttpUrl.Value = ""
+			},
+		},
+		{
+			name: "external Prysm without an RPC URL",
+			setup: func(cfg *HyperdriveConfig) {
+				setExternalMode(cfg)
+				cfg.ExternalBeaconConfig.BeaconNode.Value = BeaconNode_Prysm
+			},
+			expectedPath: "hyperdrive.externalBeacon.prysmRpcUrl",
+		},
+		{
+			name: "external Prysm with an RPC URL",
+			setup: func(cfg *HyperdriveConfig) {
+				setExternalMode(cfg)
+				cfg.ExternalBeaconConfig.BeaconNode.Value = BeaconNode_Prysm
+				cfg.ExternalBeaconConfig.PrysmRpcUrl.Value = "192.168.1.100:5053"
+			},
+		},
+		{
+			name: "fallback clients with URLs",
+			setup: func(cfg *HyperdriveConfig) {
+				setFallbackClients(cfg)
+			},
+		},
+		{
+			name: "fallback clients without an Execution Client URL",
+			setup: func(cfg *HyperdriveConfig) {
+				setFallbackClients(cfg)
+				cfg.Fallback.EcHttpUrl.Value = ""
+			},
+			expectedPath: "hyperdrive.fallback.ecHttpUrl",
+		},
+		{
+			name: "fallback clients without a Beacon Node URL",
+			setup: func(cfg *HyperdriveConfig) {
+				setFallbackClients(cfg)
+				cfg.Fallback.BnHttpUrl.Value = ""
+			},
+			expectedPath: "hyperdrive.fallback.bnHttpUrl",
+		},
+		{
+			name: "fallback clients with Prysm without an RPC URL",
+			setup: func(cfg *HyperdriveConfig) {
+				setFallbackClients(cfg)
+				cfg.LocalBeaconConfig.BeaconNode.Value = BeaconNode_Prysm
+			},
+			expectedPath: "hyperdrive.fallback.prysmRpcUrl",
+		},
+		{
+			name: "negative priority fee",
+			setup: func(cfg *HyperdriveConfig) {
+				cfg.MaxPriorityFee.Value = -1
+			},
+			expectedPath: "hyperdrive.maxPriorityFee",
+		},
+		{
+			name: "negative gas threshold",
+			setup: func(cfg *HyperdriveConfig) {
+				cfg.AutoTxGasThreshold.Value = -1
+			},
+			expectedPath: "hyperdrive.autoTxGasThreshold",
+		},
+		{
+			name: "max fee lower than the priority fee",
+			setup: func(cfg *HyperdriveConfig) {
+				cfg.MaxPriorityFee.Value = 2
+				cfg.AutoTxMaxFee.Value = 1
+			},
+			expectedPath: "hyperdrive.autoTxMaxFee",
+		},
+		{
+			name: "max fee higher than the priority fee",
+			setup: func(cfg *HyperdriveConfig) {
+				cfg.MaxPriorityFee.Value = 2
+				cfg.AutoTxMaxFee.Value = 20
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			test.setup(cfg)
+			errs := cfg.Validate(nil)
+			if test.expectedPath == "" {
+				if len(errs) > 0 {
+					t.Fatalf("expected no errors but got %v", JoinValidationErrors(errs))
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Path != test.expectedPath {
+				t.Fatalf("expected a single error for [%s] but got %v", test.expectedPath, JoinValidationErrors(errs))
+			}
+		})
+	}
+}
+
+func TestIsFromOlderVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{version: fmt.Sprintf("v%s", shared.HyperdriveVersion), expected: false},
+		{version: "v0.0.1", expected: true},
+		{version: "v99.0.0", expected: false},
+		{version: "", expected: true},
+	}
+
+	for _, test := range tests {
+		cfg := newTestConfig()
+		cfg.Version = test.version
+		if cfg.IsFromOlderVersion() != test.expected {
+			t.Fatalf("expected IsFromOlderVersion for [%s] to be %t", test.version, test.expected)
+		}
+	}
+}
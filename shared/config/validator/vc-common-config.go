@@ -56,6 +56,7 @@ func NewValidatorClientCommonConfig() *ValidatorClientCommonConfig {
 				ID:                 ids.MetricsPortID,
 				Name:               "Validator Client Metrics Port",
 				Description:        "The port your Validator Client should expose its metrics on, if metrics collection is enabled.",
+				Format:             config.ParameterFormat_Port,
 				AffectsContainers:  []config.ContainerID{config.ContainerID_ValidatorClients, config.ContainerID_Prometheus},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -0,0 +1,42 @@
+package api
+
+import "time"
+
+// A single record in the daemon's audit log
+type AuditLogEntry struct {
+	// The position of the entry in the log, starting at 0
+	Index uint64 `json:"index"`
+
+	Time time.Time `json:"time"`
+
+	// Who called the route: "cli" or the name of the module whose socket it came in on
+	Caller string `json:"caller"`
+
+	Method string `json:"method"`
+	Route  string `json:"route"`
+
+	// The HTTP status code of the response
+	Status  int    `json:"status"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+
+	// The hash of the previous entry, chaining each entry to the ones before it
+	PreviousHash string `json:"previousHash"`
+
+	// The hash of this entry's fields and the previous hash
+	Hash string `json:"hash"`
+}
+
+type ServiceAuditLogData struct {
+	// The most recent entries, oldest first
+	Entries []AuditLogEntry `json:"entries"`
+
+	// The number of entries in the whole log
+	TotalEntries int `json:"totalEntries"`
+
+	// True if every entry in the log is intact and correctly chained to the one before it
+	Verified bool `json:"verified"`
+
+	// Why the log failed verification, if it did
+	VerificationError string `json:"verificationError,omitempty"`
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+)
+
+// The kind of event published on a daemon's event stream
+type EventType string
+
+const (
+	// A scheduled task finished running or was skipped; the data is a TaskStatus
+	EventType_TaskRun EventType = "task-run"
+
+	// New deposit data was saved; the data is a DepositDataUpdatedEvent
+	EventType_DepositDataUpdated EventType = "deposit-data-updated"
+
+	// The Stakewise operator's hot wallet dropped below its minimum balance; the data is an OperatorBalanceLowEvent
+	EventType_OperatorBalanceLow EventType = "operator-balance-low"
+
+	// A transaction was submitted; the data is a TxEvent
+	EventType_TxSubmitted EventType = "tx-submitted"
+
+	// A submitted transaction was included in a block; the data is a TxEvent
+	EventType_TxMined EventType = "tx-mined"
+
+	// A submitted transaction reverted or couldn't be found; the data is a TxEvent
+	EventType_TxFailed EventType = "tx-failed"
+
+	// A request to a primary or fallback client failed and it was marked as unavailable; the data is a ClientFailoverEvent
+	EventType_ClientFailover EventType = "client-failover"
+
+	// The node wallet was loaded and is ready to use; the data is a WalletEvent
+	EventType_WalletLoaded EventType = "wallet-loaded"
+
+	// The node wallet was unloaded; the data is a WalletEvent
+	EventType_WalletUnloaded EventType = "wallet-unloaded"
+)
+
+// An event published on a daemon's event stream
+type Event struct {
+	Id     uint64          `json:"id"`
+	Type   EventType       `json:"type"`
+	Source string          `json:"source"`
+	Time   time.Time       `json:"time"`
+	Data   json.RawMessage `json:"data"`
+}
+
+type DepositDataUpdatedEvent struct {
+	OldVersion int `json:"oldVersion"`
+	NewVersion int `json:"newVersion"`
+	Count      int `json:"count"`
+}
+
+type OperatorBalanceLowEvent struct {
+	Address    common.Address `json:"address"`
+	Balance    *big.Int       `json:"balance"`
+	MinBalance *big.Int       `json:"minBalance"`
+}
+
+type TxEvent struct {
+	TxHash common.Hash `json:"txHash"`
+	Error  string      `json:"error,omitempty"`
+}
+
+type ClientFailoverEvent struct {
+	// "execution" or "beacon"
+	Client string `json:"client"`
+
+	// True if the primary client failed, false if the fallback failed
+	IsPrimary bool `json:"isPrimary"`
+
+	// True if requests will go to the fallback client from now on
+	UsingFallback bool   `json:"usingFallback"`
+	Error         string `json:"error"`
+}
+
+type WalletEvent struct {
+	Address common.Address `json:"address"`
+}
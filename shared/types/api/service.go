@@ -1,6 +1,11 @@
 package api
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/nodeset-org/eth-utils/beacon"
+)
 
 type ServiceTerminateDataFolderData struct {
 	FolderExisted bool `json:"folderExisted"`
@@ -26,15 +31,78 @@ type ClientManagerStatus struct {
 	FallbackClientStatus ClientStatus `json:"fallbackEcStatus"`
 }
 
+// The result of comparing the BN's finalized checkpoint against a single independent source
+type CheckpointSourceStatus struct {
+	Url       string      `json:"url"`
+	Epoch     uint64      `json:"epoch"`
+	Root      common.Hash `json:"root"`
+	IsMatched bool        `json:"isMatched"`
+	Error     string      `json:"error"`
+}
+
+// The result of verifying the BN's finalized checkpoint against all of the independent sources
+type CheckpointVerificationStatus struct {
+	Enabled      bool                     `json:"enabled"`
+	HasChecked   bool                     `json:"hasChecked"`
+	CheckTime    time.Time                `json:"checkTime"`
+	LocalEpoch   uint64                   `json:"localEpoch"`
+	LocalRoot    common.Hash              `json:"localRoot"`
+	IsVerified   bool                     `json:"isVerified"`
+	IsMismatched bool                     `json:"isMismatched"`
+	IsOverridden bool                     `json:"isOverridden"`
+	Error        string                   `json:"error"`
+	Sources      []CheckpointSourceStatus `json:"sources"`
+}
+
 type ServiceClientStatusData struct {
-	EcManagerStatus ClientManagerStatus `json:"ecManagerStatus"`
-	BcManagerStatus ClientManagerStatus `json:"bcManagerStatus"`
+	EcManagerStatus        ClientManagerStatus          `json:"ecManagerStatus"`
+	BcManagerStatus        ClientManagerStatus          `json:"bcManagerStatus"`
+	CheckpointVerification CheckpointVerificationStatus `json:"checkpointVerification"`
 }
 
 type ServiceGetConfigData struct {
 	Config map[string]any `json:"config"`
 }
 
+type ServiceSendTestNotificationData struct {
+	NotificationsEnabled bool `json:"notificationsEnabled"`
+}
+
 type ServiceVersionData struct {
 	Version string `json:"version"`
 }
+
+// The type of duty a validator has been assigned on the Beacon Chain
+type ValidatorDutyType string
+
+const (
+	ValidatorDutyType_Proposal      ValidatorDutyType = "proposal"
+	ValidatorDutyType_SyncCommittee ValidatorDutyType = "sync"
+)
+
+// A duty assigned to one of the node's validators during an epoch
+type ValidatorDuty struct {
+	Pubkey    beacon.ValidatorPubkey `json:"pubkey"`
+	Index     string                 `json:"index"`
+	Type      ValidatorDutyType      `json:"type"`
+	Epoch     uint64                 `json:"epoch"`
+	Count     uint64                 `json:"count"`
+	StartTime time.Time              `json:"startTime"`
+	EndTime   time.Time              `json:"endTime"`
+}
+
+// The time span of an epoch covered by the duty lookahead
+type DutyEpoch struct {
+	Epoch     uint64    `json:"epoch"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	HasDuties bool      `json:"hasDuties"`
+}
+
+type ServiceGetUpcomingDutiesData struct {
+	CurrentEpoch       uint64                   `json:"currentEpoch"`
+	SecondsPerEpoch    uint64                   `json:"secondsPerEpoch"`
+	Epochs             []DutyEpoch              `json:"epochs"`
+	Duties             []ValidatorDuty          `json:"duties"`
+	InactiveValidators []beacon.ValidatorPubkey `json:"inactiveValidators"`
+}
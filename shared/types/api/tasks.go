@@ -0,0 +1,32 @@
+package api
+
+import (
+	"time"
+)
+
+// The outcome of a task's last run
+type TaskResult string
+
+const (
+	TaskResult_Succeeded TaskResult = "success"
+	TaskResult_Failed    TaskResult = "failed"
+	TaskResult_Skipped   TaskResult = "skipped"
+	TaskResult_TimedOut  TaskResult = "timed-out"
+)
+
+// The status of a task registered with a daemon's scheduler
+type TaskStatus struct {
+	Name            string        `json:"name"`
+	Description     string        `json:"description"`
+	Interval        time.Duration `json:"interval"`
+	IsRunning       bool          `json:"isRunning"`
+	LastRunTime     time.Time     `json:"lastRunTime"`
+	LastRunDuration time.Duration `json:"lastRunDuration"`
+	LastResult      TaskResult    `json:"lastResult"`
+	LastError       string        `json:"lastError"`
+	NextRunTime     time.Time     `json:"nextRunTime"`
+}
+
+type TaskListData struct {
+	Tasks []TaskStatus `json:"tasks"`
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/nodeset-org/eth-utils/beacon"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+)
+
+type WithdrawalChangeStatus string
+
+const (
+	// The change was signed but not submitted
+	WithdrawalChangeStatus_Signed WithdrawalChangeStatus = "signed"
+
+	// The change was signed and submitted to the Beacon Node
+	WithdrawalChangeStatus_Submitted WithdrawalChangeStatus = "submitted"
+
+	// The change was signed but the Beacon Node rejected it
+	WithdrawalChangeStatus_SubmitFailed WithdrawalChangeStatus = "submit-failed"
+
+	// The validator already has 0x01 withdrawal credentials
+	WithdrawalChangeStatus_AlreadySet WithdrawalChangeStatus = "already-set"
+
+	// The validator doesn't exist on the Beacon Chain yet
+	WithdrawalChangeStatus_NotFound WithdrawalChangeStatus = "not-found"
+
+	// None of the searched withdrawal keys match the validator's withdrawal credentials
+	WithdrawalChangeStatus_KeyNotFound WithdrawalChangeStatus = "key-not-found"
+)
+
+type ValidatorWithdrawalChangeInfo struct {
+	Pubkey                beacon.ValidatorPubkey            `json:"pubkey"`
+	Index                 string                            `json:"index"`
+	Status                WithdrawalChangeStatus            `json:"status"`
+	WithdrawalCredentials common.Hash                       `json:"withdrawalCredentials"`
+	KeyIndex              uint64                            `json:"keyIndex"`
+	Change                *types.SignedBlsToExecutionChange `json:"change,omitempty"`
+	Error                 string                            `json:"error,omitempty"`
+}
+
+type ValidatorSetWithdrawalAddressData struct {
+	Validators []ValidatorWithdrawalChangeInfo `json:"validators"`
+}
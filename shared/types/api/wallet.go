@@ -54,6 +54,11 @@ type WalletSignMessageData struct {
 	SignedMessage []byte `json:"signedMessage"`
 }
 
+type WalletSignTypedDataData struct {
+	Hash      common.Hash `json:"hash"`
+	Signature []byte      `json:"signature"`
+}
+
 type WalletSignTxData struct {
 	SignedTx []byte `json:"signedTx"`
 }
@@ -63,6 +68,16 @@ type WalletExportEthKeyData struct {
 	Password   string `json:"password"`
 }
 
+type WalletExportSecondaryEthKeyData struct {
+	AccountAddress common.Address `json:"accountAddress"`
+	EthKeyJson     []byte         `json:"ethKeyJson"`
+	Password       string         `json:"password"`
+}
+
+type WalletExportSharesData struct {
+	Shares []string `json:"shares"`
+}
+
 type WalletGenerateValidatorKeyData struct {
 	PrivateKey []byte `json:"privateKey"`
 }
@@ -39,6 +39,20 @@ type BeaconHead struct {
 	JustifiedEpoch         uint64
 	PreviousJustifiedEpoch uint64
 }
+type Checkpoint struct {
+	Epoch uint64
+	Root  common.Hash
+}
+type FinalityCheckpoints struct {
+	PreviousJustified Checkpoint
+	CurrentJustified  Checkpoint
+	Finalized         Checkpoint
+}
+type BeaconBlockHeader struct {
+	Slot      uint64
+	Root      common.Hash
+	Canonical bool
+}
 type ValidatorStatus struct {
 	Pubkey                     beacon.ValidatorPubkey
 	Index                      string
@@ -93,7 +107,9 @@ type IBeaconClient interface {
 	GetEth2DepositContract(ctx context.Context) (Eth2DepositContract, error)
 	GetAttestations(ctx context.Context, blockId string) ([]AttestationInfo, bool, error)
 	GetBeaconBlock(ctx context.Context, blockId string) (BeaconBlock, bool, error)
+	GetBeaconBlockHeader(ctx context.Context, blockId string) (BeaconBlockHeader, bool, error)
 	GetBeaconHead(ctx context.Context) (BeaconHead, error)
+	GetFinalityCheckpoints(ctx context.Context, stateId string) (FinalityCheckpoints, error)
 	GetValidatorStatusByIndex(ctx context.Context, index string, opts *ValidatorStatusOptions) (ValidatorStatus, error)
 	GetValidatorStatus(ctx context.Context, pubkey beacon.ValidatorPubkey, opts *ValidatorStatusOptions) (ValidatorStatus, error)
 	GetValidatorStatuses(ctx context.Context, pubkeys []beacon.ValidatorPubkey, opts *ValidatorStatusOptions) (map[beacon.ValidatorPubkey]ValidatorStatus, error)
@@ -17,6 +17,9 @@ const (
 	StakewiseValidatorPath     string = "m/12381/3600/%d/1/0"
 	ConstellationValidatorPath string = "m/12381/3600/%d/2/0"
 	SoloValidatorPath          string = "m/12381/3600/%d/3/0"
+
+	// The path used by the staking deposit CLI for validators created outside of Hyperdrive
+	DepositCliValidatorPath string = "m/12381/3600/%d/0/0"
 )
 
 // Encrypted validator keystore following the EIP-2335 standard
@@ -43,6 +46,27 @@ type ExtendedDepositData struct {
 	HyperdriveVersion     string    `json:"hyperdrive_version,omitempty"`
 }
 
+// A request to change a validator's 0x00 BLS withdrawal credentials to an execution address, as defined in the Capella spec
+type BlsToExecutionChange struct {
+	ValidatorIndex     string `json:"validator_index"`
+	FromBlsPubkey      string `json:"from_bls_pubkey"`
+	ToExecutionAddress string `json:"to_execution_address"`
+}
+
+// Signed BLS-to-execution change, emulating what the deposit CLI produces so it can be broadcast by other tools
+type SignedBlsToExecutionChange struct {
+	Message   BlsToExecutionChange          `json:"message"`
+	Signature string                        `json:"signature"`
+	Metadata  *BlsToExecutionChangeMetadata `json:"metadata,omitempty"`
+}
+
+// Network details for a signed BLS-to-execution change
+type BlsToExecutionChangeMetadata struct {
+	NetworkName           string    `json:"network_name"`
+	GenesisValidatorsRoot ByteArray `json:"genesis_validators_root"`
+	HyperdriveVersion     string    `json:"hyperdrive_version,omitempty"`
+}
+
 // Byte array type
 type ByteArray []byte
 
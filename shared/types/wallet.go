@@ -24,12 +24,26 @@ type WalletStatus struct {
 }
 type DerivationPath string
 
+const (
+	// Secondary EL accounts are derived from the node wallet's seed under their own BIP-44 account so they never overlap with the node account.
+	// Hyperdrive distinguishes them by module using the change level of the path, following the same module indices as the validator paths.
+	SecondaryAccountPathPrefix string = "m/44'/60'/1'/"
+
+	StakewiseOperatorAccountPath string = SecondaryAccountPathPrefix + "1/%d"
+)
+
 const (
 	DerivationPath_Default    DerivationPath = ""
 	DerivationPath_LedgerLive DerivationPath = "ledger-live"
 	DerivationPath_Mew        DerivationPath = "mew"
 )
 
+// The key derivation functions a local wallet keystore can be encrypted with
+const (
+	KeystoreKdf_Pbkdf2 string = "pbkdf2"
+	KeystoreKdf_Scrypt string = "scrypt"
+)
+
 // An enum describing the type of wallet used by the node
 type WalletType string
 
@@ -13,6 +13,8 @@ import (
 	"github.com/goccy/go-json"
 	"github.com/nodeset-org/eth-utils/beacon"
 	"github.com/nodeset-org/eth-utils/eth"
+	"github.com/nodeset-org/hyperdrive/shared/types"
+	"github.com/nodeset-org/hyperdrive/shared/utils/slip39"
 	"github.com/tyler-smith/go-bip39"
 	"github.com/urfave/cli/v2"
 )
@@ -205,6 +207,15 @@ func ValidateNodePassword(name string, value string) (string, error) {
 	return value, nil
 }
 
+// Validate a wallet keystore key derivation function
+func ValidateKdf(name string, value string) (string, error) {
+	switch value {
+	case types.KeystoreKdf_Pbkdf2, types.KeystoreKdf_Scrypt:
+		return value, nil
+	}
+	return "", fmt.Errorf("Invalid %s '%s' - must be '%s' or '%s'", name, value, types.KeystoreKdf_Pbkdf2, types.KeystoreKdf_Scrypt)
+}
+
 // Validate a wallet mnemonic phrase
 func ValidateWalletMnemonic(name, value string) (string, error) {
 	if !bip39.IsMnemonicValid(value) {
@@ -213,6 +224,16 @@ func ValidateWalletMnemonic(name, value string) (string, error) {
 	return value, nil
 }
 
+// Validate a SLIP-39 share mnemonic
+func ValidateWalletShare(name, value string) (string, error) {
+	value = strings.Join(strings.Fields(strings.ToLower(value)), " ")
+	err := slip39.ValidateShare(value)
+	if err != nil {
+		return "", fmt.Errorf("Invalid %s: %w", name, err)
+	}
+	return value, nil
+}
+
 // Validate a timezone location
 func ValidateTimezoneLocation(name, value string) (string, error) {
 	if !regexp.MustCompile("^([a-zA-Z_]{2,}\\/)+[a-zA-Z_]{2,}$").MatchString(value) {
@@ -0,0 +1,223 @@
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// The x coordinate of the share holding the shared secret
+	secretIndex byte = 255
+
+	// The x coordinate of the share holding the digest of the shared secret
+	digestIndex byte = 254
+
+	// The length of the digest used to verify a recovered secret, in bytes
+	digestLength int = 4
+
+	// The number of rounds in the Feistel cipher used to encrypt the master secret
+	roundCount int = 4
+
+	// The total number of PBKDF2 iterations across all rounds with an iteration exponent of 0
+	baseIterationCount int = 10000
+
+	// The prefix of the salt used by the Feistel cipher for non-extendable shares
+	customizationString string = "shamir"
+)
+
+// A single point of a split secret
+type rawShare struct {
+	x     byte
+	value []byte
+}
+
+// Log and exponent tables for GF(256) with the Rijndael polynomial
+var (
+	expTable [255]byte
+	logTable [256]byte
+)
+
+func init() {
+	poly := 1
+	for i := 0; i < 255; i++ {
+		expTable[i] = byte(poly)
+		logTable[poly] = byte(i)
+
+		// Multiply by x + 1, the primitive element
+		poly = (poly << 1) ^ poly
+		if poly&0x100 != 0 {
+			poly ^= 0x11B
+		}
+	}
+}
+
+// Evaluate the polynomial that passes through the provided shares at x
+func interpolate(shares []rawShare, x byte) ([]byte, error) {
+	length := len(shares[0].value)
+	for _, share := range shares {
+		if len(share.value) != length {
+			return nil, fmt.Errorf("all share values must have the same length")
+		}
+		if share.x == x {
+			return share.value, nil
+		}
+	}
+
+	// Log of the product of (x_i - x) over all shares
+	logProd := 0
+	for _, share := range shares {
+		logProd += int(logTable[share.x^x])
+	}
+
+	result := make([]byte, length)
+	for _, share := range shares {
+		// Log of the Lagrange basis polynomial for this share, evaluated at x
+		logBasis := logProd - int(logTable[share.x^x])
+		for _, other := range shares {
+			logBasis -= int(logTable[share.x^other.x])
+		}
+		logBasis = ((logBasis % 255) + 255) % 255
+
+		for i, b := range share.value {
+			if b != 0 {
+				result[i] ^= expTable[(int(logTable[b])+logBasis)%255]
+			}
+		}
+	}
+	return result, nil
+}
+
+// Split a secret into count shares, any threshold of which can recover it
+func splitSecret(threshold int, count int, secret []byte) ([]rawShare, error) {
+	if threshold < 1 || threshold > count {
+		return nil, fmt.Errorf("threshold must be between 1 and the share count (%d)", count)
+	}
+	if count > maxShareCount {
+		return nil, fmt.Errorf("share count cannot exceed %d", maxShareCount)
+	}
+
+	// With a threshold of 1, every share is just the secret itself
+	shares := make([]rawShare, 0, count)
+	if threshold == 1 {
+		for i := 0; i < count; i++ {
+			shares = append(shares, rawShare{x: byte(i), value: secret})
+		}
+		return shares, nil
+	}
+
+	// Pick random values for the first threshold - 2 shares
+	randomShareCount := threshold - 2
+	for i := 0; i < randomShareCount; i++ {
+		value := make([]byte, len(secret))
+		_, err := rand.Read(value)
+		if err != nil {
+			return nil, fmt.Errorf("error generating random share: %w", err)
+		}
+		shares = append(shares, rawShare{x: byte(i), value: value})
+	}
+
+	// Fix the polynomial with the digest and secret shares, then derive the remaining shares from it
+	randomPart := make([]byte, len(secret)-digestLength)
+	_, err := rand.Read(randomPart)
+	if err != nil {
+		return nil, fmt.Errorf("error generating random digest data: %w", err)
+	}
+	digest := createDigest(randomPart, secret)
+	baseShares := append([]rawShare{}, shares...)
+	baseShares = append(baseShares,
+		rawShare{x: digestIndex, value: append(digest, randomPart...)},
+		rawShare{x: secretIndex, value: secret},
+	)
+	for i := randomShareCount; i < count; i++ {
+		value, err := interpolate(baseShares, byte(i))
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, rawShare{x: byte(i), value: value})
+	}
+	return shares, nil
+}
+
+// Recover a secret from threshold shares, checking it against the digest embedded in them
+func recoverSecret(threshold int, shares []rawShare) ([]byte, error) {
+	if threshold == 1 {
+		return shares[0].value, nil
+	}
+
+	secret, err := interpolate(shares, secretIndex)
+	if err != nil {
+		return nil, err
+	}
+	digestShare, err := interpolate(shares, digestIndex)
+	if err != nil {
+		return nil, err
+	}
+	digest := digestShare[:digestLength]
+	randomPart := digestShare[digestLength:]
+	if subtle.ConstantTimeCompare(digest, createDigest(randomPart, secret)) != 1 {
+		return nil, fmt.Errorf("invalid digest of the shared secret; the shares do not belong together")
+	}
+	return secret, nil
+}
+
+// Get the digest of a shared secret
+func createDigest(randomData []byte, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomData)
+	mac.Write(secret)
+	return mac.Sum(nil)[:digestLength]
+}
+
+// Encrypt a master secret with a passphrase using the SLIP-39 Feistel cipher
+func encrypt(masterSecret []byte, passphrase []byte, iterationExponent int, identifier int, extendable bool) []byte {
+	half := len(masterSecret) / 2
+	left := append([]byte{}, masterSecret[:half]...)
+	right := append([]byte{}, masterSecret[half:]...)
+	salt := getSalt(identifier, extendable)
+	for i := 0; i < roundCount; i++ {
+		f := roundFunction(i, passphrase, iterationExponent, salt, right)
+		left, right = right, xor(left, f)
+	}
+	return append(right, left...)
+}
+
+// Decrypt an encrypted master secret with a passphrase using the SLIP-39 Feistel cipher
+func decrypt(encryptedMasterSecret []byte, passphrase []byte, iterationExponent int, identifier int, extendable bool) []byte {
+	half := len(encryptedMasterSecret) / 2
+	left := append([]byte{}, encryptedMasterSecret[:half]...)
+	right := append([]byte{}, encryptedMasterSecret[half:]...)
+	salt := getSalt(identifier, extendable)
+	for i := roundCount - 1; i >= 0; i-- {
+		f := roundFunction(i, passphrase, iterationExponent, salt, right)
+		left, right = right, xor(left, f)
+	}
+	return append(right, left...)
+}
+
+// The pseudorandom function used by each round of the Feistel cipher
+func roundFunction(round int, passphrase []byte, iterationExponent int, salt []byte, data []byte) []byte {
+	password := append([]byte{byte(round)}, passphrase...)
+	iterations := (baseIterationCount << iterationExponent) / roundCount
+	return pbkdf2.Key(password, append(append([]byte{}, salt...), data...), iterations, len(data), sha256.New)
+}
+
+// Get the salt used by the Feistel cipher; extendable shares don't bind it to the identifier
+func getSalt(identifier int, extendable bool) []byte {
+	if extendable {
+		return []byte{}
+	}
+	return append([]byte(customizationString), byte(identifier>>8), byte(identifier))
+}
+
+// XOR two byte slices of the same length
+func xor(a []byte, b []byte) []byte {
+	result := make([]byte, len(a))
+	for i := range a {
+		result[i] = a[i] ^ b[i]
+	}
+	return result
+}
@@ -0,0 +1,232 @@
+package slip39
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// The number of words in the wordlist
+	radix int = 1024
+
+	// The number of bits encoded by each word
+	radixBits int = 10
+
+	// The number of bits in a share set identifier
+	idBits int = 15
+
+	// The number of bits in the iteration exponent
+	iterationExponentBits int = 4
+
+	// The number of words holding the identifier, extendable flag and iteration exponent
+	idExpWords int = 2
+
+	// The number of words holding the group and member parameters
+	groupParamsWords int = 2
+
+	// The number of words in the checksum
+	checksumWords int = 3
+
+	// The customization string of the checksum for non-extendable shares
+	checksumCustomization string = "shamir"
+
+	// The customization string of the checksum for extendable shares
+	checksumCustomizationExtendable string = "shamir_extendable"
+
+	// The maximum number of shares in a group, or groups in a share set
+	maxShareCount int = 16
+
+	// The minimum length of a master secret, in bytes
+	minSecretLength int = 16
+)
+
+// The minimum number of words in a share, which is the length of a share for a 128-bit secret
+var minMnemonicWords = idExpWords + groupParamsWords + (minSecretLength*8+radixBits-1)/radixBits + checksumWords
+
+// Generator of the RS1024 checksum
+var checksumGenerator = [10]uint32{
+	0xE0E040,
+	0x1C1C080,
+	0x3838100,
+	0x7070200,
+	0xE0E0009,
+	0x1C0C2412,
+	0x38086C24,
+	0x3090FC48,
+	0x21B1F890,
+	0x3F3F120,
+}
+
+// A single decoded SLIP-39 share
+type share struct {
+	identifier        int
+	extendable        bool
+	iterationExponent int
+	groupIndex        int
+	groupThreshold    int
+	groupCount        int
+	memberIndex       int
+	memberThreshold   int
+	value             []byte
+}
+
+// Encode the share as a mnemonic
+func (s *share) mnemonic() string {
+	idExp := s.identifier << (1 + iterationExponentBits)
+	if s.extendable {
+		idExp |= 1 << iterationExponentBits
+	}
+	idExp |= s.iterationExponent
+	params := s.groupIndex<<16 | (s.groupThreshold-1)<<12 | (s.groupCount-1)<<8 | s.memberIndex<<4 | (s.memberThreshold - 1)
+
+	indices := intToIndices(big.NewInt(int64(idExp)), idExpWords)
+	indices = append(indices, intToIndices(big.NewInt(int64(params)), groupParamsWords)...)
+	valueWordCount := (len(s.value)*8 + radixBits - 1) / radixBits
+	indices = append(indices, intToIndices(new(big.Int).SetBytes(s.value), valueWordCount)...)
+	indices = append(indices, createChecksum(indices, s.extendable)...)
+
+	words := make([]string, len(indices))
+	for i, index := range indices {
+		words[i] = wordlist[index]
+	}
+	return strings.Join(words, " ")
+}
+
+// Decode a share mnemonic, verifying its checksum
+func parseShare(mnemonic string) (*share, error) {
+	indices, err := mnemonicToIndices(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) < minMnemonicWords {
+		return nil, fmt.Errorf("invalid share length: shares must have at least %d words but this one has %d", minMnemonicWords, len(indices))
+	}
+	paddingLength := (radixBits * (len(indices) - idExpWords - groupParamsWords - checksumWords)) % 16
+	if paddingLength > 8 {
+		return nil, fmt.Errorf("invalid share length: %d words", len(indices))
+	}
+
+	// Parse the identifier and extendable flag first since the checksum depends on them
+	idExp := int(indicesToInt(indices[:idExpWords]).Int64())
+	s := &share{
+		identifier:        idExp >> (1 + iterationExponentBits),
+		extendable:        (idExp>>iterationExponentBits)&1 == 1,
+		iterationExponent: idExp & (1<<iterationExponentBits - 1),
+	}
+	if !verifyChecksum(indices, s.extendable) {
+		words := strings.Fields(mnemonic)
+		return nil, fmt.Errorf("invalid checksum for share starting with \"%s\"", strings.Join(words[:idExpWords+groupParamsWords], " "))
+	}
+
+	// Parse the group parameters
+	params := int(indicesToInt(indices[idExpWords : idExpWords+groupParamsWords]).Int64())
+	s.groupIndex = params >> 16
+	s.groupThreshold = (params>>12)&0xF + 1
+	s.groupCount = (params>>8)&0xF + 1
+	s.memberIndex = (params >> 4) & 0xF
+	s.memberThreshold = params&0xF + 1
+	if s.groupCount < s.groupThreshold {
+		return nil, fmt.Errorf("invalid share: the group threshold (%d) cannot be greater than the group count (%d)", s.groupThreshold, s.groupCount)
+	}
+
+	// Parse the value, making sure the padding bits are all zero
+	valueIndices := indices[idExpWords+groupParamsWords : len(indices)-checksumWords]
+	valueByteCount := (radixBits*len(valueIndices) - paddingLength) / 8
+	value := indicesToInt(valueIndices)
+	if value.BitLen() > valueByteCount*8 {
+		return nil, fmt.Errorf("invalid share: the padding bits are not all zero")
+	}
+	s.value = value.FillBytes(make([]byte, valueByteCount))
+	return s, nil
+}
+
+// Convert the words of a mnemonic to their indices in the wordlist
+func mnemonicToIndices(mnemonic string) ([]int, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	indices := make([]int, len(words))
+	for i, word := range words {
+		index, exists := wordIndices[word]
+		if !exists {
+			return nil, fmt.Errorf("invalid share: \"%s\" is not a SLIP-39 word", word)
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
+
+// Lookup of wordlist indices by word
+var wordIndices = func() map[string]int {
+	indices := make(map[string]int, radix)
+	for i, word := range wordlist {
+		indices[word] = i
+	}
+	return indices
+}()
+
+// Convert a big-endian sequence of 10-bit word indices to an integer
+func indicesToInt(indices []int) *big.Int {
+	value := new(big.Int)
+	for _, index := range indices {
+		value.Lsh(value, uint(radixBits))
+		value.Or(value, big.NewInt(int64(index)))
+	}
+	return value
+}
+
+// Convert an integer to a big-endian sequence of wordCount 10-bit word indices
+func intToIndices(value *big.Int, wordCount int) []int {
+	indices := make([]int, wordCount)
+	remaining := new(big.Int).Set(value)
+	mask := big.NewInt(int64(radix - 1))
+	for i := wordCount - 1; i >= 0; i-- {
+		indices[i] = int(new(big.Int).And(remaining, mask).Int64())
+		remaining.Rsh(remaining, uint(radixBits))
+	}
+	return indices
+}
+
+// Compute the RS1024 polynomial modulus of a sequence of values
+func rs1024Polymod(values []int) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xFFFFF)<<10 ^ uint32(v)
+		for i := 0; i < 10; i++ {
+			if (b>>i)&1 == 1 {
+				chk ^= checksumGenerator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// Get the customization string values that prefix the checksummed data
+func checksumPrefix(extendable bool) []int {
+	customization := checksumCustomization
+	if extendable {
+		customization = checksumCustomizationExtendable
+	}
+	values := make([]int, len(customization))
+	for i, c := range customization {
+		values[i] = int(c)
+	}
+	return values
+}
+
+// Create the checksum words for a share's data
+func createChecksum(data []int, extendable bool) []int {
+	values := append(checksumPrefix(extendable), data...)
+	values = append(values, make([]int, checksumWords)...)
+	polymod := rs1024Polymod(values) ^ 1
+	checksum := make([]int, checksumWords)
+	for i := 0; i < checksumWords; i++ {
+		checksum[i] = int(polymod>>(radixBits*(checksumWords-1-i))) & (radix - 1)
+	}
+	return checksum
+}
+
+// Check that a share's checksum is valid
+func verifyChecksum(data []int, extendable bool) bool {
+	return rs1024Polymod(append(checksumPrefix(extendable), data...)) == 1
+}
@@ -0,0 +1,194 @@
+// Package slip39 implements SLIP-39 Shamir's Secret-Sharing for mnemonic codes, so a wallet's seed can be split into several
+// share mnemonics that only recover it when enough of them are combined.
+//
+// See https://github.com/satoshilabs/slips/blob/master/slip-0039.md for the specification.
+package slip39
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+const (
+	// The iteration exponent used when generating shares; the cipher runs 10000 * 2^e PBKDF2 iterations in total
+	DefaultIterationExponent int = 1
+
+	// The maximum number of shares that can be generated
+	MaxShareCount int = maxShareCount
+)
+
+// Split a master secret into count share mnemonics in a single group, any threshold of which can be combined to recover it.
+// The passphrase is optional; if provided, it will be required to recover the secret as well.
+func GenerateShares(masterSecret []byte, passphrase string, threshold int, count int) ([]string, error) {
+	if len(masterSecret) < minSecretLength {
+		return nil, fmt.Errorf("the master secret must be at least %d bytes long", minSecretLength)
+	}
+	if len(masterSecret)%2 != 0 {
+		return nil, fmt.Errorf("the master secret must have an even number of bytes")
+	}
+	if count < 1 || count > maxShareCount {
+		return nil, fmt.Errorf("the share count must be between 1 and %d", maxShareCount)
+	}
+	if threshold < 1 || threshold > count {
+		return nil, fmt.Errorf("the threshold must be between 1 and the share count (%d)", count)
+	}
+	if threshold == 1 && count > 1 {
+		return nil, fmt.Errorf("creating multiple shares with a threshold of 1 is not allowed; use a single share instead")
+	}
+	for _, c := range passphrase {
+		if c < 32 || c > 126 {
+			return nil, fmt.Errorf("the passphrase must only contain printable ASCII characters")
+		}
+	}
+
+	// Pick a random share set identifier
+	var idBytes [2]byte
+	_, err := rand.Read(idBytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("error generating share identifier: %w", err)
+	}
+	identifier := int(binary.BigEndian.Uint16(idBytes[:])) & (1<<idBits - 1)
+
+	// Encrypt the secret and split it
+	encryptedSecret := encrypt(masterSecret, []byte(passphrase), DefaultIterationExponent, identifier, false)
+	groupShares, err := splitSecret(1, 1, encryptedSecret)
+	if err != nil {
+		return nil, fmt.Errorf("error splitting secret into groups: %w", err)
+	}
+	memberShares, err := splitSecret(threshold, count, groupShares[0].value)
+	if err != nil {
+		return nil, fmt.Errorf("error splitting secret into shares: %w", err)
+	}
+
+	mnemonics := make([]string, len(memberShares))
+	for i, memberShare := range memberShares {
+		s := share{
+			identifier:        identifier,
+			extendable:        false,
+			iterationExponent: DefaultIterationExponent,
+			groupIndex:        int(groupShares[0].x),
+			groupThreshold:    1,
+			groupCount:        1,
+			memberIndex:       int(memberShare.x),
+			memberThreshold:   threshold,
+			value:             memberShare.value,
+		}
+		mnemonics[i] = s.mnemonic()
+	}
+	return mnemonics, nil
+}
+
+// Combine share mnemonics to recover the master secret they were generated from.
+// Shares beyond what's required to meet each threshold are ignored.
+func CombineShares(mnemonics []string, passphrase string) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, fmt.Errorf("no shares were provided")
+	}
+
+	// Decode the shares and make sure they all belong to the same set
+	shares := make([]*share, len(mnemonics))
+	for i, mnemonic := range mnemonics {
+		s, err := parseShare(mnemonic)
+		if err != nil {
+			return nil, fmt.Errorf("share %d: %w", i+1, err)
+		}
+		shares[i] = s
+	}
+	first := shares[0]
+	for i, s := range shares[1:] {
+		if s.identifier != first.identifier || s.extendable != first.extendable || s.iterationExponent != first.iterationExponent {
+			return nil, fmt.Errorf("share %d does not belong to the same set as share 1", i+2)
+		}
+		if s.groupThreshold != first.groupThreshold || s.groupCount != first.groupCount {
+			return nil, fmt.Errorf("share %d has different group parameters than share 1", i+2)
+		}
+		if len(s.value) != len(first.value) {
+			return nil, fmt.Errorf("share %d has a different length than share 1", i+2)
+		}
+	}
+
+	// Sort the shares into their groups, ignoring exact duplicates
+	groups := map[int][]*share{}
+	for i, s := range shares {
+		members := groups[s.groupIndex]
+		if len(members) > 0 && members[0].memberThreshold != s.memberThreshold {
+			return nil, fmt.Errorf("share %d has a different member threshold than the other shares in group %d", i+1, s.groupIndex+1)
+		}
+		duplicate := false
+		for _, member := range members {
+			if member.memberIndex != s.memberIndex {
+				continue
+			}
+			if !bytes.Equal(member.value, s.value) {
+				return nil, fmt.Errorf("share %d has the same member index as another share in group %d but a different value", i+1, s.groupIndex+1)
+			}
+			duplicate = true
+			break
+		}
+		if !duplicate {
+			groups[s.groupIndex] = append(members, s)
+		}
+	}
+
+	// Recover the secret of each group that has enough shares
+	groupIndices := make([]int, 0, len(groups))
+	for index := range groups {
+		groupIndices = append(groupIndices, index)
+	}
+	sort.Ints(groupIndices)
+	groupSecrets := []rawShare{}
+	for _, index := range groupIndices {
+		members := groups[index]
+		threshold := members[0].memberThreshold
+		if len(members) < threshold {
+			continue
+		}
+		memberShares := make([]rawShare, threshold)
+		for i, member := range members[:threshold] {
+			memberShares[i] = rawShare{x: byte(member.memberIndex), value: member.value}
+		}
+		secret, err := recoverSecret(threshold, memberShares)
+		if err != nil {
+			return nil, fmt.Errorf("error recovering the secret of group %d: %w", index+1, err)
+		}
+		groupSecrets = append(groupSecrets, rawShare{x: byte(index), value: secret})
+		if len(groupSecrets) == first.groupThreshold {
+			break
+		}
+	}
+	if len(groupSecrets) < first.groupThreshold {
+		if first.groupCount == 1 {
+			return nil, fmt.Errorf("insufficient shares: %d of %d required shares were provided", len(groups[first.groupIndex]), first.memberThreshold)
+		}
+		return nil, fmt.Errorf("insufficient shares: %d of %d required groups have enough shares", len(groupSecrets), first.groupThreshold)
+	}
+
+	// Recover and decrypt the master secret
+	encryptedSecret, err := recoverSecret(first.groupThreshold, groupSecrets)
+	if err != nil {
+		return nil, fmt.Errorf("error recovering the encrypted master secret: %w", err)
+	}
+	return decrypt(encryptedSecret, []byte(passphrase), first.iterationExponent, first.identifier, first.extendable), nil
+}
+
+// Check that a share mnemonic is well-formed and has a valid checksum
+func ValidateShare(mnemonic string) error {
+	_, err := parseShare(mnemonic)
+	return err
+}
+
+// Get the number of shares required to recover the secret from the set the provided share belongs to.
+// Returns false if the set is split into multiple groups, since the total then depends on which groups the shares come from.
+func GetRequiredShareCount(mnemonic string) (int, bool, error) {
+	s, err := parseShare(mnemonic)
+	if err != nil {
+		return 0, false, err
+	}
+	if s.groupCount > 1 {
+		return 0, false, nil
+	}
+	return s.memberThreshold, true, nil
+}
@@ -0,0 +1,167 @@
+package slip39
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// The passphrase used by the official TREZOR test vectors
+const vectorPassphrase string = "TREZOR"
+
+// Official SLIP-0039 test vectors from https://github.com/trezor/python-shamir-mnemonic/blob/master/vectors.json
+var validVectors = []struct {
+	name         string
+	mnemonics    []string
+	masterSecret string
+}{
+	{
+		name: "1. Valid mnemonic without sharing (128 bits)",
+		mnemonics: []string{
+			"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard",
+		},
+		masterSecret: "bb54aac4b89dc868ba37d9cc21b2cece",
+	},
+	{
+		name: "4. Basic sharing 2-of-3 (128 bits)",
+		mnemonics: []string{
+			"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+		},
+		masterSecret: "b43ceb7e57a0ea8766221624d01b0864",
+	},
+	{
+		name: "18. Valid mnemonic without sharing (256 bits)",
+		mnemonics: []string{
+			"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck",
+		},
+		masterSecret: "989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92",
+	},
+}
+
+func TestCombineSharesVectors(t *testing.T) {
+	for _, vector := range validVectors {
+		t.Run(vector.name, func(t *testing.T) {
+			expected, err := hex.DecodeString(vector.masterSecret)
+			if err != nil {
+				t.Fatalf("error decoding expected master secret: %s", err.Error())
+			}
+			for _, mnemonic := range vector.mnemonics {
+				err = ValidateShare(mnemonic)
+				if err != nil {
+					t.Fatalf("share [%s] was rejected: %s", mnemonic, err.Error())
+				}
+			}
+			secret, err := CombineShares(vector.mnemonics, vectorPassphrase)
+			if err != nil {
+				t.Fatalf("error combining shares: %s", err.Error())
+			}
+			if !bytes.Equal(secret, expected) {
+				t.Fatalf("expected master secret %x but got %x", expected, secret)
+			}
+		})
+	}
+}
+
+func TestInvalidChecksumVectors(t *testing.T) {
+	for _, mnemonic := range []string{
+		// 2. Mnemonic with invalid checksum (128 bits)
+		"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney",
+		// 19. Mnemonic with invalid checksum (256 bits)
+		"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect lunar",
+	} {
+		err := ValidateShare(mnemonic)
+		if err == nil {
+			t.Fatalf("share with an invalid checksum was accepted: %s", mnemonic)
+		}
+		_, err = CombineShares([]string{mnemonic}, vectorPassphrase)
+		if err == nil {
+			t.Fatalf("combining a share with an invalid checksum succeeded: %s", mnemonic)
+		}
+	}
+}
+
+func TestInsufficientSharesVectors(t *testing.T) {
+	for _, mnemonic := range []string{
+		// 4. Basic sharing 2-of-3 (128 bits), with only one of the shares
+		validVectors[1].mnemonics[0],
+		// 21. Basic sharing 2-of-3 (256 bits), with only one of the shares
+		"humidity disease academic always aluminum jewelry energy woman receiver strategy amuse duckling lying evidence network walnut tactics forget hairy rebound impulse brother survive clothes stadium mailman rival ocean reward venture always armed unwrap",
+	} {
+		count, isSingleGroup, err := GetRequiredShareCount(mnemonic)
+		if err != nil {
+			t.Fatalf("error getting required share count: %s", err.Error())
+		}
+		if !isSingleGroup || count != 2 {
+			t.Fatalf("expected a single group requiring 2 shares but got %d (single group: %t)", count, isSingleGroup)
+		}
+		_, err = CombineShares([]string{mnemonic}, vectorPassphrase)
+		if err == nil {
+			t.Fatal("combining fewer shares than the threshold succeeded")
+		}
+	}
+}
+
+func TestGenerateAndCombineShares(t *testing.T) {
+	for _, secretHex := range []string{
+		"bb54aac4b89dc868ba37d9cc21b2cece",
+		"989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92",
+		// A 64-byte BIP-39 seed, which is what wallets are split into
+		"5eb00bbddcf069084889a8ab9155568165f5c453ccb85e70811aaed6f6da5fc19a5ac40b389cd370d086206dec8aa6c43daea6690f20ad3d8d48b2d2ce9e38e4",
+	} {
+		secret, err := hex.DecodeString(secretHex)
+		if err != nil {
+			t.Fatalf("error decoding master secret: %s", err.Error())
+		}
+		mnemonics, err := GenerateShares(secret, vectorPassphrase, 2, 3)
+		if err != nil {
+			t.Fatalf("error generating shares for a %d-byte secret: %s", len(secret), err.Error())
+		}
+		if len(mnemonics) != 3 {
+			t.Fatalf("expected 3 shares but got %d", len(mnemonics))
+		}
+
+		// Every pair of shares must recover the secret
+		for i := 0; i < len(mnemonics); i++ {
+			for j := i + 1; j < len(mnemonics); j++ {
+				recovered, err := CombineShares([]string{mnemonics[i], mnemonics[j]}, vectorPassphrase)
+				if err != nil {
+					t.Fatalf("error combining shares %d and %d: %s", i+1, j+1, err.Error())
+				}
+				if !bytes.Equal(recovered, secret) {
+					t.Fatalf("shares %d and %d recovered %x instead of %x", i+1, j+1, recovered, secret)
+				}
+			}
+		}
+	}
+}
+
+func TestCombineConflictingShares(t *testing.T) {
+	secret, err := hex.DecodeString("bb54aac4b89dc868ba37d9cc21b2cece")
+	if err != nil {
+		t.Fatalf("error decoding master secret: %s", err.Error())
+	}
+	mnemonics, err := GenerateShares(secret, vectorPassphrase, 2, 3)
+	if err != nil {
+		t.Fatalf("error generating shares: %s", err.Error())
+	}
+
+	// Exact duplicates are ignored
+	_, err = CombineShares([]string{mnemonics[0], mnemonics[0], mnemonics[1]}, vectorPassphrase)
+	if err != nil {
+		t.Fatalf("error combining shares with a duplicate: %s", err.Error())
+	}
+
+	// A different share with the same member index is rejected instead of being dropped
+	conflicting, err := parseShare(mnemonics[0])
+	if err != nil {
+		t.Fatalf("error parsing share: %s", err.Error())
+	}
+	conflicting.value = append([]byte{}, conflicting.value...)
+	conflicting.value[0] ^= 0xff
+	_, err = CombineShares([]string{mnemonics[0], conflicting.mnemonic(), mnemonics[1]}, vectorPassphrase)
+	if err == nil || !strings.Contains(err.Error(), "different value") {
+		t.Fatalf("expected shares with conflicting values for the same member index to be rejected but got %v", err)
+	}
+}
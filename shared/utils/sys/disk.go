@@ -0,0 +1,35 @@
+package sys
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"syscall"
+)
+
+// Get the free space available to unprivileged users on the filesystem that holds the given path, in bytes.
+// If the path doesn't exist or can't be accessed, the closest parent that can be is used instead; the path that was actually checked is returned along with the free space.
+func GetFreeSpace(path string) (uint64, string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return 0, "", fmt.Errorf("error getting absolute path of [%s]: %w", path, err)
+	}
+
+	for {
+		var stat syscall.Statfs_t
+		err := syscall.Statfs(path, &stat)
+		if err == nil {
+			return uint64(stat.Bavail) * uint64(stat.Bsize), path, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) && !errors.Is(err, fs.ErrPermission) {
+			return 0, "", fmt.Errorf("error getting filesystem info for [%s]: %w", path, err)
+		}
+
+		parent := filepath.Dir(path)
+		if parent == path {
+			return 0, "", fmt.Errorf("error getting filesystem info for [%s]: %w", path, err)
+		}
+		path = parent
+	}
+}
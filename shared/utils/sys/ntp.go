@@ -0,0 +1,81 @@
+package sys
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// The default NTP server to compare the system clock against
+	DefaultNtpServer string = "pool.ntp.org"
+
+	// The port NTP servers listen on
+	ntpPort int = 123
+
+	// Seconds between the NTP epoch (1900) and the Unix epoch (1970)
+	ntpEpochOffset int64 = 2208988800
+)
+
+// Get the offset of the system clock from the time reported by an NTP server, using a single SNTP request.
+// A positive offset means the system clock is behind the server.
+func GetClockOffset(server string, timeout time.Duration) (time.Duration, error) {
+	conn, err := net.DialTimeout("udp", net.JoinHostPort(server, fmt.Sprint(ntpPort)), timeout)
+	if err != nil {
+		return 0, fmt.Errorf("error connecting to NTP server [%s]: %w", server, err)
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return 0, fmt.Errorf("error setting NTP request deadline: %w", err)
+	}
+
+	// Send a version 4 client request, with the transmit time set so the server echoes it back as the origin time
+	request := make([]byte, 48)
+	request[0] = 0x23 // LI = 0, VN = 4, Mode = 3 (client)
+	sendTime := time.Now()
+	binary.BigEndian.PutUint64(request[40:], toNtpTime(sendTime))
+	_, err = conn.Write(request)
+	if err != nil {
+		return 0, fmt.Errorf("error sending NTP request to [%s]: %w", server, err)
+	}
+
+	response := make([]byte, 48)
+	length, err := conn.Read(response)
+	if err != nil {
+		return 0, fmt.Errorf("error reading NTP response from [%s]: %w", server, err)
+	}
+	receiveTime := time.Now()
+	if length < 48 {
+		return 0, fmt.Errorf("NTP response from [%s] was too short (%d bytes)", server, length)
+	}
+	if mode := response[0] & 0x07; mode != 4 {
+		return 0, fmt.Errorf("NTP response from [%s] had unexpected mode %d", server, mode)
+	}
+	if stratum := response[1]; stratum == 0 {
+		return 0, fmt.Errorf("NTP server [%s] sent a kiss-of-death response (%s)", server, string(response[12:16]))
+	}
+	if binary.BigEndian.Uint64(response[24:]) != binary.BigEndian.Uint64(request[40:]) {
+		return 0, fmt.Errorf("NTP response from [%s] doesn't match the request", server)
+	}
+
+	// Offset = ((T2 - T1) + (T3 - T4)) / 2
+	serverReceiveTime := fromNtpTime(binary.BigEndian.Uint64(response[32:]))
+	serverTransmitTime := fromNtpTime(binary.BigEndian.Uint64(response[40:]))
+	return (serverReceiveTime.Sub(sendTime) + serverTransmitTime.Sub(receiveTime)) / 2, nil
+}
+
+// Convert a time to the 64-bit NTP timestamp format: seconds since 1900 in the upper 32 bits, and the fraction of a second in the lower 32
+func toNtpTime(t time.Time) uint64 {
+	seconds := uint64(t.Unix() + ntpEpochOffset)
+	fraction := (uint64(t.Nanosecond()) << 32) / uint64(time.Second)
+	return seconds<<32 | fraction
+}
+
+// Convert a 64-bit NTP timestamp to a time
+func fromNtpTime(timestamp uint64) time.Time {
+	seconds := int64(timestamp>>32) - ntpEpochOffset
+	nanoseconds := (int64(timestamp&0xFFFFFFFF) * int64(time.Second)) >> 32
+	return time.Unix(seconds, nanoseconds)
+}
@@ -0,0 +1,32 @@
+package sys
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Run systemctl with the given arguments.
+// If it fails, the error includes its output.
+func RunSystemctl(args ...string) error {
+	output, err := exec.Command("systemctl", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error running systemctl %s: %w (%s)", strings.Join(args, " "), err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// Check if the systemd unit with the given name is running
+func IsUnitActive(unit string) bool {
+	return exec.Command("systemctl", "is-active", "--quiet", unit).Run() == nil
+}
+
+// Get the paths of the systemd service unit files in the given folder whose names start with the given prefix
+func GetUnitFiles(unitDir string, prefix string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(unitDir, prefix+"*.service"))
+	if err != nil {
+		return nil, fmt.Errorf("error searching for unit files in [%s]: %w", unitDir, err)
+	}
+	return paths, nil
+}